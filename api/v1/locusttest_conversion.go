@@ -146,15 +146,22 @@ func (dst *LocustTest) ConvertFrom(srcRaw conversion.Hub) error {
 	}
 
 	// The following v2-only fields are NOT preserved in v1:
-	// - master.resources, master.extraArgs
-	// - worker.resources, worker.extraArgs
-	// - testFiles.srcMountPath, testFiles.libMountPath
+	// - master.resources, master.extraArgs, master.sidecars, master.initContainers, master.podTemplate
+	// - worker.resources, worker.extraArgs, worker.autoscaling, worker.sidecars, worker.initContainers,
+	//   worker.podTemplate, workerGroups
+	// - testFiles.srcMountPath, testFiles.libMountPath, testFiles.git, testFiles.inline
 	// - scheduling.nodeSelector
 	// - scheduling.runtimeClassName
 	// - env (configMapRefs, secretRefs, variables, secretMounts)
 	// - volumes, volumeMounts
 	// - security (podSecurityContext, containerSecurityContext)
-	// - observability (OpenTelemetry config)
+	// - observability (OpenTelemetry config, metricsExporter, prometheus)
+	// - load (users, spawnRate, runTime, stages)
+	// - maxDuration, suspend, startAt, queue
+	// - thresholds
+	// - results
+	// - webUI
+	// - notifications, integrations
 	// - status (v1 has no status subresource fields)
 
 	return nil
@@ -32,6 +32,34 @@ const (
 
 	// ConditionTypePodsHealthy indicates whether pods are healthy and running.
 	ConditionTypePodsHealthy = "PodsHealthy"
+
+	// ConditionTypeThresholdsMet indicates whether the test meets spec.thresholds.
+	ConditionTypeThresholdsMet = "ThresholdsMet"
+
+	// ConditionTypeResultsExported indicates whether the results were uploaded to spec.results.s3.
+	ConditionTypeResultsExported = "ResultsExported"
+
+	// ConditionTypeMonitorsCreated indicates whether the Prometheus Operator
+	// monitors of spec.observability.prometheus were created.
+	ConditionTypeMonitorsCreated = "MonitorsCreated"
+
+	// ConditionTypeGrafanaAnnotated indicates whether the run of the test is
+	// annotated in Grafana.
+	ConditionTypeGrafanaAnnotated = "GrafanaAnnotated"
+
+	// ConditionTypeKafkaCredentialsAvailable indicates whether the Secrets
+	// holding the Kafka credentials of spec.integrations.kafka exist.
+	ConditionTypeKafkaCredentialsAvailable = "KafkaCredentialsAvailable"
+
+	// ConditionTypeWorkerReplicasInBounds indicates whether the workers
+	// requested through the scale subresource, which skips the validating
+	// webhook, lie within the bounds of spec.worker.
+	ConditionTypeWorkerReplicasInBounds = "WorkerReplicasInBounds"
+
+	// ConditionTypeWorkerReplicasApplied indicates, once the autoscaler of
+	// spec.worker.autoscaling decided, whether spec.worker.replicas is the
+	// number of workers it runs or is overridden by its decision.
+	ConditionTypeWorkerReplicasApplied = "WorkerReplicasApplied"
 )
 
 // Condition reasons for Ready condition.
@@ -51,8 +79,18 @@ const (
 // Condition reasons for TestCompleted condition.
 const (
 	ReasonTestInProgress = "TestInProgress"
+	ReasonTestQueued     = "TestQueued"
+	ReasonTestSuspended  = "TestSuspended"
+	ReasonTestScheduled  = "TestScheduled"
 	ReasonTestSucceeded  = "TestSucceeded"
 	ReasonTestFailed     = "TestFailed"
+	ReasonTestStopping   = "TestStopping"
+	ReasonTestCancelled  = "TestCancelled"
+	ReasonTestAborted    = "TestAborted"
+	// ReasonTimedOut is a test that ran past its maxDuration.
+	ReasonTimedOut = "TimedOut"
+	// ReasonQueueCapacityExceeded is a test needing more than its queue's limits.
+	ReasonQueueCapacityExceeded = "QueueCapacityExceeded"
 )
 
 // Condition reasons for SpecDrifted condition.
@@ -69,6 +107,65 @@ const (
 	ReasonPodSchedulingError = "SchedulingError"
 	ReasonPodCrashLoop       = "CrashLoopBackOff"
 	ReasonPodInitError       = "InitializationError"
+	ReasonPodGitCloneError   = "GitCloneFailed"
+)
+
+// Condition reasons for ThresholdsMet condition.
+const (
+	ReasonThresholdsPending     = "ThresholdsPending"
+	ReasonThresholdsMet         = "ThresholdsMet"
+	ReasonThresholdsBreached    = "ThresholdsBreached"
+	ReasonStatisticsUnavailable = "StatisticsUnavailable"
+)
+
+// Condition reasons for ResultsExported condition.
+const (
+	ReasonResultsExportPending = "ResultsExportPending"
+	ReasonResultsExported      = "ResultsExported"
+	ReasonResultsExportFailed  = "ResultsExportFailed"
+)
+
+// Condition reasons for MonitorsCreated condition.
+const (
+	ReasonMonitorsCreated = "MonitorsCreated"
+	// ReasonMonitoringCRDsMissing is a cluster without the Prometheus Operator CRDs.
+	ReasonMonitoringCRDsMissing = "MonitoringCRDsMissing"
+	// ReasonMetricsPortUnavailable is a test without the metrics exporter
+	// sidecar, whose master Service has no metrics port.
+	ReasonMetricsPortUnavailable = "MetricsPortUnavailable"
+)
+
+// Condition reasons for GrafanaAnnotated condition.
+const (
+	// ReasonGrafanaAnnotationStarted is an annotation region whose end is
+	// set when the test finishes.
+	ReasonGrafanaAnnotationStarted = "GrafanaAnnotationStarted"
+	ReasonGrafanaAnnotationEnded   = "GrafanaAnnotationEnded"
+	ReasonGrafanaAnnotationFailed  = "GrafanaAnnotationFailed"
+)
+
+// Condition reasons for KafkaCredentialsAvailable condition.
+const (
+	ReasonKafkaCredentialsFound = "KafkaCredentialsFound"
+	// ReasonKafkaCredentialsMissing is a Secret or key missing from the
+	// namespace of the test; its pods don't start until it is created.
+	ReasonKafkaCredentialsMissing = "KafkaCredentialsMissing"
+)
+
+// Condition reasons for WorkerReplicasInBounds condition.
+const (
+	ReasonWorkerReplicasInBounds = "WorkerReplicasInBounds"
+	// ReasonWorkerReplicasOutOfBounds is a worker count the operator clamped
+	// to the bounds of spec.worker.
+	ReasonWorkerReplicasOutOfBounds = "WorkerReplicasOutOfBounds"
+)
+
+// Condition reasons for WorkerReplicasApplied condition.
+const (
+	ReasonWorkerReplicasApplied = "WorkerReplicasApplied"
+	// ReasonOverriddenByAutoscaler is a spec.worker.replicas the autoscaler's
+	// decision takes precedence over.
+	ReasonOverriddenByAutoscaler = "OverriddenByAutoscaler"
 )
 
 // Phase represents the current lifecycle phase of a LocustTest.
@@ -76,8 +173,35 @@ type Phase string
 
 // Phase constants for LocustTest status.
 const (
-	PhasePending   Phase = "Pending"
+	// PhaseQueued is a test waiting in spec.queue before its resources are created.
+	PhaseQueued  Phase = "Queued"
+	PhasePending Phase = "Pending"
+	// PhaseSuspended is a test whose Jobs were created suspended with spec.suspend.
+	PhaseSuspended Phase = "Suspended"
+	// PhaseScheduled is a test whose Jobs are suspended until spec.startAt.
+	PhaseScheduled Phase = "Scheduled"
 	PhaseRunning   Phase = "Running"
 	PhaseSucceeded Phase = "Succeeded"
 	PhaseFailed    Phase = "Failed"
+	// PhaseCancelled is a test stopped early with the Stop control action.
+	PhaseCancelled Phase = "Cancelled"
+	// PhaseAborted is a test whose Jobs were deleted with the Abort control action.
+	PhaseAborted Phase = "Aborted"
 )
+
+// IsTerminal reports whether the phase is final: the test finished, failed,
+// or was stopped or aborted.
+func (p Phase) IsTerminal() bool {
+	switch p {
+	case PhaseSucceeded, PhaseFailed, PhaseCancelled, PhaseAborted:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsWaiting reports whether the test's Jobs exist but are suspended until
+// the test starts.
+func (p Phase) IsWaiting() bool {
+	return p == PhaseSuspended || p == PhaseScheduled
+}
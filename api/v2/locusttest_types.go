@@ -18,7 +18,9 @@ package v2
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // ============================================
@@ -56,6 +58,27 @@ type MasterSpec struct {
 	// ExtraArgs are additional CLI arguments appended to the command.
 	// +optional
 	ExtraArgs []string `json:"extraArgs,omitempty"`
+
+	// Sidecars are containers run next to Locust in the master pod, e.g. a
+	// log shipper. They run as native sidecars: their restartPolicy is
+	// Always, they start before Locust and stop after it.
+	// +optional
+	Sidecars []Container `json:"sidecars,omitempty"`
+
+	// InitContainers run to completion in the master pod before Locust
+	// starts, after the test files are cloned.
+	// +optional
+	InitContainers []Container `json:"initContainers,omitempty"`
+
+	// PodTemplate is a strategic merge patch applied over the generated
+	// master pod template, for pod fields the spec doesn't expose. The
+	// performance-test-pod-name label, the restart policy and the Locust
+	// container's command and args can't be overridden.
+	// +optional
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	PodTemplate *runtime.RawExtension `json:"podTemplate,omitempty"`
 }
 
 // AutoquitConfig defines autoquit behavior for the master.
@@ -71,6 +94,17 @@ type AutoquitConfig struct {
 	Timeout int32 `json:"timeout,omitempty"`
 }
 
+// Container is a Kubernetes container declared as a sidecar or init container.
+// Its schema is left out of the CRD, which would otherwise embed the container
+// schema once per field and outgrow the size kubectl apply can handle; the
+// webhook validates it instead.
+// +kubebuilder:validation:Type=object
+// +kubebuilder:pruning:PreserveUnknownFields
+type Container struct {
+	// +kubebuilder:validation:Schemaless
+	corev1.Container `json:",inline"`
+}
+
 // ============================================
 // WORKER CONFIGURATION
 // ============================================
@@ -82,7 +116,8 @@ type WorkerSpec struct {
 	// +kubebuilder:validation:Required
 	Command string `json:"command"`
 
-	// Replicas is the number of worker pods to create.
+	// Replicas is the number of worker pods to create. It can be changed
+	// while the test runs, directly or through the scale subresource.
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:Minimum=1
 	// +kubebuilder:validation:Maximum=500
@@ -103,6 +138,324 @@ type WorkerSpec struct {
 	// ExtraArgs are additional CLI arguments appended to the command.
 	// +optional
 	ExtraArgs []string `json:"extraArgs,omitempty"`
+
+	// Autoscaling adjusts the number of workers while the test runs. Replicas
+	// is then the number of workers the test starts with.
+	// +optional
+	Autoscaling *WorkerAutoscaling `json:"autoscaling,omitempty"`
+
+	// Sidecars are containers run next to Locust in the worker pods, worker
+	// groups included. They run as native sidecars: their restartPolicy is
+	// Always, they start before Locust and stop after it.
+	// +optional
+	Sidecars []Container `json:"sidecars,omitempty"`
+
+	// InitContainers run to completion in the worker pods, worker groups
+	// included, before Locust starts, after the test files are cloned.
+	// +optional
+	InitContainers []Container `json:"initContainers,omitempty"`
+
+	// PodTemplate is a strategic merge patch applied over the generated
+	// worker pod template, worker groups included, for pod fields the spec
+	// doesn't expose. The performance-test-pod-name label, the restart policy
+	// and the Locust container's command and args can't be overridden.
+	// +optional
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	PodTemplate *runtime.RawExtension `json:"podTemplate,omitempty"`
+}
+
+// WorkerAutoscaling adjusts the number of workers of spec.worker from the
+// CPU usage the master reports for them.
+type WorkerAutoscaling struct {
+	// MinReplicas is the fewest workers the test scales down to.
+	// +optional
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=500
+	MinReplicas int32 `json:"minReplicas,omitempty"`
+
+	// MaxReplicas is the most workers the test scales up to.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=500
+	MaxReplicas int32 `json:"maxReplicas"`
+
+	// TargetCPUPercent is the average worker CPU usage to keep the workers
+	// at. Locust warns when a worker goes above 90%.
+	// +optional
+	// +kubebuilder:default=75
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	TargetCPUPercent int32 `json:"targetCPUPercent,omitempty"`
+
+	// Cooldown is the least time between two scaling decisions, and before
+	// the first one once the test started. Defaults to 1m.
+	// +optional
+	Cooldown *metav1.Duration `json:"cooldown,omitempty"`
+}
+
+// WorkerGroup is a group of workers run by its own worker Job, next to the
+// workers of spec.worker. Groups let one test run workers with different
+// user classes, resources or images against the same master.
+type WorkerGroup struct {
+	// Name identifies the group. The group's Job is named
+	// "<test>-worker-<name>". "default" is reserved for spec.worker.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=20
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// Replicas is the number of worker pods in the group.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=500
+	Replicas int32 `json:"replicas"`
+
+	// Command is the base command for the group's workers.
+	// Defaults to spec.worker.command.
+	// +optional
+	Command string `json:"command,omitempty"`
+
+	// ExtraArgs are additional CLI arguments appended to the command,
+	// e.g. the user classes the group runs.
+	// +optional
+	ExtraArgs []string `json:"extraArgs,omitempty"`
+
+	// Image is the container image for the group's workers.
+	// Defaults to spec.image.
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// Resources defines resource requests and limits for the group's pods.
+	// Defaults to the operator's worker resources.
+	// +optional
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// Labels for the group's pods.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations for the group's pods.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// Scheduling replaces spec.scheduling for the group's pods.
+	// +optional
+	Scheduling *SchedulingConfig `json:"scheduling,omitempty"`
+}
+
+// ============================================
+// LOAD PROFILE
+// ============================================
+
+// LoadConfig defines the load profile of the test.
+// Either set users/spawnRate/runTime for a constant load, or stages for a
+// staged ramp. Stages are rendered into a LoadTestShape class that the
+// operator mounts into the master pod.
+type LoadConfig struct {
+	// Users is the peak number of concurrent users (--users).
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Users *int32 `json:"users,omitempty"`
+
+	// SpawnRate is the number of users started per second (--spawn-rate).
+	// Fractional rates such as "0.5" or "500m" are allowed.
+	// +optional
+	SpawnRate *resource.Quantity `json:"spawnRate,omitempty"`
+
+	// RunTime stops the test after this duration (--run-time), e.g. "10m".
+	// +optional
+	RunTime *metav1.Duration `json:"runTime,omitempty"`
+
+	// Stages describe a staged ramp, run in order. The test stops after the
+	// last stage, so stages cannot be combined with users, spawnRate or runTime.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=100
+	Stages []LoadStage `json:"stages,omitempty"`
+}
+
+// LoadStage is one step of a staged load profile.
+type LoadStage struct {
+	// Duration of this stage, e.g. "2m".
+	// +kubebuilder:validation:Required
+	Duration metav1.Duration `json:"duration"`
+
+	// Users is the target number of concurrent users during this stage.
+	// Use 0 to ramp down.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=0
+	Users int32 `json:"users"`
+
+	// SpawnRate is the number of users started or stopped per second while
+	// moving to the stage's user count.
+	// +kubebuilder:validation:Required
+	SpawnRate resource.Quantity `json:"spawnRate"`
+}
+
+// ============================================
+// THRESHOLDS
+// ============================================
+
+// ThresholdsConfig defines the service level objectives a test must meet to
+// succeed. They are evaluated against the cumulative statistics of the master
+// while the test runs; if any is breached when the test finishes, the test
+// ends Failed even if Locust exited cleanly.
+type ThresholdsConfig struct {
+	// Limits applied to the aggregate of all requests.
+	ThresholdLimits `json:",inline"`
+
+	// Endpoints applies limits to individual endpoints.
+	// +optional
+	// +kubebuilder:validation:MaxItems=50
+	Endpoints []EndpointThresholds `json:"endpoints,omitempty"`
+}
+
+// ThresholdLimits are the limits that can be set on a group of requests.
+type ThresholdLimits struct {
+	// MaxFailureRatio is the highest allowed ratio of failed requests, from 0 to 1 (e.g. "0.01").
+	// +optional
+	MaxFailureRatio *resource.Quantity `json:"maxFailureRatio,omitempty"`
+
+	// MaxP95ResponseTime is the highest allowed 95th percentile response time (e.g. "500ms").
+	// +optional
+	MaxP95ResponseTime *metav1.Duration `json:"maxP95ResponseTime,omitempty"`
+
+	// MaxP99ResponseTime is the highest allowed 99th percentile response time (e.g. "2s").
+	// +optional
+	MaxP99ResponseTime *metav1.Duration `json:"maxP99ResponseTime,omitempty"`
+
+	// MinRPS is the lowest allowed average number of requests per second.
+	// +optional
+	MinRPS *resource.Quantity `json:"minRPS,omitempty"`
+}
+
+// EndpointThresholds applies limits to the requests of one endpoint.
+type EndpointThresholds struct {
+	// Name of the endpoint as reported by Locust (the request name or URL path).
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Method of the endpoint (e.g. "GET"). Matches all methods when empty.
+	// +optional
+	Method string `json:"method,omitempty"`
+
+	// Limits applied to the matching requests.
+	ThresholdLimits `json:",inline"`
+}
+
+// ============================================
+// RESULTS
+// ============================================
+
+// ResultsFormat is a kind of result file written by the master.
+// +kubebuilder:validation:Enum=csv;html;json
+type ResultsFormat string
+
+const (
+	// ResultsFormatCSV writes the statistics, failures and exceptions as CSV files (--csv).
+	ResultsFormatCSV ResultsFormat = "csv"
+	// ResultsFormatHTML writes the HTML report (--html).
+	ResultsFormatHTML ResultsFormat = "html"
+	// ResultsFormatJSON writes the final statistics as JSON (--json-file).
+	ResultsFormatJSON ResultsFormat = "json"
+)
+
+// ResultsStorage is where the collected results are kept.
+// +kubebuilder:validation:Enum=ConfigMap;PersistentVolumeClaim
+type ResultsStorage string
+
+const (
+	// ResultsStorageConfigMap gathers the result files into a ConfigMap.
+	ResultsStorageConfigMap ResultsStorage = "ConfigMap"
+	// ResultsStoragePersistentVolumeClaim writes the result files to a PersistentVolumeClaim.
+	ResultsStoragePersistentVolumeClaim ResultsStorage = "PersistentVolumeClaim"
+)
+
+// ResultsConfig keeps the test's results after its pods are gone.
+// The master writes the selected formats to a results volume and prints
+// only the summary (--only-summary). When Locust exits, the operator stores
+// the summary, and the result files with ConfigMap storage, in a ConfigMap
+// named "<name>-results" owned by the test.
+type ResultsConfig struct {
+	// Formats of the result files the master writes.
+	// +optional
+	// +listType=set
+	// +kubebuilder:default={csv,html}
+	Formats []ResultsFormat `json:"formats,omitempty"`
+
+	// Storage is where the result files are kept. ConfigMap is limited to
+	// about 1MiB in total; files that don't fit are skipped and listed in
+	// status.results.message. PersistentVolumeClaim writes the files to a
+	// claim named "<name>-results" instead, for large reports.
+	// +optional
+	// +kubebuilder:default=ConfigMap
+	Storage ResultsStorage `json:"storage,omitempty"`
+
+	// PersistentVolumeClaim configures the claim created for PersistentVolumeClaim storage.
+	// +optional
+	PersistentVolumeClaim *ResultsVolumeClaim `json:"persistentVolumeClaim,omitempty"`
+
+	// S3 uploads the summary and result files to S3-compatible object storage
+	// when the test finishes, in addition to the storage above.
+	// +optional
+	S3 *ResultsS3Config `json:"s3,omitempty"`
+}
+
+// DefaultResultsS3Prefix is the key prefix of uploaded results when none is set.
+const DefaultResultsS3Prefix = "{namespace}/{name}/{timestamp}"
+
+// ResultsS3Config configures the upload of results to an S3-compatible bucket,
+// such as AWS S3 or MinIO.
+type ResultsS3Config struct {
+	// Endpoint is the URL of the S3 API, e.g. "https://s3.eu-west-1.amazonaws.com"
+	// or "http://minio.minio.svc:9000".
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Endpoint string `json:"endpoint"`
+
+	// Region used to sign requests.
+	// +optional
+	// +kubebuilder:default="us-east-1"
+	Region string `json:"region,omitempty"`
+
+	// Bucket the results are uploaded to. It must already exist.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=3
+	Bucket string `json:"bucket"`
+
+	// Prefix of the uploaded object keys. The placeholders {namespace},
+	// {name} and {timestamp} are replaced by the test's namespace, name and
+	// start time (UTC, e.g. 20261016T020000Z).
+	// +optional
+	// +kubebuilder:default="{namespace}/{name}/{timestamp}"
+	Prefix string `json:"prefix,omitempty"`
+
+	// ForcePathStyle addresses the bucket in the URL path ("<endpoint>/<bucket>/<key>")
+	// instead of the host name. MinIO and most self-hosted stores need it.
+	// +optional
+	ForcePathStyle bool `json:"forcePathStyle,omitempty"`
+
+	// CredentialsSecretRef names a Secret in the test's namespace with the keys
+	// AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY, and optionally AWS_SESSION_TOKEN.
+	// +kubebuilder:validation:Required
+	CredentialsSecretRef corev1.LocalObjectReference `json:"credentialsSecretRef"`
+}
+
+// ResultsVolumeClaim configures the PersistentVolumeClaim holding the result files.
+type ResultsVolumeClaim struct {
+	// Size of the claim.
+	// +optional
+	// +kubebuilder:default="1Gi"
+	Size resource.Quantity `json:"size,omitempty"`
+
+	// StorageClassName of the claim. Uses the cluster default when unset.
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
 }
 
 // ============================================
@@ -110,6 +463,7 @@ type WorkerSpec struct {
 // ============================================
 
 // TestFilesConfig defines test file mounting configuration.
+// Test files come either from ConfigMaps or from a Git repository.
 type TestFilesConfig struct {
 	// ConfigMapRef is the name of the ConfigMap containing locustfile(s).
 	// +optional
@@ -128,6 +482,49 @@ type TestFilesConfig struct {
 	// +optional
 	// +kubebuilder:default="/opt/locust/lib"
 	LibMountPath string `json:"libMountPath,omitempty"`
+
+	// Git clones the test files from a Git repository instead of ConfigMaps.
+	// +optional
+	Git *GitSource `json:"git,omitempty"`
+
+	// Inline maps file names to their content, e.g. "locustfile.py". The
+	// operator stores them in an owned, immutable ConfigMap mounted at
+	// SrcMountPath, like ConfigMapRef. Limited to 1MiB in total.
+	// +optional
+	Inline map[string]string `json:"inline,omitempty"`
+}
+
+// GitSource is a Git repository holding locustfiles and libraries. An init
+// container clones it into an emptyDir in every pod; the directories at Path
+// and LibPath are mounted at srcMountPath and libMountPath.
+type GitSource struct {
+	// URL of the repository, e.g. "https://github.com/org/load-tests.git"
+	// or "git@github.com:org/load-tests.git".
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	URL string `json:"url"`
+
+	// Revision to check out: a branch, tag or commit SHA. Defaults to the
+	// repository's default branch. The master resolves it; the workers clone
+	// the commit the master cloned, even if the branch or tag moved since.
+	// +optional
+	Revision string `json:"revision,omitempty"`
+
+	// Path of the directory holding the locustfiles, relative to the
+	// repository root. Defaults to the root.
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// LibPath of the directory holding library files, relative to the
+	// repository root. Not mounted when unset.
+	// +optional
+	LibPath string `json:"libPath,omitempty"`
+
+	// SecretRef names a Secret with the credentials to clone: "ssh-privatekey"
+	// (and optionally "known_hosts") for SSH URLs, or "password" holding a
+	// token (and optionally "username") for HTTPS URLs.
+	// +optional
+	SecretRef *corev1.LocalObjectReference `json:"secretRef,omitempty"`
 }
 
 // ============================================
@@ -261,8 +658,99 @@ type ObservabilityConfig struct {
 	// OpenTelemetry configuration for native Locust OTel integration.
 	// +optional
 	OpenTelemetry *OpenTelemetryConfig `json:"openTelemetry,omitempty"`
+
+	// MetricsExporter selects how the Locust metrics are exported to
+	// Prometheus when OpenTelemetry is disabled. Defaults to the operator's
+	// METRICS_EXPORTER_MODE.
+	// +optional
+	MetricsExporter MetricsExporterMode `json:"metricsExporter,omitempty"`
+
+	// Prometheus creates Prometheus Operator monitors scraping the metrics
+	// exporter sidecar. Requires the Prometheus Operator CRDs.
+	// +optional
+	Prometheus *PrometheusConfig `json:"prometheus,omitempty"`
+}
+
+// PrometheusConfig defines the Prometheus Operator monitors of a test.
+type PrometheusConfig struct {
+	// ServiceMonitor scrapes the metrics port of the master Service.
+	// +optional
+	ServiceMonitor *PrometheusMonitorConfig `json:"serviceMonitor,omitempty"`
+
+	// PodMonitor scrapes the metrics exporter sidecar of the master pod.
+	// +optional
+	PodMonitor *PrometheusMonitorConfig `json:"podMonitor,omitempty"`
+}
+
+// PrometheusMonitorConfig defines a ServiceMonitor or PodMonitor.
+type PrometheusMonitorConfig struct {
+	// Labels added to the monitor, e.g. to match the monitor selector of a Prometheus.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Interval between scrapes, e.g. "30s". Defaults to the Prometheus scrape interval.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^(0|(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?)$`
+	Interval string `json:"interval,omitempty"`
+
+	// ScrapeTimeout of a scrape, e.g. "10s". Defaults to the Prometheus scrape timeout.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^(0|(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?)$`
+	ScrapeTimeout string `json:"scrapeTimeout,omitempty"`
+
+	// Relabelings applied to the target's labels before scraping.
+	// +optional
+	// +listType=atomic
+	Relabelings []PrometheusRelabelConfig `json:"relabelings,omitempty"`
+}
+
+// PrometheusRelabelConfig is a Prometheus relabeling rule.
+type PrometheusRelabelConfig struct {
+	// SourceLabels whose values are concatenated with Separator and matched against Regex.
+	// +optional
+	// +listType=atomic
+	SourceLabels []string `json:"sourceLabels,omitempty"`
+
+	// Separator placed between the source label values. Defaults to ";".
+	// +optional
+	Separator *string `json:"separator,omitempty"`
+
+	// TargetLabel the result is written to, for the replace, hashmod,
+	// lowercase and uppercase actions.
+	// +optional
+	TargetLabel string `json:"targetLabel,omitempty"`
+
+	// Regex matched against the concatenated source label values. Defaults to "(.*)".
+	// +optional
+	Regex string `json:"regex,omitempty"`
+
+	// Modulus taken of the hash of the source label values, for the hashmod action.
+	// +optional
+	Modulus uint64 `json:"modulus,omitempty"`
+
+	// Replacement written to TargetLabel when Regex matches. Defaults to "$1".
+	// +optional
+	Replacement *string `json:"replacement,omitempty"`
+
+	// Action to perform. Defaults to replace.
+	// +optional
+	// +kubebuilder:validation:Enum=replace;keep;drop;hashmod;labelmap;labeldrop;labelkeep;lowercase;uppercase;keepequal;dropequal
+	Action string `json:"action,omitempty"`
 }
 
+// MetricsExporterMode selects how the Locust metrics of a test are exported.
+// +kubebuilder:validation:Enum=Sidecar;Builtin
+type MetricsExporterMode string
+
+const (
+	// MetricsExporterSidecar runs the locust_exporter sidecar next to the
+	// master, serving the metrics on the master Service.
+	MetricsExporterSidecar MetricsExporterMode = "Sidecar"
+	// MetricsExporterBuiltin exports the metrics from the operator's metrics
+	// endpoint, from the statistics it reads from the master.
+	MetricsExporterBuiltin MetricsExporterMode = "Builtin"
+)
+
 // OpenTelemetryConfig defines OpenTelemetry integration settings.
 type OpenTelemetryConfig struct {
 	// Enabled enables OpenTelemetry integration.
@@ -291,6 +779,232 @@ type OpenTelemetryConfig struct {
 	ExtraEnvVars map[string]string `json:"extraEnvVars,omitempty"`
 }
 
+// WebUIConfig exposes the master's web UI so a running test can be watched
+// without port-forwarding.
+type WebUIConfig struct {
+	// Enabled creates a Service for the web UI, and the Ingress or HTTPRoute when configured.
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled"`
+
+	// ServiceType of the web UI Service.
+	// +optional
+	// +kubebuilder:validation:Enum=ClusterIP;NodePort;LoadBalancer
+	// +kubebuilder:default=ClusterIP
+	ServiceType corev1.ServiceType `json:"serviceType,omitempty"`
+
+	// Ingress routes to the web UI through an Ingress controller.
+	// Mutually exclusive with HTTPRoute.
+	// +optional
+	Ingress *WebUIIngressConfig `json:"ingress,omitempty"`
+
+	// HTTPRoute routes to the web UI through a Gateway API Gateway.
+	// Requires the Gateway API CRDs. Mutually exclusive with Ingress.
+	// +optional
+	HTTPRoute *WebUIHTTPRouteConfig `json:"httpRoute,omitempty"`
+}
+
+// WebUIIngressConfig defines the Ingress of the web UI.
+type WebUIIngressConfig struct {
+	// ClassName is the IngressClass to use. Defaults to the cluster's default class.
+	// +optional
+	ClassName *string `json:"className,omitempty"`
+
+	// Host the web UI is served on, e.g. "load-test.example.com".
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Host string `json:"host"`
+
+	// TLSSecretName is the Secret holding the certificate for Host.
+	// Serves the web UI over HTTPS when set.
+	// +optional
+	TLSSecretName string `json:"tlsSecretName,omitempty"`
+
+	// Annotations added to the Ingress, e.g. for the Ingress controller or cert-manager.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// WebUIHTTPRouteConfig defines the Gateway API HTTPRoute of the web UI.
+type WebUIHTTPRouteConfig struct {
+	// ParentRefs are the Gateways the route attaches to.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=atomic
+	ParentRefs []GatewayParentReference `json:"parentRefs"`
+
+	// Hostnames the route matches. The first one is published in status.webUIURL.
+	// +optional
+	// +listType=atomic
+	Hostnames []string `json:"hostnames,omitempty"`
+}
+
+// GatewayParentReference identifies a Gateway, or one of its listeners, an HTTPRoute attaches to.
+type GatewayParentReference struct {
+	// Name of the Gateway.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Namespace of the Gateway. Defaults to the LocustTest's namespace.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// SectionName is the name of the Gateway listener to attach to.
+	// +optional
+	SectionName string `json:"sectionName,omitempty"`
+}
+
+// QueueKind is the kind of queue a LocustTest waits in.
+// +kubebuilder:validation:Enum=LocustTestQueue;ClusterLocustTestQueue
+type QueueKind string
+
+const (
+	// QueueKindNamespaced is a LocustTestQueue in the test's namespace.
+	QueueKindNamespaced QueueKind = "LocustTestQueue"
+	// QueueKindCluster is a ClusterLocustTestQueue shared by all namespaces.
+	QueueKindCluster QueueKind = "ClusterLocustTestQueue"
+)
+
+// QueueConfig makes the test wait in a queue until it has capacity for it.
+type QueueConfig struct {
+	// Name of the queue.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Kind of the queue. A LocustTestQueue must be in the test's namespace.
+	// +optional
+	// +kubebuilder:default=LocustTestQueue
+	Kind QueueKind `json:"kind,omitempty"`
+
+	// Priority orders the queued tests: higher priorities are admitted
+	// first, and tests of the same priority in creation order.
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+}
+
+// QueueKind returns the kind of queue, defaulting to a LocustTestQueue.
+func (q *QueueConfig) QueueKind() QueueKind {
+	if q.Kind == "" {
+		return QueueKindNamespaced
+	}
+	return q.Kind
+}
+
+// ============================================
+// NOTIFICATIONS
+// ============================================
+
+// NotificationsConfig defines who is told when the test starts and ends.
+type NotificationsConfig struct {
+	// Webhooks receive a CloudEvent when the test changes phase.
+	// +optional
+	// +listType=atomic
+	Webhooks []NotificationWebhook `json:"webhooks,omitempty"`
+}
+
+// NotificationWebhook is an HTTP endpoint receiving phase transitions as
+// CloudEvents in structured JSON mode.
+type NotificationWebhook struct {
+	// URL the events are POSTed to. Exactly one of URL and URLSecretRef is required.
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// URLSecretRef reads the URL from a Secret in the test's namespace, for
+	// URLs embedding a token such as chat incoming webhooks.
+	// +optional
+	URLSecretRef *corev1.SecretKeySelector `json:"urlSecretRef,omitempty"`
+
+	// Phases the webhook is notified of. Defaults to all of them.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:items:Enum=Running;Succeeded;Failed;Cancelled;Aborted
+	Phases []Phase `json:"phases,omitempty"`
+}
+
+// ============================================
+// INTEGRATIONS
+// ============================================
+
+// IntegrationsConfig connects the test to external systems.
+type IntegrationsConfig struct {
+	// Grafana annotates dashboards with the run of the test.
+	// +optional
+	Grafana *GrafanaIntegration `json:"grafana,omitempty"`
+
+	// Kafka injects the KAFKA_* connection settings into the Locust containers.
+	// +optional
+	Kafka *KafkaIntegration `json:"kafka,omitempty"`
+}
+
+// KafkaIntegration configures the Kafka cluster the locustfile connects to,
+// exposed as KAFKA_* environment variables. Unset fields default to the
+// operator's Kafka configuration. Credentials are always read from Secrets
+// by the kubelet, so they never appear in the Job spec.
+type KafkaIntegration struct {
+	// BootstrapServers is the comma-separated list of brokers, e.g.
+	// "broker-1:9092,broker-2:9092". Defaults to the operator's KAFKA_BOOTSTRAP_SERVERS.
+	// +optional
+	BootstrapServers string `json:"bootstrapServers,omitempty"`
+
+	// SecurityEnabled tells the locustfile to authenticate.
+	// Defaults to the operator's KAFKA_SECURITY_ENABLED.
+	// +optional
+	SecurityEnabled *bool `json:"securityEnabled,omitempty"`
+
+	// SecurityProtocol is the protocol used to talk to the brokers.
+	// Defaults to the operator's KAFKA_SECURITY_PROTOCOL_CONFIG.
+	// +optional
+	// +kubebuilder:validation:Enum=PLAINTEXT;SSL;SASL_PLAINTEXT;SASL_SSL
+	SecurityProtocol string `json:"securityProtocol,omitempty"`
+
+	// SaslMechanism is the SASL mechanism, e.g. "SCRAM-SHA-512".
+	// Defaults to the operator's KAFKA_SASL_MECHANISM.
+	// +optional
+	SaslMechanism string `json:"saslMechanism,omitempty"`
+
+	// UsernameSecretRef is the key of a Secret in the test's namespace holding
+	// the SASL username. Defaults to the operator's Kafka credentials Secret.
+	// +optional
+	UsernameSecretRef *corev1.SecretKeySelector `json:"usernameSecretRef,omitempty"`
+
+	// PasswordSecretRef is the key of a Secret in the test's namespace holding
+	// the SASL password. Defaults to the operator's Kafka credentials Secret.
+	// +optional
+	PasswordSecretRef *corev1.SecretKeySelector `json:"passwordSecretRef,omitempty"`
+
+	// SaslJaasConfigSecretRef is the key of a Secret in the test's namespace
+	// holding a raw JAAS configuration. Defaults to the operator's Kafka
+	// credentials Secret.
+	// +optional
+	SaslJaasConfigSecretRef *corev1.SecretKeySelector `json:"saslJaasConfigSecretRef,omitempty"`
+}
+
+// GrafanaIntegration posts an annotation region spanning the run of the test
+// to Grafana, tagged with its namespace, name and image.
+type GrafanaIntegration struct {
+	// URL of Grafana, e.g. "https://grafana.example.com".
+	// Defaults to the operator's GRAFANA_URL.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^https?://`
+	URL string `json:"url,omitempty"`
+
+	// APITokenSecretRef is the key of a Secret in the test's namespace holding
+	// a Grafana service account token allowed to write annotations.
+	// Defaults to the operator's GRAFANA_API_TOKEN when url is unset.
+	// +optional
+	APITokenSecretRef *corev1.SecretKeySelector `json:"apiTokenSecretRef,omitempty"`
+
+	// DashboardUID shows the annotation on this dashboard only. By default it
+	// is an organization annotation, shown on dashboards querying it by tag.
+	// +optional
+	DashboardUID string `json:"dashboardUID,omitempty"`
+
+	// Tags added to the namespace, test name and image tags.
+	// +optional
+	// +listType=atomic
+	Tags []string `json:"tags,omitempty"`
+}
+
 // ============================================
 // STATUS
 // ============================================
@@ -298,7 +1012,7 @@ type OpenTelemetryConfig struct {
 // LocustTestStatus defines the observed state of LocustTest.
 type LocustTestStatus struct {
 	// Phase is the current lifecycle phase of the test.
-	// +kubebuilder:validation:Enum=Pending;Running;Succeeded;Failed
+	// +kubebuilder:validation:Enum=Queued;Pending;Suspended;Scheduled;Running;Succeeded;Failed;Cancelled;Aborted
 	// +optional
 	Phase Phase `json:"phase,omitempty"`
 
@@ -310,13 +1024,72 @@ type LocustTestStatus struct {
 	// +optional
 	ExpectedWorkers int32 `json:"expectedWorkers,omitempty"`
 
-	// ConnectedWorkers is the approximate number of connected workers,
-	// derived from the worker Job's Active pod count (Job.Status.Active).
-	// This is an approximation as Kubernetes Job.Status.Active may lag behind
-	// actual Locust worker connections.
+	// WorkerReplicas is the number of active pods of the worker Job of
+	// spec.worker, read by the scale subresource.
+	// +optional
+	WorkerReplicas int32 `json:"workerReplicas,omitempty"`
+
+	// WorkerSelector selects the pods of spec.worker, for the scale
+	// subresource.
+	// +optional
+	WorkerSelector string `json:"workerSelector,omitempty"`
+
+	// WorkerAutoscaling is the state of spec.worker.autoscaling, set once
+	// the autoscaler changed the number of workers.
+	// +optional
+	WorkerAutoscaling *WorkerAutoscalingStatus `json:"workerAutoscaling,omitempty"`
+
+	// QueuePosition is the test's position in spec.queue while it is
+	// Queued, starting at 1 for the next test to be admitted.
+	// +optional
+	QueuePosition int32 `json:"queuePosition,omitempty"`
+
+	// LoadProfile summarizes the configured load, e.g. "100 users at 10/s for 10m0s".
+	// +optional
+	LoadProfile string `json:"loadProfile,omitempty"`
+
+	// GitCommit is the commit SHA the master cloned (testFiles.git only).
+	// +optional
+	GitCommit string `json:"gitCommit,omitempty"`
+
+	// WebUIURL is where the master's web UI is reachable (webUI.enabled only).
+	// +optional
+	WebUIURL string `json:"webUIURL,omitempty"`
+
+	// ConnectedWorkers is the number of workers registered with the master,
+	// read from the master's worker list while the test runs. When the master
+	// can't be reached it falls back to the worker Job's Active pod count
+	// (Job.Status.Active), which may count pods whose worker never connected.
 	// +optional
 	ConnectedWorkers int32 `json:"connectedWorkers,omitempty"`
 
+	// Workers lists the workers last reported by the master.
+	// +optional
+	// +listType=atomic
+	Workers []WorkerStatus `json:"workers,omitempty"`
+
+	// WorkerGroups breaks the connected workers down per worker group when
+	// spec.workerGroups is set. The workers of spec.worker are the "default"
+	// group.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	WorkerGroups []WorkerGroupStatus `json:"workerGroups,omitempty"`
+
+	// Stats is a summary of the live statistics read from the master while
+	// the test runs. It keeps the last values read after the test ends.
+	// +optional
+	Stats *LiveStats `json:"stats,omitempty"`
+
+	// Results references the results collected when the test finished.
+	// +optional
+	Results *ResultsStatus `json:"results,omitempty"`
+
+	// GrafanaAnnotationID is the Grafana annotation marking the run of the
+	// test, whose end is set when the test finishes.
+	// +optional
+	GrafanaAnnotationID int64 `json:"grafanaAnnotationID,omitempty"`
+
 	// StartTime is when the test started.
 	// +optional
 	StartTime *metav1.Time `json:"startTime,omitempty"`
@@ -334,6 +1107,138 @@ type LocustTestStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
 }
 
+// WorkerStatus is the state of one worker as reported by the Locust master.
+type WorkerStatus struct {
+	// ID identifies the worker, usually "<hostname>_<random hex>".
+	ID string `json:"id"`
+
+	// State is the worker state, e.g. ready, running or missing.
+	// +optional
+	State string `json:"state,omitempty"`
+
+	// Users is the number of users the worker runs.
+	// +optional
+	Users int32 `json:"users,omitempty"`
+
+	// CPUUsage is the worker's CPU usage in percent, e.g. "37.5".
+	// +optional
+	CPUUsage string `json:"cpuUsage,omitempty"`
+
+	// Group is the worker group the worker belongs to, when
+	// spec.workerGroups is set.
+	// +optional
+	Group string `json:"group,omitempty"`
+}
+
+// WorkerAutoscalingStatus is the state of the worker autoscaler.
+type WorkerAutoscalingStatus struct {
+	// Replicas is the number of workers the autoscaler decided on.
+	Replicas int32 `json:"replicas"`
+
+	// LastScaleTime is when the autoscaler last changed the number of workers.
+	// +optional
+	LastScaleTime *metav1.Time `json:"lastScaleTime,omitempty"`
+
+	// History lists the last scaling decisions, oldest first.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=10
+	History []WorkerScalingDecision `json:"history,omitempty"`
+}
+
+// WorkerScalingDecision is one change of the number of workers made by the
+// autoscaler.
+type WorkerScalingDecision struct {
+	// Time is when the decision was made.
+	Time metav1.Time `json:"time"`
+
+	// From is the number of workers before the decision.
+	From int32 `json:"from"`
+
+	// To is the number of workers after the decision.
+	To int32 `json:"to"`
+
+	// CPUUsage is the workers' average CPU usage in percent that led to the
+	// decision, e.g. "92.5".
+	CPUUsage string `json:"cpuUsage"`
+}
+
+// WorkerGroupStatus is the number of workers of one worker group.
+type WorkerGroupStatus struct {
+	// Name is the worker group, "default" for spec.worker.
+	Name string `json:"name"`
+
+	// Expected is the number of workers in the group.
+	// +optional
+	Expected int32 `json:"expected,omitempty"`
+
+	// Connected is the number of the group's workers registered with the
+	// master, counted like connectedWorkers.
+	// +optional
+	Connected int32 `json:"connected,omitempty"`
+}
+
+// LiveStats summarizes the statistics reported by the Locust master.
+type LiveStats struct {
+	// State is the runner state reported by Locust, e.g. spawning, running or stopped.
+	// +optional
+	State string `json:"state,omitempty"`
+
+	// Users is the current number of simulated users.
+	// +optional
+	Users int32 `json:"users,omitempty"`
+
+	// RPS is the current total requests per second, e.g. "152.3".
+	// +optional
+	RPS string `json:"rps,omitempty"`
+
+	// FailureRatio is the ratio of failed requests since the test started,
+	// from 0 to 1, e.g. "0.0125".
+	// +optional
+	FailureRatio string `json:"failureRatio,omitempty"`
+
+	// MedianResponseTime is the current median response time.
+	// +optional
+	MedianResponseTime *metav1.Duration `json:"medianResponseTime,omitempty"`
+
+	// P95ResponseTime is the current 95th percentile response time.
+	// +optional
+	P95ResponseTime *metav1.Duration `json:"p95ResponseTime,omitempty"`
+
+	// LastUpdateTime is when the statistics were read from the master.
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// ResultsStatus references the collected results of a test.
+type ResultsStatus struct {
+	// ConfigMap holding the summary and, with ConfigMap storage, the result files.
+	// +optional
+	ConfigMap string `json:"configMap,omitempty"`
+
+	// PersistentVolumeClaim holding the result files with PersistentVolumeClaim storage.
+	// +optional
+	PersistentVolumeClaim string `json:"persistentVolumeClaim,omitempty"`
+
+	// Files lists the collected result files.
+	// +optional
+	// +listType=atomic
+	Files []string `json:"files,omitempty"`
+
+	// CollectionTime is when the results were collected.
+	// +optional
+	CollectionTime *metav1.Time `json:"collectionTime,omitempty"`
+
+	// URLs of the objects uploaded to spec.results.s3.
+	// +optional
+	// +listType=atomic
+	URLs []string `json:"urls,omitempty"`
+
+	// Message describes results that couldn't be collected.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
 // ============================================
 // SPEC
 // ============================================
@@ -362,6 +1267,48 @@ type LocustTestSpec struct {
 	// +kubebuilder:validation:Required
 	Worker WorkerSpec `json:"worker"`
 
+	// WorkerGroups run more workers, each group in its own Job, next to the
+	// workers of spec.worker. The master expects the workers of all groups.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=20
+	WorkerGroups []WorkerGroup `json:"workerGroups,omitempty"`
+
+	// Load configures the number of users, spawn rate and run time,
+	// or a staged load profile.
+	// +optional
+	Load *LoadConfig `json:"load,omitempty"`
+
+	// MaxDuration is the longest the test may run. Past it, the Jobs are
+	// ended and the test fails with the TimedOut reason. Defaults to the
+	// operator's default maximum duration and is capped at its limit.
+	// +optional
+	MaxDuration *metav1.Duration `json:"maxDuration,omitempty"`
+
+	// Suspend creates the Jobs suspended, so the generated resources can be
+	// checked before the test runs. Set it to false to start the test.
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+
+	// StartAt is when the test starts, in RFC 3339 format, e.g.
+	// "2026-10-20T09:00:00Z". The Jobs are created suspended until then.
+	// +optional
+	StartAt *metav1.Time `json:"startAt,omitempty"`
+
+	// Queue makes the test wait in a LocustTestQueue or
+	// ClusterLocustTestQueue until the queue has capacity for it.
+	// +optional
+	Queue *QueueConfig `json:"queue,omitempty"`
+
+	// Thresholds the test must meet to succeed.
+	// +optional
+	Thresholds *ThresholdsConfig `json:"thresholds,omitempty"`
+
+	// Results collects the CSV statistics, HTML report and summary when the test finishes.
+	// +optional
+	Results *ResultsConfig `json:"results,omitempty"`
+
 	// TestFiles configuration for locustfile and library mounting.
 	// +optional
 	TestFiles *TestFilesConfig `json:"testFiles,omitempty"`
@@ -389,6 +1336,19 @@ type LocustTestSpec struct {
 	// Observability configuration for metrics and tracing.
 	// +optional
 	Observability *ObservabilityConfig `json:"observability,omitempty"`
+
+	// WebUI exposes the master's web UI through a Service, Ingress or HTTPRoute.
+	// +optional
+	WebUI *WebUIConfig `json:"webUI,omitempty"`
+
+	// Notifications sends the test's phase transitions to HTTP endpoints.
+	// Defaults to the operator's NOTIFICATION_WEBHOOK_URLS.
+	// +optional
+	Notifications *NotificationsConfig `json:"notifications,omitempty"`
+
+	// Integrations connects the test to external systems.
+	// +optional
+	Integrations *IntegrationsConfig `json:"integrations,omitempty"`
 }
 
 // ============================================
@@ -397,11 +1357,17 @@ type LocustTestSpec struct {
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:subresource:scale:specpath=.spec.worker.replicas,statuspath=.status.workerReplicas,selectorpath=.status.workerSelector
 // +kubebuilder:resource:shortName=lotest
 // +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`,description="Current test phase"
 // +kubebuilder:printcolumn:name="Workers",type=integer,JSONPath=`.spec.worker.replicas`,description="Requested worker count"
 // +kubebuilder:printcolumn:name="Connected",type=integer,JSONPath=`.status.connectedWorkers`,description="Connected workers"
+// +kubebuilder:printcolumn:name="RPS",type=string,JSONPath=`.status.stats.rps`,description="Current requests per second"
+// +kubebuilder:printcolumn:name="Failures",type=string,JSONPath=`.status.stats.failureRatio`,description="Ratio of failed requests"
+// +kubebuilder:printcolumn:name="Queue",type=string,JSONPath=`.spec.queue.name`,description="Queue the test waits in",priority=1
+// +kubebuilder:printcolumn:name="Load",type=string,JSONPath=`.status.loadProfile`,description="Configured load profile",priority=1
+// +kubebuilder:printcolumn:name="Web UI",type=string,JSONPath=`.status.webUIURL`,description="Web UI URL",priority=1
 // +kubebuilder:printcolumn:name="Image",type=string,JSONPath=`.spec.image`,priority=1
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
@@ -414,6 +1380,30 @@ type LocustTest struct {
 	Status LocustTestStatus `json:"status,omitempty"`
 }
 
+// AnnotationControl requests an action on a running LocustTest, e.g.
+// "kubectl annotate locusttest my-test locust.io/control=Stop". An annotation
+// rather than a spec field keeps the spec immutable once the test started.
+const AnnotationControl = "locust.io/control"
+
+// ControlAction is the value of the AnnotationControl annotation.
+type ControlAction string
+
+// Control actions.
+const (
+	// ControlStop stops the users, lets autoquit end Locust and collects the
+	// results. The test ends Cancelled.
+	ControlStop ControlAction = "Stop"
+	// ControlAbort deletes the master and worker Jobs immediately, without
+	// collecting results. The test ends Aborted.
+	ControlAbort ControlAction = "Abort"
+)
+
+// ControlAction returns the action requested with the AnnotationControl
+// annotation, or "" if none is.
+func (lt *LocustTest) ControlAction() ControlAction {
+	return ControlAction(lt.Annotations[AnnotationControl])
+}
+
 // +kubebuilder:object:root=true
 
 // LocustTestList contains a list of LocustTest.
@@ -17,10 +17,20 @@ limitations under the License.
 package v2
 
 import (
+	"cmp"
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
 	"strings"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -33,14 +43,45 @@ var locusttestlog = logf.Log.WithName("locusttest-resource")
 const (
 	DefaultSrcMountPath = "/lotest/src"
 	DefaultLibMountPath = "/opt/locust/lib"
+	// LoadShapeMountPath is where the LoadTestShape generated from load.stages is mounted.
+	LoadShapeMountPath = "/lotest/shape"
+	// ResultsMountPath is where the master writes its result files when results are collected.
+	ResultsMountPath = "/lotest/results"
 )
 
 // Reserved volume name constants
 const (
 	reservedVolumeNamePrefix = "secret-"
-	libVolumeName            = "locust-lib"
+	// LibVolumeName is the name of the lib volume.
+	LibVolumeName = "locust-lib"
+	// LoadShapeVolumeName is the name of the generated LoadTestShape volume.
+	LoadShapeVolumeName = "locust-load-shape"
+	// ResultsVolumeName is the name of the volume the master writes its results to.
+	ResultsVolumeName = "locust-results"
+	// GitVolumeName is the name of the volume holding the cloned repository.
+	GitVolumeName = "locust-git"
+	// GitSecretVolumeName is the name of the volume holding the git credentials.
+	GitSecretVolumeName = "locust-git-secret"
 )
 
+// Container names of the operator's own init containers and sidecars
+const (
+	// MetricsExporterContainerName is the name of the metrics exporter sidecar.
+	MetricsExporterContainerName = "locust-metrics-exporter"
+	// ResultsCollectorContainerName is the name of the results collector sidecar.
+	ResultsCollectorContainerName = "locust-results-collector"
+	// GitCloneContainerName is the name of the init container cloning testFiles.git.
+	GitCloneContainerName = "locust-git-clone"
+)
+
+// PodNameLabel is the pod label the operator finds the pods of a test by.
+// podTemplate overrides can't change it.
+const PodNameLabel = "performance-test-pod-name"
+
+// patchDirective is the strategic merge patch key that replaces or deletes
+// what it's set on instead of merging it.
+const patchDirective = "$patch"
+
 // LocustTestCustomValidator handles validation for LocustTest resources.
 type LocustTestCustomValidator struct{}
 
@@ -118,10 +159,10 @@ func getReservedPaths(lt *LocustTest) []string {
 	}
 
 	// Only add paths that are actually in use
-	if lt.Spec.TestFiles != nil && lt.Spec.TestFiles.ConfigMapRef != "" {
+	if tf := lt.Spec.TestFiles; tf != nil && (tf.ConfigMapRef != "" || tf.Git != nil || len(tf.Inline) > 0) {
 		paths = append(paths, srcPath)
 	}
-	if lt.Spec.TestFiles != nil && lt.Spec.TestFiles.LibConfigMapRef != "" {
+	if tf := lt.Spec.TestFiles; tf != nil && (tf.LibConfigMapRef != "" || tf.Git != nil && tf.Git.LibPath != "") {
 		paths = append(paths, libPath)
 	}
 
@@ -131,6 +172,14 @@ func getReservedPaths(lt *LocustTest) []string {
 		paths = []string{srcPath, libPath}
 	}
 
+	if lt.Spec.Load != nil && len(lt.Spec.Load.Stages) > 0 {
+		paths = append(paths, LoadShapeMountPath)
+	}
+
+	if lt.Spec.Results != nil {
+		paths = append(paths, ResultsMountPath)
+	}
+
 	return paths
 }
 
@@ -154,6 +203,36 @@ func validateLocustTest(lt *LocustTest) (admission.Warnings, error) {
 		return nil, err
 	}
 
+	// Validate worker groups
+	if err := validateWorkerGroups(lt); err != nil {
+		return nil, err
+	}
+
+	// Validate worker autoscaling
+	if err := validateWorkerAutoscaling(lt); err != nil {
+		return nil, err
+	}
+
+	// Validate user sidecars and init containers
+	if err := validateUserContainers(lt); err != nil {
+		return nil, err
+	}
+
+	// Validate pod template overrides
+	if err := validatePodTemplates(lt); err != nil {
+		return nil, err
+	}
+
+	// Validate the control annotation
+	if err := validateControl(lt); err != nil {
+		return nil, err
+	}
+
+	// Validate test files source
+	if err := validateTestFiles(lt); err != nil {
+		return nil, err
+	}
+
 	// Validate secret mounts
 	if err := validateSecretMounts(lt); err != nil {
 		return nil, err
@@ -169,9 +248,120 @@ func validateLocustTest(lt *LocustTest) (admission.Warnings, error) {
 		return nil, err
 	}
 
+	// Validate Prometheus Operator monitors
+	if err := validatePrometheusMonitors(lt); err != nil {
+		return nil, err
+	}
+
+	// Validate load profile
+	if err := validateLoad(lt); err != nil {
+		return nil, err
+	}
+
+	// Validate maximum run duration
+	if err := validateMaxDuration(lt); err != nil {
+		return nil, err
+	}
+
+	// Validate thresholds
+	if err := validateThresholds(lt); err != nil {
+		return nil, err
+	}
+
+	// Validate results collection
+	if err := validateResults(lt); err != nil {
+		return nil, err
+	}
+
+	// Validate web UI exposure
+	if err := validateWebUI(lt); err != nil {
+		return nil, err
+	}
+
+	// Validate notification webhooks
+	if err := validateNotifications(lt); err != nil {
+		return nil, err
+	}
+
 	return nil, nil
 }
 
+// gitURLPattern matches the repository URLs git can clone: http(s), ssh and
+// git URLs, and the scp-like "user@host:path" syntax.
+var gitURLPattern = regexp.MustCompile(`^((https?|ssh|git)://[^\s/]+/\S*|[\w.-]+@[\w.-]+:\S+)$`)
+
+// gitRevisionPattern matches branch, tag and commit names. A leading dash is
+// rejected so the revision can't be read as an option by git.
+var gitRevisionPattern = regexp.MustCompile(`^[\w.][\w./-]*$`)
+
+// validateControl validates the action requested with the control annotation.
+func validateControl(lt *LocustTest) error {
+	switch action := lt.ControlAction(); action {
+	case "", ControlStop, ControlAbort:
+		return nil
+	default:
+		return fmt.Errorf("annotation %s must be %s or %s, got %q", AnnotationControl, ControlStop, ControlAbort, action)
+	}
+}
+
+// maxInlineTestFilesSize is the size limit of a ConfigMap, which holds testFiles.inline.
+const maxInlineTestFilesSize = 1024 * 1024
+
+// validateInlineTestFiles validates the files of testFiles.inline.
+func validateInlineTestFiles(tf *TestFilesConfig) error {
+	if tf.ConfigMapRef != "" || tf.Git != nil {
+		return fmt.Errorf("testFiles.inline cannot be combined with testFiles.configMapRef or testFiles.git")
+	}
+
+	size := 0
+	for name, content := range tf.Inline {
+		if errs := validation.IsConfigMapKey(name); len(errs) > 0 {
+			return fmt.Errorf("testFiles.inline file name %q is invalid: %s", name, strings.Join(errs, "; "))
+		}
+		size += len(name) + len(content)
+	}
+	if size > maxInlineTestFilesSize {
+		return fmt.Errorf("testFiles.inline is %d bytes, exceeding the %d byte ConfigMap limit: "+
+			"use testFiles.configMapRef or testFiles.git for larger test files", size, maxInlineTestFilesSize)
+	}
+
+	return nil
+}
+
+// validateTestFiles validates the test files source.
+func validateTestFiles(lt *LocustTest) error {
+	tf := lt.Spec.TestFiles
+	if tf == nil {
+		return nil
+	}
+	if len(tf.Inline) > 0 {
+		if err := validateInlineTestFiles(tf); err != nil {
+			return err
+		}
+	}
+	if tf.Git == nil {
+		return nil
+	}
+	git := tf.Git
+
+	if tf.ConfigMapRef != "" || tf.LibConfigMapRef != "" {
+		return fmt.Errorf("testFiles.git cannot be combined with testFiles.configMapRef or testFiles.libConfigMapRef")
+	}
+	if !gitURLPattern.MatchString(git.URL) {
+		return fmt.Errorf("testFiles.git.url must be an http(s), ssh or git URL, or user@host:path, got %q", git.URL)
+	}
+	if git.Revision != "" && !gitRevisionPattern.MatchString(git.Revision) {
+		return fmt.Errorf("testFiles.git.revision must be a branch, tag or commit SHA, got %q", git.Revision)
+	}
+	for _, dir := range []struct{ field, value string }{{"path", git.Path}, {"libPath", git.LibPath}} {
+		if strings.HasPrefix(dir.value, "/") || slices.Contains(strings.Split(dir.value, "/"), "..") {
+			return fmt.Errorf("testFiles.git.%s must be relative to the repository root, got %q", dir.field, dir.value)
+		}
+	}
+
+	return nil
+}
+
 // validateCRName validates that the CR name won't cause generated resource names to exceed K8s limits.
 // Kubernetes resource names (including Jobs) must be <= 63 characters (DNS label limit).
 // The operator generates names like "{cr-name}-worker", so we need to ensure total length fits.
@@ -190,6 +380,194 @@ func validateCRName(lt *LocustTest) error {
 	return nil
 }
 
+// validateWorkerGroups validates that worker group names are unique, don't
+// use the name of spec.worker's group, and fit the generated Job names in 63
+// characters.
+func validateWorkerGroups(lt *LocustTest) error {
+	const maxK8sNameLen = 63 // Kubernetes DNS label limit
+
+	seen := make(map[string]bool, len(lt.Spec.WorkerGroups))
+	for _, group := range lt.Spec.WorkerGroups {
+		if group.Name == DefaultWorkerGroup {
+			return fmt.Errorf("workerGroups name %q is reserved for spec.worker", group.Name)
+		}
+		if seen[group.Name] {
+			return fmt.Errorf("workerGroups name %q is used more than once", group.Name)
+		}
+		seen[group.Name] = true
+
+		if jobName := GeneratedWorkerGroupName(lt.Name, group.Name); len(jobName) > maxK8sNameLen {
+			return fmt.Errorf(
+				"workerGroups name %q is too long: generated Job name %q would exceed 63 characters",
+				group.Name, jobName,
+			)
+		}
+	}
+
+	return nil
+}
+
+// validatePodTemplates validates the podTemplate overrides of the master and
+// the workers. The Locust container of the worker Job and of every worker
+// group Job is protected by the worker override.
+func validatePodTemplates(lt *LocustTest) error {
+	if err := validatePodTemplate("master.podTemplate", lt.Spec.Master.PodTemplate,
+		GeneratedNodeName(lt.Name, NodeModeMaster)); err != nil {
+		return err
+	}
+
+	return validatePodTemplate("worker.podTemplate", lt.Spec.Worker.PodTemplate, workerLocustContainers(lt)...)
+}
+
+// workerLocustContainers returns the names of the Locust containers of the
+// worker Job and of every worker group Job, which are named after their Job.
+func workerLocustContainers(lt *LocustTest) []string {
+	names := []string{GeneratedNodeName(lt.Name, NodeModeWorker)}
+	for _, group := range lt.Spec.WorkerGroups {
+		names = append(names, GeneratedWorkerGroupName(lt.Name, group.Name))
+	}
+	return names
+}
+
+// validateUserContainers validates the sidecars and init containers of the
+// master and the workers, which the CRD schema leaves unchecked (see
+// Container), and that they don't reuse the name of a container the
+// operator adds to the same pods.
+func validateUserContainers(lt *LocustTest) error {
+	if err := validateContainers("master", lt.Spec.Master.Sidecars, lt.Spec.Master.InitContainers,
+		GeneratedNodeName(lt.Name, NodeModeMaster)); err != nil {
+		return err
+	}
+	return validateContainers("worker", lt.Spec.Worker.Sidecars, lt.Spec.Worker.InitContainers,
+		workerLocustContainers(lt)...)
+}
+
+// validateContainers checks the sidecars and init containers of one pod, and
+// their names against the operator's containers and against each other.
+func validateContainers(node string, sidecars, initContainers []Container, locustContainers ...string) error {
+	reserved := []string{MetricsExporterContainerName, ResultsCollectorContainerName, GitCloneContainerName}
+	seen := map[string]string{}
+
+	for _, declared := range []struct {
+		field      string
+		containers []Container
+	}{
+		{node + ".initContainers", initContainers},
+		{node + ".sidecars", sidecars},
+	} {
+		field := declared.field
+		for i, container := range declared.containers {
+			if errs := validation.IsDNS1123Label(container.Name); len(errs) > 0 {
+				return fmt.Errorf("%s[%d].name %q is invalid: %s", field, i, container.Name, strings.Join(errs, "; "))
+			}
+			if container.Image == "" {
+				return fmt.Errorf("%s[%d].image is required", field, i)
+			}
+			if slices.Contains(reserved, container.Name) {
+				return fmt.Errorf("%s name %q is reserved by the operator", field, container.Name)
+			}
+			if slices.Contains(locustContainers, container.Name) {
+				return fmt.Errorf("%s name %q conflicts with the Locust container", field, container.Name)
+			}
+			if other, ok := seen[container.Name]; ok {
+				return fmt.Errorf("%s name %q is already used in %s", field, container.Name, other)
+			}
+			seen[container.Name] = field
+		}
+	}
+
+	return nil
+}
+
+// validatePodTemplate validates that a podTemplate override is a pod template
+// and leaves alone the fields the operator relies on: the pod name label, the
+// restart policy and the command and args of the Locust container, named
+// after its Job. Patch directives that would replace the template, its spec
+// or its containers are rejected for the same reason.
+func validatePodTemplate(field string, override *runtime.RawExtension, locustContainers ...string) error {
+	if override == nil || len(override.Raw) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(override.Raw, &corev1.PodTemplateSpec{}); err != nil {
+		return fmt.Errorf("%s is not a valid pod template: %w", field, err)
+	}
+
+	var patch struct {
+		Directive string `json:"$patch"`
+		Metadata  struct {
+			Directive string                     `json:"$patch"`
+			Labels    map[string]json.RawMessage `json:"labels"`
+		} `json:"metadata"`
+		Spec map[string]json.RawMessage `json:"spec"`
+	}
+	if err := json.Unmarshal(override.Raw, &patch); err != nil {
+		return fmt.Errorf("%s is not a valid pod template: %w", field, err)
+	}
+
+	if patch.Directive != "" || patch.Metadata.Directive != "" || patch.Spec[patchDirective] != nil {
+		return fmt.Errorf("%s can't use %q on the pod template, its metadata or its spec", field, patchDirective)
+	}
+	if _, ok := patch.Metadata.Labels[PodNameLabel]; ok {
+		return fmt.Errorf("%s can't override the %q label", field, PodNameLabel)
+	}
+	if _, ok := patch.Metadata.Labels[patchDirective]; ok {
+		return fmt.Errorf("%s can't use %q on the pod labels", field, patchDirective)
+	}
+	if _, ok := patch.Spec["restartPolicy"]; ok {
+		return fmt.Errorf("%s can't override the restart policy", field)
+	}
+
+	var containers []map[string]json.RawMessage
+	if raw, ok := patch.Spec["containers"]; ok {
+		if err := json.Unmarshal(raw, &containers); err != nil {
+			return fmt.Errorf("%s is not a valid pod template: %w", field, err)
+		}
+	}
+	for _, container := range containers {
+		if _, ok := container[patchDirective]; ok {
+			return fmt.Errorf("%s can't use %q on containers", field, patchDirective)
+		}
+
+		var name string
+		_ = json.Unmarshal(container["name"], &name)
+		if !slices.Contains(locustContainers, name) {
+			continue
+		}
+		for _, key := range []string{"command", "args"} {
+			if _, ok := container[key]; ok {
+				return fmt.Errorf("%s can't override the %s of the Locust container %q", field, key, name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateWorkerAutoscaling validates that the worker replicas lie within the
+// autoscaling bounds and that the cooldown is positive.
+func validateWorkerAutoscaling(lt *LocustTest) error {
+	autoscaling := lt.Spec.Worker.Autoscaling
+	if autoscaling == nil {
+		return nil
+	}
+
+	minReplicas := max(autoscaling.MinReplicas, 1)
+	if minReplicas > autoscaling.MaxReplicas {
+		return fmt.Errorf("worker.autoscaling.minReplicas %d is more than maxReplicas %d",
+			minReplicas, autoscaling.MaxReplicas)
+	}
+	if replicas := lt.Spec.Worker.Replicas; replicas < minReplicas || replicas > autoscaling.MaxReplicas {
+		return fmt.Errorf("worker.replicas %d must be between worker.autoscaling.minReplicas %d and maxReplicas %d",
+			replicas, minReplicas, autoscaling.MaxReplicas)
+	}
+	if autoscaling.Cooldown != nil && autoscaling.Cooldown.Duration <= 0 {
+		return fmt.Errorf("worker.autoscaling.cooldown must be positive, got %s", autoscaling.Cooldown.Duration)
+	}
+
+	return nil
+}
+
 // validateOTelConfig validates OpenTelemetry configuration.
 func validateOTelConfig(lt *LocustTest) error {
 	if lt.Spec.Observability == nil {
@@ -209,6 +587,385 @@ func validateOTelConfig(lt *LocustTest) error {
 	return nil
 }
 
+// relabelActionsWithTarget are the relabeling actions writing, or comparing
+// against, targetLabel; "" is the default replace action.
+var relabelActionsWithTarget = []string{"", "replace", "hashmod", "lowercase", "uppercase", "keepequal", "dropequal"}
+
+// validatePrometheusMonitors rejects monitors of a test without the metrics
+// exporter sidecar they scrape, and relabelings Prometheus would reject.
+func validatePrometheusMonitors(lt *LocustTest) error {
+	if lt.Spec.Observability == nil || lt.Spec.Observability.Prometheus == nil {
+		return nil
+	}
+	obs := lt.Spec.Observability
+
+	monitors := []struct {
+		field   string
+		monitor *PrometheusMonitorConfig
+	}{
+		{"serviceMonitor", obs.Prometheus.ServiceMonitor},
+		{"podMonitor", obs.Prometheus.PodMonitor},
+	}
+	for _, m := range monitors {
+		if m.monitor == nil {
+			continue
+		}
+		field := "observability.prometheus." + m.field
+		if obs.OpenTelemetry != nil && obs.OpenTelemetry.Enabled {
+			return fmt.Errorf("%s requires the metrics exporter sidecar, which OpenTelemetry replaces", field)
+		}
+		if obs.MetricsExporter == MetricsExporterBuiltin {
+			return fmt.Errorf("%s requires the metrics exporter sidecar; with metricsExporter %q the metrics are served by the operator",
+				field, MetricsExporterBuiltin)
+		}
+		for i, rule := range m.monitor.Relabelings {
+			if rule.TargetLabel == "" && slices.Contains(relabelActionsWithTarget, rule.Action) {
+				return fmt.Errorf("%s.relabelings[%d].targetLabel is required for the %s action", field, i, cmp.Or(rule.Action, "replace"))
+			}
+			if rule.Action == "hashmod" && rule.Modulus == 0 {
+				return fmt.Errorf("%s.relabelings[%d].modulus is required for the hashmod action", field, i)
+			}
+		}
+	}
+
+	return nil
+}
+
+// maxLoadDuration bounds the total duration of a staged load profile.
+const maxLoadDuration = 7 * 24 * time.Hour
+
+// loadFlags maps each load field to the Locust flags it sets.
+var loadFlags = []struct {
+	field, short, long string
+}{
+	{"users", "-u", "--users"},
+	{"spawnRate", "-r", "--spawn-rate"},
+	{"runTime", "-t", "--run-time"},
+}
+
+// validateLoad validates the load profile and rejects flags in the master
+// command or extraArgs that would silently override it.
+func validateLoad(lt *LocustTest) error {
+	load := lt.Spec.Load
+	if load == nil {
+		return nil
+	}
+
+	masterArgs := append(strings.Fields(lt.Spec.Master.Command), lt.Spec.Master.ExtraArgs...)
+
+	if len(load.Stages) > 0 {
+		if err := validateLoadStages(load); err != nil {
+			return err
+		}
+		if lt.Spec.TestFiles != nil {
+			for _, path := range []string{lt.Spec.TestFiles.SrcMountPath, lt.Spec.TestFiles.LibMountPath} {
+				if path != "" && PathConflicts(path, LoadShapeMountPath) {
+					return fmt.Errorf("testFiles mount path %q conflicts with reserved path %q; "+
+						"operator uses this path for the load shape generated from load.stages", path, LoadShapeMountPath)
+				}
+			}
+		}
+		if !hasLocustfileFlag(masterArgs) {
+			return fmt.Errorf("load.stages requires the master command to set -f/--locustfile, " +
+				"so the generated load shape can be added to it")
+		}
+	} else {
+		if load.Users == nil && load.SpawnRate == nil && load.RunTime == nil {
+			return fmt.Errorf("load must set users, spawnRate, runTime or stages")
+		}
+		if load.Users != nil && *load.Users < 1 {
+			return fmt.Errorf("load.users must be at least 1, got %d", *load.Users)
+		}
+		if load.SpawnRate != nil && load.SpawnRate.Sign() <= 0 {
+			return fmt.Errorf("load.spawnRate must be positive, got %s", load.SpawnRate.String())
+		}
+		if load.RunTime != nil && load.RunTime.Duration < time.Second {
+			return fmt.Errorf("load.runTime must be at least 1s, got %s", load.RunTime.Duration)
+		}
+	}
+
+	for _, flag := range loadFlags {
+		for _, arg := range masterArgs {
+			if setsFlag(arg, flag.short, flag.long) {
+				return fmt.Errorf("master command sets %q, which conflicts with load; "+
+					"remove it and use load.%s or load.stages instead", arg, flag.field)
+			}
+		}
+	}
+
+	return nil
+}
+
+// setsFlag reports whether arg sets a flag, given on its own or with its
+// value attached: "--users=10", "-u=10" or "-u10". The values of the load
+// flags start with a digit or a dot, so "-uFoo" isn't taken for "-u".
+func setsFlag(arg, short, long string) bool {
+	if arg == short || arg == long || strings.HasPrefix(arg, long+"=") || strings.HasPrefix(arg, short+"=") {
+		return true
+	}
+	value, ok := strings.CutPrefix(arg, short)
+	return ok && value != "" && (value[0] == '.' || (value[0] >= '0' && value[0] <= '9'))
+}
+
+// validateMaxDuration checks that maxDuration is positive and leaves the
+// configured load time to run.
+func validateMaxDuration(lt *LocustTest) error {
+	if lt.Spec.MaxDuration == nil {
+		return nil
+	}
+	maxDuration := lt.Spec.MaxDuration.Duration
+	if maxDuration <= 0 {
+		return fmt.Errorf("maxDuration must be positive, got %s", maxDuration)
+	}
+
+	if load := lt.Spec.Load; load != nil {
+		var runTime time.Duration
+		if load.RunTime != nil {
+			runTime = load.RunTime.Duration
+		}
+		for _, stage := range load.Stages {
+			runTime += stage.Duration.Duration
+		}
+		if runTime > maxDuration {
+			return fmt.Errorf("maxDuration %s is shorter than the %s of load configured; the test would always time out",
+				maxDuration, runTime)
+		}
+	}
+	return nil
+}
+
+// validateLoadStages validates a staged load profile.
+func validateLoadStages(load *LoadConfig) error {
+	if load.Users != nil || load.SpawnRate != nil || load.RunTime != nil {
+		return fmt.Errorf("load.stages cannot be combined with load.users, load.spawnRate or load.runTime; " +
+			"the stages define the load and the test ends after the last one")
+	}
+
+	var total time.Duration
+	for i, stage := range load.Stages {
+		if stage.Duration.Duration < time.Second {
+			return fmt.Errorf("load.stages[%d].duration must be at least 1s, got %s", i, stage.Duration.Duration)
+		}
+		if stage.Users < 0 {
+			return fmt.Errorf("load.stages[%d].users must not be negative, got %d", i, stage.Users)
+		}
+		if stage.SpawnRate.Sign() <= 0 {
+			return fmt.Errorf("load.stages[%d].spawnRate must be positive, got %s", i, stage.SpawnRate.String())
+		}
+		total += stage.Duration.Duration
+	}
+
+	if total > maxLoadDuration {
+		return fmt.Errorf("total duration of load.stages is %s, which exceeds the maximum of %s", total, maxLoadDuration)
+	}
+
+	return nil
+}
+
+// hasLocustfileFlag reports whether args name a locustfile.
+func hasLocustfileFlag(args []string) bool {
+	for i, arg := range args {
+		if ((arg == "-f" || arg == "--locustfile") && i+1 < len(args)) || strings.HasPrefix(arg, "--locustfile=") {
+			return true
+		}
+	}
+	return false
+}
+
+// validateThresholds validates the configured thresholds.
+func validateThresholds(lt *LocustTest) error {
+	th := lt.Spec.Thresholds
+	if th == nil {
+		return nil
+	}
+
+	if !th.ThresholdLimits.isSet() && len(th.Endpoints) == 0 {
+		return fmt.Errorf("thresholds must set at least one limit")
+	}
+	if err := th.ThresholdLimits.validate("thresholds"); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(th.Endpoints))
+	for i, ep := range th.Endpoints {
+		field := fmt.Sprintf("thresholds.endpoints[%d]", i)
+		key := ep.Method + " " + ep.Name
+		if seen[key] {
+			return fmt.Errorf("%s: duplicate endpoint %q", field, strings.TrimSpace(key))
+		}
+		seen[key] = true
+
+		if !ep.ThresholdLimits.isSet() {
+			return fmt.Errorf("%s: endpoint %q must set at least one limit", field, ep.Name)
+		}
+		if err := ep.ThresholdLimits.validate(field); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isSet reports whether any limit is configured.
+func (l ThresholdLimits) isSet() bool {
+	return l.MaxFailureRatio != nil || l.MaxP95ResponseTime != nil || l.MaxP99ResponseTime != nil || l.MinRPS != nil
+}
+
+// validate checks that the configured limits are in range.
+func (l ThresholdLimits) validate(field string) error {
+	if l.MaxFailureRatio != nil {
+		if l.MaxFailureRatio.Sign() < 0 || l.MaxFailureRatio.Cmp(resource.MustParse("1")) > 0 {
+			return fmt.Errorf("%s.maxFailureRatio must be between 0 and 1, got %s", field, l.MaxFailureRatio.String())
+		}
+	}
+	if l.MaxP95ResponseTime != nil && l.MaxP95ResponseTime.Duration <= 0 {
+		return fmt.Errorf("%s.maxP95ResponseTime must be positive, got %s", field, l.MaxP95ResponseTime.Duration)
+	}
+	if l.MaxP99ResponseTime != nil && l.MaxP99ResponseTime.Duration <= 0 {
+		return fmt.Errorf("%s.maxP99ResponseTime must be positive, got %s", field, l.MaxP99ResponseTime.Duration)
+	}
+	if l.MinRPS != nil && l.MinRPS.Sign() <= 0 {
+		return fmt.Errorf("%s.minRPS must be positive, got %s", field, l.MinRPS.String())
+	}
+	return nil
+}
+
+// resultsFlags are the Locust flags the operator sets when results are collected.
+var resultsFlags = []string{"--csv", "--html", "--json-file"}
+
+// resultsS3Placeholder matches the placeholders of results.s3.prefix.
+var resultsS3Placeholder = regexp.MustCompile(`\{[^{}]*\}`)
+
+// resultsS3Placeholders are the placeholders results.s3.prefix may use.
+var resultsS3Placeholders = map[string]bool{"{namespace}": true, "{name}": true, "{timestamp}": true}
+
+// validateResults validates results collection and rejects flags in the
+// master command or extraArgs that would write the result files elsewhere.
+func validateResults(lt *LocustTest) error {
+	res := lt.Spec.Results
+	if res == nil {
+		return nil
+	}
+
+	if res.PersistentVolumeClaim != nil {
+		if res.Storage != ResultsStoragePersistentVolumeClaim {
+			return fmt.Errorf("results.persistentVolumeClaim requires results.storage to be %s",
+				ResultsStoragePersistentVolumeClaim)
+		}
+		if res.PersistentVolumeClaim.Size.Sign() < 0 {
+			return fmt.Errorf("results.persistentVolumeClaim.size must not be negative, got %s",
+				res.PersistentVolumeClaim.Size.String())
+		}
+	}
+
+	if lt.Spec.TestFiles != nil {
+		for _, path := range []string{lt.Spec.TestFiles.SrcMountPath, lt.Spec.TestFiles.LibMountPath} {
+			if path != "" && PathConflicts(path, ResultsMountPath) {
+				return fmt.Errorf("testFiles mount path %q conflicts with reserved path %q; "+
+					"operator uses this path for the result files", path, ResultsMountPath)
+			}
+		}
+	}
+
+	if err := validateResultsS3(res.S3); err != nil {
+		return err
+	}
+
+	masterArgs := append(strings.Fields(lt.Spec.Master.Command), lt.Spec.Master.ExtraArgs...)
+	for _, flag := range resultsFlags {
+		for _, arg := range masterArgs {
+			if arg == flag || strings.HasPrefix(arg, flag+"=") {
+				return fmt.Errorf("master command sets %q, which conflicts with results; "+
+					"remove it and use results.formats instead", arg)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateResultsS3 checks the S3 endpoint URL and the placeholders of the key prefix.
+func validateResultsS3(s3 *ResultsS3Config) error {
+	if s3 == nil {
+		return nil
+	}
+
+	endpoint, err := url.Parse(s3.Endpoint)
+	if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+		return fmt.Errorf("results.s3.endpoint must be an http or https URL, got %q", s3.Endpoint)
+	}
+	if endpoint.RawQuery != "" || endpoint.Fragment != "" {
+		return fmt.Errorf("results.s3.endpoint must not have a query or fragment, got %q", s3.Endpoint)
+	}
+
+	for _, placeholder := range resultsS3Placeholder.FindAllString(s3.Prefix, -1) {
+		if !resultsS3Placeholders[placeholder] {
+			return fmt.Errorf("results.s3.prefix has unknown placeholder %s; "+
+				"use {namespace}, {name} or {timestamp}", placeholder)
+		}
+	}
+
+	return nil
+}
+
+// validateWebUI validates the Service, Ingress and HTTPRoute of the web UI.
+func validateWebUI(lt *LocustTest) error {
+	webUI := lt.Spec.WebUI
+	if webUI == nil {
+		return nil
+	}
+
+	if webUI.Ingress != nil && webUI.HTTPRoute != nil {
+		return fmt.Errorf("webUI.ingress and webUI.httpRoute are mutually exclusive")
+	}
+	if !webUI.Enabled && (webUI.Ingress != nil || webUI.HTTPRoute != nil) {
+		return fmt.Errorf("webUI.ingress and webUI.httpRoute require webUI.enabled to be true")
+	}
+
+	if ing := webUI.Ingress; ing != nil {
+		if errs := validation.IsDNS1123Subdomain(ing.Host); len(errs) > 0 {
+			return fmt.Errorf("webUI.ingress.host %q is invalid: %s", ing.Host, strings.Join(errs, "; "))
+		}
+	}
+	if route := webUI.HTTPRoute; route != nil {
+		for _, hostname := range route.Hostnames {
+			errs := validation.IsDNS1123Subdomain(hostname)
+			if strings.HasPrefix(hostname, "*.") {
+				errs = validation.IsWildcardDNS1123Subdomain(hostname)
+			}
+			if len(errs) > 0 {
+				return fmt.Errorf("webUI.httpRoute hostname %q is invalid: %s", hostname, strings.Join(errs, "; "))
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateNotifications validates the webhooks of spec.notifications. URLs
+// read from a Secret are only known when the test changes phase.
+func validateNotifications(lt *LocustTest) error {
+	if lt.Spec.Notifications == nil {
+		return nil
+	}
+
+	for i, webhook := range lt.Spec.Notifications.Webhooks {
+		field := fmt.Sprintf("notifications.webhooks[%d]", i)
+		if (webhook.URL == "") == (webhook.URLSecretRef == nil) {
+			return fmt.Errorf("%s must set exactly one of url and urlSecretRef", field)
+		}
+		if webhook.URL == "" {
+			continue
+		}
+		u, err := url.Parse(webhook.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("%s.url must be an http or https URL, got %q", field, webhook.URL)
+		}
+	}
+
+	return nil
+}
+
 // validateVolumes checks for volume name and mount path conflicts.
 func validateVolumes(lt *LocustTest) error {
 	// Check volume names
@@ -241,8 +998,9 @@ func validateVolumeName(lt *LocustTest, name string) error {
 		return fmt.Errorf("volume name %q uses reserved prefix %q", name, reservedVolumeNamePrefix)
 	}
 
-	// Check for lib volume name
-	if name == libVolumeName {
+	// Check for operator-managed volume names
+	switch name {
+	case LibVolumeName, LoadShapeVolumeName, ResultsVolumeName, GitVolumeName, GitSecretVolumeName:
 		return fmt.Errorf("volume name %q is reserved by the operator", name)
 	}
 
@@ -254,6 +1012,11 @@ func validateVolumeName(lt *LocustTest, name string) error {
 	if name == masterName || name == workerName {
 		return fmt.Errorf("volume name %q conflicts with operator-generated name", name)
 	}
+	for _, group := range lt.Spec.WorkerGroups {
+		if name == GeneratedWorkerGroupName(lt.Name, group.Name) {
+			return fmt.Errorf("volume name %q conflicts with operator-generated name", name)
+		}
+	}
 
 	return nil
 }
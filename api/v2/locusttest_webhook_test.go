@@ -18,12 +18,18 @@ package v2
 
 import (
 	"context"
+	"encoding/json"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 )
 
 func TestPathConflicts_ExactMatch(t *testing.T) {
@@ -248,6 +254,33 @@ func TestGetReservedPaths_CustomPaths(t *testing.T) {
 	assert.NotContains(t, paths, DefaultLibMountPath)
 }
 
+func TestGetReservedPaths_WithGitSource(t *testing.T) {
+	lt := &LocustTest{
+		Spec: LocustTestSpec{
+			TestFiles: &TestFilesConfig{
+				Git: &GitSource{URL: "https://github.com/example/load-tests.git"},
+			},
+		},
+	}
+
+	assert.Equal(t, []string{DefaultSrcMountPath}, getReservedPaths(lt))
+
+	lt.Spec.TestFiles.Git.LibPath = "lib"
+	assert.Equal(t, []string{DefaultSrcMountPath, DefaultLibMountPath}, getReservedPaths(lt))
+}
+
+func TestGetReservedPaths_WithInline(t *testing.T) {
+	lt := &LocustTest{
+		Spec: LocustTestSpec{
+			TestFiles: &TestFilesConfig{
+				Inline: map[string]string{"locustfile.py": "from locust import HttpUser"},
+			},
+		},
+	}
+
+	assert.Equal(t, []string{DefaultSrcMountPath}, getReservedPaths(lt))
+}
+
 func TestValidateCreate(t *testing.T) {
 	validator := &LocustTestCustomValidator{}
 	lt := &LocustTest{
@@ -444,6 +477,17 @@ func TestValidateVolumeName_WorkerConflict(t *testing.T) {
 	assert.Contains(t, err.Error(), "conflicts with operator-generated name")
 }
 
+func TestValidateVolumeName_WorkerGroupConflict(t *testing.T) {
+	lt := &LocustTest{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-test", Namespace: "default"},
+		Spec:       LocustTestSpec{WorkerGroups: []WorkerGroup{{Name: "checkout", Replicas: 1}}},
+	}
+
+	err := validateVolumeName(lt, "my-test-worker-checkout")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "conflicts with operator-generated name")
+}
+
 // Regression test: the operator names its generated ConfigMap volume after the
 // sanitized node name, so for a dotted CR name the conflicting volume is
 // "my-test-v2-master", not "my-test.v2-master". Comparing against the raw CR
@@ -937,3 +981,1158 @@ func TestValidateCreate_LongCRName(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+// ============================================
+// Load Profile Tests
+// ============================================
+
+func newTestLoadLocustTest() *LocustTest {
+	return &LocustTest{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+		Spec: LocustTestSpec{
+			Image: "locustio/locust:2.20.0",
+			Master: MasterSpec{
+				Command: "--locustfile /lotest/src/locustfile.py",
+			},
+			Worker: WorkerSpec{
+				Command:  "--locustfile /lotest/src/locustfile.py",
+				Replicas: 1,
+			},
+		},
+	}
+}
+
+func newTestLoadStage(d time.Duration, users int32, spawnRate string) LoadStage {
+	return LoadStage{
+		Duration:  metav1.Duration{Duration: d},
+		Users:     users,
+		SpawnRate: resource.MustParse(spawnRate),
+	}
+}
+
+func TestValidateLoad_Nil(t *testing.T) {
+	assert.NoError(t, validateLoad(newTestLoadLocustTest()))
+}
+
+func TestValidateLoad_ConstantLoad(t *testing.T) {
+	lt := newTestLoadLocustTest()
+	lt.Spec.Load = &LoadConfig{
+		Users:     ptr.To(int32(100)),
+		SpawnRate: ptr.To(resource.MustParse("500m")),
+		RunTime:   &metav1.Duration{Duration: 10 * time.Minute},
+	}
+
+	assert.NoError(t, validateLoad(lt))
+}
+
+func TestValidateLoad_Stages(t *testing.T) {
+	lt := newTestLoadLocustTest()
+	lt.Spec.Load = &LoadConfig{Stages: []LoadStage{
+		newTestLoadStage(time.Minute, 10, "1"),
+		newTestLoadStage(5*time.Minute, 100, "10"),
+		newTestLoadStage(time.Minute, 0, "10"),
+	}}
+
+	assert.NoError(t, validateLoad(lt))
+}
+
+func TestValidateLoad_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(lt *LocustTest)
+		errMsg string
+	}{
+		{
+			name:   "Empty",
+			mutate: func(lt *LocustTest) { lt.Spec.Load = &LoadConfig{} },
+			errMsg: "load must set users, spawnRate, runTime or stages",
+		},
+		{
+			name:   "ZeroUsers",
+			mutate: func(lt *LocustTest) { lt.Spec.Load = &LoadConfig{Users: ptr.To(int32(0))} },
+			errMsg: "load.users must be at least 1",
+		},
+		{
+			name: "NegativeSpawnRate",
+			mutate: func(lt *LocustTest) {
+				lt.Spec.Load = &LoadConfig{SpawnRate: ptr.To(resource.MustParse("-1"))}
+			},
+			errMsg: "load.spawnRate must be positive",
+		},
+		{
+			name: "SubSecondRunTime",
+			mutate: func(lt *LocustTest) {
+				lt.Spec.Load = &LoadConfig{RunTime: &metav1.Duration{Duration: 500 * time.Millisecond}}
+			},
+			errMsg: "load.runTime must be at least 1s",
+		},
+		{
+			name: "StagesWithUsers",
+			mutate: func(lt *LocustTest) {
+				lt.Spec.Load = &LoadConfig{
+					Users:  ptr.To(int32(10)),
+					Stages: []LoadStage{newTestLoadStage(time.Minute, 10, "1")},
+				}
+			},
+			errMsg: "load.stages cannot be combined",
+		},
+		{
+			name: "StageZeroDuration",
+			mutate: func(lt *LocustTest) {
+				lt.Spec.Load = &LoadConfig{Stages: []LoadStage{newTestLoadStage(0, 10, "1")}}
+			},
+			errMsg: "load.stages[0].duration must be at least 1s",
+		},
+		{
+			name: "StageZeroSpawnRate",
+			mutate: func(lt *LocustTest) {
+				lt.Spec.Load = &LoadConfig{Stages: []LoadStage{
+					newTestLoadStage(time.Minute, 10, "1"),
+					newTestLoadStage(time.Minute, 10, "0"),
+				}}
+			},
+			errMsg: "load.stages[1].spawnRate must be positive",
+		},
+		{
+			name: "StagesTooLong",
+			mutate: func(lt *LocustTest) {
+				lt.Spec.Load = &LoadConfig{Stages: []LoadStage{
+					newTestLoadStage(4*24*time.Hour, 10, "1"),
+					newTestLoadStage(4*24*time.Hour, 10, "1"),
+				}}
+			},
+			errMsg: "exceeds the maximum",
+		},
+		{
+			name: "StagesWithoutLocustfile",
+			mutate: func(lt *LocustTest) {
+				lt.Spec.Master.Command = "--host https://example.com"
+				lt.Spec.Load = &LoadConfig{Stages: []LoadStage{newTestLoadStage(time.Minute, 10, "1")}}
+			},
+			errMsg: "requires the master command to set -f/--locustfile",
+		},
+		{
+			name: "StagesSrcPathConflict",
+			mutate: func(lt *LocustTest) {
+				lt.Spec.TestFiles = &TestFilesConfig{ConfigMapRef: "scripts", SrcMountPath: "/lotest"}
+				lt.Spec.Load = &LoadConfig{Stages: []LoadStage{newTestLoadStage(time.Minute, 10, "1")}}
+			},
+			errMsg: "conflicts with reserved path \"/lotest/shape\"",
+		},
+		{
+			name: "UsersFlagInCommand",
+			mutate: func(lt *LocustTest) {
+				lt.Spec.Master.Command += " -u 100"
+				lt.Spec.Load = &LoadConfig{RunTime: &metav1.Duration{Duration: time.Minute}}
+			},
+			errMsg: "master command sets \"-u\"",
+		},
+		{
+			name: "RunTimeFlagInExtraArgs",
+			mutate: func(lt *LocustTest) {
+				lt.Spec.Master.ExtraArgs = []string{"--run-time=5m"}
+				lt.Spec.Load = &LoadConfig{Users: ptr.To(int32(10))}
+			},
+			errMsg: "master command sets \"--run-time=5m\"",
+		},
+		{
+			name: "SpawnRateShortFlagInStagesMode",
+			mutate: func(lt *LocustTest) {
+				lt.Spec.Master.ExtraArgs = []string{"-r5"}
+				lt.Spec.Load = &LoadConfig{Stages: []LoadStage{newTestLoadStage(time.Minute, 10, "1")}}
+			},
+			errMsg: "master command sets \"-r5\"",
+		},
+		{
+			name: "RunTimeShortFlagWithEquals",
+			mutate: func(lt *LocustTest) {
+				lt.Spec.Master.ExtraArgs = []string{"-t=5m"}
+				lt.Spec.Load = &LoadConfig{Users: ptr.To(int32(10))}
+			},
+			errMsg: "master command sets \"-t=5m\"",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lt := newTestLoadLocustTest()
+			tt.mutate(lt)
+
+			err := validateLoad(lt)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestSetsFlag(t *testing.T) {
+	tests := []struct {
+		arg  string
+		want bool
+	}{
+		{"-u", true},
+		{"--users", true},
+		{"--users=10", true},
+		{"-u=10", true},
+		{"-u10", true},
+		{"-u.5", true},
+		{"--users-file=users.csv", false},
+		{"-use-tls", false},
+		{"-uFoo", false},
+		{"-f", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.arg, func(t *testing.T) {
+			assert.Equal(t, tt.want, setsFlag(tt.arg, "-u", "--users"))
+		})
+	}
+}
+
+func TestValidateLoad_ArgsStartingWithShortFlag(t *testing.T) {
+	lt := newTestLoadLocustTest()
+	lt.Spec.Master.ExtraArgs = []string{"-run-id", "nightly", "-tenant=acme", "-users-file=users.csv"}
+
+	assert.NoError(t, validateLoad(lt), "only -u, -r and -t and their attached values are load flags")
+}
+
+func TestValidateCreate_LoadShapeReservations(t *testing.T) {
+	validator := &LocustTestCustomValidator{}
+
+	t.Run("MountPath", func(t *testing.T) {
+		lt := newTestLoadLocustTest()
+		lt.Spec.Load = &LoadConfig{Stages: []LoadStage{newTestLoadStage(time.Minute, 10, "1")}}
+		lt.Spec.Env = &EnvConfig{
+			SecretMounts: []SecretMount{{Name: "creds", MountPath: "/lotest/shape"}},
+		}
+
+		_, err := validator.ValidateCreate(context.Background(), lt)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "conflicts with reserved path")
+	})
+
+	t.Run("VolumeName", func(t *testing.T) {
+		lt := newTestLoadLocustTest()
+		lt.Spec.Volumes = []corev1.Volume{{Name: "locust-load-shape"}}
+
+		_, err := validator.ValidateCreate(context.Background(), lt)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "reserved by the operator")
+	})
+}
+
+func TestValidateThresholds_Valid(t *testing.T) {
+	lt := newTestLoadLocustTest()
+	assert.NoError(t, validateThresholds(lt), "thresholds are optional")
+
+	lt.Spec.Thresholds = &ThresholdsConfig{
+		ThresholdLimits: ThresholdLimits{
+			MaxFailureRatio:    ptr.To(resource.MustParse("0.01")),
+			MaxP95ResponseTime: &metav1.Duration{Duration: 500 * time.Millisecond},
+			MinRPS:             ptr.To(resource.MustParse("50")),
+		},
+		Endpoints: []EndpointThresholds{
+			{Name: "/checkout", Method: "POST", ThresholdLimits: ThresholdLimits{
+				MaxP99ResponseTime: &metav1.Duration{Duration: 2 * time.Second},
+			}},
+			// Same name, different method
+			{Name: "/checkout", Method: "GET", ThresholdLimits: ThresholdLimits{
+				MaxFailureRatio: ptr.To(resource.MustParse("0")),
+			}},
+		},
+	}
+
+	assert.NoError(t, validateThresholds(lt))
+}
+
+func TestValidateThresholds_Invalid(t *testing.T) {
+	endpoint := func(name, method string, limits ThresholdLimits) EndpointThresholds {
+		return EndpointThresholds{Name: name, Method: method, ThresholdLimits: limits}
+	}
+	p95 := ThresholdLimits{MaxP95ResponseTime: &metav1.Duration{Duration: time.Second}}
+
+	tests := []struct {
+		name       string
+		thresholds *ThresholdsConfig
+		errMsg     string
+	}{
+		{
+			name:       "Empty",
+			thresholds: &ThresholdsConfig{},
+			errMsg:     "thresholds must set at least one limit",
+		},
+		{
+			name: "FailureRatioAboveOne",
+			thresholds: &ThresholdsConfig{ThresholdLimits: ThresholdLimits{
+				MaxFailureRatio: ptr.To(resource.MustParse("1.5")),
+			}},
+			errMsg: "thresholds.maxFailureRatio must be between 0 and 1",
+		},
+		{
+			name: "ZeroResponseTime",
+			thresholds: &ThresholdsConfig{ThresholdLimits: ThresholdLimits{
+				MaxP99ResponseTime: &metav1.Duration{},
+			}},
+			errMsg: "thresholds.maxP99ResponseTime must be positive",
+		},
+		{
+			name: "ZeroRPS",
+			thresholds: &ThresholdsConfig{ThresholdLimits: ThresholdLimits{
+				MinRPS: ptr.To(resource.MustParse("0")),
+			}},
+			errMsg: "thresholds.minRPS must be positive",
+		},
+		{
+			name: "EndpointWithoutLimits",
+			thresholds: &ThresholdsConfig{Endpoints: []EndpointThresholds{
+				endpoint("/login", "", ThresholdLimits{}),
+			}},
+			errMsg: "thresholds.endpoints[0]: endpoint \"/login\" must set at least one limit",
+		},
+		{
+			name: "DuplicateEndpoint",
+			thresholds: &ThresholdsConfig{Endpoints: []EndpointThresholds{
+				endpoint("/login", "POST", p95),
+				endpoint("/login", "POST", p95),
+			}},
+			errMsg: "thresholds.endpoints[1]: duplicate endpoint \"POST /login\"",
+		},
+		{
+			name: "EndpointInvalidLimit",
+			thresholds: &ThresholdsConfig{Endpoints: []EndpointThresholds{
+				endpoint("/login", "", ThresholdLimits{MaxFailureRatio: ptr.To(resource.MustParse("-1"))}),
+			}},
+			errMsg: "thresholds.endpoints[0].maxFailureRatio must be between 0 and 1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lt := newTestLoadLocustTest()
+			lt.Spec.Thresholds = tt.thresholds
+
+			err := validateThresholds(lt)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestValidateResults_Valid(t *testing.T) {
+	lt := newTestLoadLocustTest()
+	assert.NoError(t, validateResults(lt), "results are optional")
+
+	lt.Spec.Results = &ResultsConfig{Formats: []ResultsFormat{ResultsFormatCSV}}
+	assert.NoError(t, validateResults(lt))
+
+	lt.Spec.Master.ExtraArgs = []string{"--csv-full-history", "--only-summary"}
+	lt.Spec.Results = &ResultsConfig{
+		Storage:               ResultsStoragePersistentVolumeClaim,
+		PersistentVolumeClaim: &ResultsVolumeClaim{Size: resource.MustParse("5Gi")},
+		S3: &ResultsS3Config{
+			Endpoint:             "http://minio.minio.svc:9000",
+			Bucket:               "load-tests",
+			Prefix:               "runs/{namespace}/{name}-{timestamp}",
+			ForcePathStyle:       true,
+			CredentialsSecretRef: corev1.LocalObjectReference{Name: "minio-credentials"},
+		},
+	}
+	assert.NoError(t, validateResults(lt))
+}
+
+func TestValidateResults_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(lt *LocustTest)
+		errMsg string
+	}{
+		{
+			name: "ClaimWithConfigMapStorage",
+			mutate: func(lt *LocustTest) {
+				lt.Spec.Results = &ResultsConfig{
+					Storage:               ResultsStorageConfigMap,
+					PersistentVolumeClaim: &ResultsVolumeClaim{Size: resource.MustParse("1Gi")},
+				}
+			},
+			errMsg: "results.persistentVolumeClaim requires results.storage to be PersistentVolumeClaim",
+		},
+		{
+			name: "NegativeClaimSize",
+			mutate: func(lt *LocustTest) {
+				lt.Spec.Results = &ResultsConfig{
+					Storage:               ResultsStoragePersistentVolumeClaim,
+					PersistentVolumeClaim: &ResultsVolumeClaim{Size: resource.MustParse("-1Gi")},
+				}
+			},
+			errMsg: "results.persistentVolumeClaim.size must not be negative",
+		},
+		{
+			name: "CSVFlagInCommand",
+			mutate: func(lt *LocustTest) {
+				lt.Spec.Master.Command += " --csv out"
+				lt.Spec.Results = &ResultsConfig{}
+			},
+			errMsg: "master command sets \"--csv\"",
+		},
+		{
+			name: "HTMLFlagInExtraArgs",
+			mutate: func(lt *LocustTest) {
+				lt.Spec.Master.ExtraArgs = []string{"--html=/tmp/report.html"}
+				lt.Spec.Results = &ResultsConfig{}
+			},
+			errMsg: "master command sets \"--html=/tmp/report.html\"",
+		},
+		{
+			name: "SrcPathConflict",
+			mutate: func(lt *LocustTest) {
+				lt.Spec.TestFiles = &TestFilesConfig{ConfigMapRef: "scripts", SrcMountPath: "/lotest"}
+				lt.Spec.Results = &ResultsConfig{}
+			},
+			errMsg: "conflicts with reserved path \"/lotest/results\"",
+		},
+		{
+			name: "JSONFlagInExtraArgs",
+			mutate: func(lt *LocustTest) {
+				lt.Spec.Master.ExtraArgs = []string{"--json-file", "out"}
+				lt.Spec.Results = &ResultsConfig{}
+			},
+			errMsg: "master command sets \"--json-file\"",
+		},
+		{
+			name: "S3EndpointWithoutScheme",
+			mutate: func(lt *LocustTest) {
+				lt.Spec.Results = &ResultsConfig{S3: &ResultsS3Config{Endpoint: "minio:9000", Bucket: "results"}}
+			},
+			errMsg: "results.s3.endpoint must be an http or https URL",
+		},
+		{
+			name: "S3EndpointWithQuery",
+			mutate: func(lt *LocustTest) {
+				lt.Spec.Results = &ResultsConfig{S3: &ResultsS3Config{Endpoint: "https://s3.example.com?x=1", Bucket: "results"}}
+			},
+			errMsg: "results.s3.endpoint must not have a query or fragment",
+		},
+		{
+			name: "S3UnknownPlaceholder",
+			mutate: func(lt *LocustTest) {
+				lt.Spec.Results = &ResultsConfig{S3: &ResultsS3Config{
+					Endpoint: "https://s3.example.com", Bucket: "results", Prefix: "{namespace}/{date}",
+				}}
+			},
+			errMsg: "results.s3.prefix has unknown placeholder {date}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lt := newTestLoadLocustTest()
+			tt.mutate(lt)
+
+			err := validateResults(lt)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestValidateCreate_ResultsReservations(t *testing.T) {
+	validator := &LocustTestCustomValidator{}
+
+	t.Run("MountPath", func(t *testing.T) {
+		lt := newTestLoadLocustTest()
+		lt.Spec.Results = &ResultsConfig{}
+		lt.Spec.Volumes = []corev1.Volume{{Name: "data"}}
+		lt.Spec.VolumeMounts = []TargetedVolumeMount{
+			{VolumeMount: corev1.VolumeMount{Name: "data", MountPath: "/lotest/results/extra"}},
+		}
+
+		_, err := validator.ValidateCreate(context.Background(), lt)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "conflicts with reserved path")
+	})
+
+	t.Run("VolumeName", func(t *testing.T) {
+		lt := newTestLoadLocustTest()
+		lt.Spec.Volumes = []corev1.Volume{{Name: "locust-results"}}
+
+		_, err := validator.ValidateCreate(context.Background(), lt)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "reserved by the operator")
+	})
+}
+
+func TestValidateTestFiles_Valid(t *testing.T) {
+	lt := newTestLoadLocustTest()
+	assert.NoError(t, validateTestFiles(lt), "testFiles are optional")
+
+	lt.Spec.TestFiles = &TestFilesConfig{ConfigMapRef: "scripts"}
+	assert.NoError(t, validateTestFiles(lt))
+
+	for _, git := range []GitSource{
+		{URL: "https://github.com/example/load-tests.git"},
+		{URL: "git@github.com:example/load-tests.git", Revision: "release/1.2", Path: "tests/checkout", LibPath: "lib"},
+		{URL: "ssh://git@gitlab.example.com:2222/team/load-tests.git", Revision: "4b825dc642cb6eb9a060e54bf8d69288fbee4904"},
+		{URL: "https://gitea.example.com/team/load-tests", Revision: "v1.2.0", Path: "./tests/"},
+	} {
+		lt.Spec.TestFiles = &TestFilesConfig{Git: &git}
+		assert.NoError(t, validateTestFiles(lt), git.URL)
+	}
+
+	lt.Spec.TestFiles = &TestFilesConfig{
+		Inline:          map[string]string{"locustfile.py": "from locust import HttpUser", "helpers.py": ""},
+		LibConfigMapRef: "lib",
+	}
+	assert.NoError(t, validateTestFiles(lt), "inline files can use a library ConfigMap")
+
+	lt.Spec.TestFiles = &TestFilesConfig{
+		Inline: map[string]string{"locustfile.py": strings.Repeat("x", maxInlineTestFilesSize-len("locustfile.py"))},
+	}
+	assert.NoError(t, validateTestFiles(lt), "inline files up to the ConfigMap limit")
+}
+
+func TestValidateTestFiles_Invalid(t *testing.T) {
+	tests := []struct {
+		name      string
+		testFiles TestFilesConfig
+		errMsg    string
+	}{
+		{
+			name: "WithConfigMapRef",
+			testFiles: TestFilesConfig{
+				ConfigMapRef: "scripts",
+				Git:          &GitSource{URL: "https://github.com/example/load-tests.git"},
+			},
+			errMsg: "testFiles.git cannot be combined with testFiles.configMapRef or testFiles.libConfigMapRef",
+		},
+		{
+			name: "WithLibConfigMapRef",
+			testFiles: TestFilesConfig{
+				LibConfigMapRef: "lib",
+				Git:             &GitSource{URL: "https://github.com/example/load-tests.git"},
+			},
+			errMsg: "testFiles.git cannot be combined",
+		},
+		{
+			name: "InlineWithConfigMapRef",
+			testFiles: TestFilesConfig{
+				ConfigMapRef: "scripts",
+				Inline:       map[string]string{"locustfile.py": "from locust import HttpUser"},
+			},
+			errMsg: "testFiles.inline cannot be combined with testFiles.configMapRef or testFiles.git",
+		},
+		{
+			name: "InlineWithGit",
+			testFiles: TestFilesConfig{
+				Git:    &GitSource{URL: "https://github.com/example/load-tests.git"},
+				Inline: map[string]string{"locustfile.py": "from locust import HttpUser"},
+			},
+			errMsg: "testFiles.inline cannot be combined",
+		},
+		{
+			name:      "InlineInvalidFileName",
+			testFiles: TestFilesConfig{Inline: map[string]string{"tests/locustfile.py": ""}},
+			errMsg:    `testFiles.inline file name "tests/locustfile.py" is invalid`,
+		},
+		{
+			name: "InlineTooLarge",
+			testFiles: TestFilesConfig{Inline: map[string]string{
+				"locustfile.py": strings.Repeat("x", 512*1024),
+				"helpers.py":    strings.Repeat("x", 512*1024),
+			}},
+			errMsg: "testFiles.inline is 1048599 bytes, exceeding the 1048576 byte ConfigMap limit",
+		},
+		{
+			name:      "FileURL",
+			testFiles: TestFilesConfig{Git: &GitSource{URL: "file:///etc"}},
+			errMsg:    "testFiles.git.url must be an http(s), ssh or git URL",
+		},
+		{
+			name:      "OptionAsURL",
+			testFiles: TestFilesConfig{Git: &GitSource{URL: "--upload-pack=touch /tmp/x"}},
+			errMsg:    "testFiles.git.url must be an http(s), ssh or git URL",
+		},
+		{
+			name: "OptionAsRevision",
+			testFiles: TestFilesConfig{Git: &GitSource{
+				URL: "https://github.com/example/load-tests.git", Revision: "--upload-pack=sh",
+			}},
+			errMsg: "testFiles.git.revision must be a branch, tag or commit SHA",
+		},
+		{
+			name: "AbsolutePath",
+			testFiles: TestFilesConfig{Git: &GitSource{
+				URL: "https://github.com/example/load-tests.git", Path: "/etc",
+			}},
+			errMsg: "testFiles.git.path must be relative to the repository root",
+		},
+		{
+			name: "LibPathEscapesRepository",
+			testFiles: TestFilesConfig{Git: &GitSource{
+				URL: "https://github.com/example/load-tests.git", LibPath: "lib/../../home",
+			}},
+			errMsg: "testFiles.git.libPath must be relative to the repository root",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lt := newTestLoadLocustTest()
+			lt.Spec.TestFiles = &tt.testFiles
+
+			err := validateTestFiles(lt)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestValidateCreate_GitReservations(t *testing.T) {
+	validator := &LocustTestCustomValidator{}
+
+	for _, name := range []string{"locust-git", "locust-git-secret"} {
+		lt := newTestLoadLocustTest()
+		lt.Spec.TestFiles = &TestFilesConfig{Git: &GitSource{URL: "https://github.com/example/load-tests.git"}}
+		lt.Spec.Volumes = []corev1.Volume{{Name: name}}
+
+		_, err := validator.ValidateCreate(context.Background(), lt)
+		require.Error(t, err, name)
+		assert.Contains(t, err.Error(), "reserved by the operator")
+	}
+}
+
+func TestValidateWebUI_Valid(t *testing.T) {
+	lt := newTestLoadLocustTest()
+	assert.NoError(t, validateWebUI(lt), "webUI is optional")
+
+	for _, webUI := range []WebUIConfig{
+		{Enabled: false},
+		{Enabled: true, ServiceType: corev1.ServiceTypeLoadBalancer},
+		{Enabled: true, Ingress: &WebUIIngressConfig{Host: "load.example.com", TLSSecretName: "load-tls"}},
+		{Enabled: true, HTTPRoute: &WebUIHTTPRouteConfig{
+			ParentRefs: []GatewayParentReference{{Name: "public"}},
+			Hostnames:  []string{"load.example.com", "*.load.example.com"},
+		}},
+	} {
+		lt.Spec.WebUI = &webUI
+		assert.NoError(t, validateWebUI(lt))
+	}
+}
+
+func TestValidateWebUI_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		webUI  WebUIConfig
+		errMsg string
+	}{
+		{
+			name: "IngressAndHTTPRoute",
+			webUI: WebUIConfig{
+				Enabled:   true,
+				Ingress:   &WebUIIngressConfig{Host: "load.example.com"},
+				HTTPRoute: &WebUIHTTPRouteConfig{ParentRefs: []GatewayParentReference{{Name: "public"}}},
+			},
+			errMsg: "webUI.ingress and webUI.httpRoute are mutually exclusive",
+		},
+		{
+			name:   "IngressWhenDisabled",
+			webUI:  WebUIConfig{Ingress: &WebUIIngressConfig{Host: "load.example.com"}},
+			errMsg: "webUI.ingress and webUI.httpRoute require webUI.enabled to be true",
+		},
+		{
+			name:   "InvalidIngressHost",
+			webUI:  WebUIConfig{Enabled: true, Ingress: &WebUIIngressConfig{Host: "https://load.example.com"}},
+			errMsg: `webUI.ingress.host "https://load.example.com" is invalid`,
+		},
+		{
+			name: "InvalidHostname",
+			webUI: WebUIConfig{Enabled: true, HTTPRoute: &WebUIHTTPRouteConfig{
+				ParentRefs: []GatewayParentReference{{Name: "public"}},
+				Hostnames:  []string{"Load_Test.example.com"},
+			}},
+			errMsg: `webUI.httpRoute hostname "Load_Test.example.com" is invalid`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lt := newTestLoadLocustTest()
+			lt.Spec.WebUI = &tt.webUI
+
+			err := validateWebUI(lt)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestValidateControl(t *testing.T) {
+	lt := newTestLoadLocustTest()
+	assert.NoError(t, validateControl(lt), "the control annotation is optional")
+
+	for _, action := range []ControlAction{ControlStop, ControlAbort} {
+		lt.Annotations = map[string]string{AnnotationControl: string(action)}
+		assert.NoError(t, validateControl(lt))
+	}
+
+	lt.Annotations = map[string]string{AnnotationControl: "stop"}
+	err := validateControl(lt)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `annotation locust.io/control must be Stop or Abort, got "stop"`)
+}
+
+func TestValidateUpdate_InvalidControl(t *testing.T) {
+	validator := &LocustTestCustomValidator{}
+	oldLt := newTestLoadLocustTest()
+	newLt := oldLt.DeepCopy()
+	newLt.Annotations = map[string]string{AnnotationControl: "Pause"}
+
+	_, err := validator.ValidateUpdate(context.Background(), oldLt, newLt)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "annotation locust.io/control must be Stop or Abort")
+}
+
+func TestValidateMaxDuration(t *testing.T) {
+	lt := newTestLoadLocustTest()
+	assert.NoError(t, validateMaxDuration(lt), "maxDuration is optional")
+
+	lt.Spec.MaxDuration = &metav1.Duration{Duration: time.Hour}
+	lt.Spec.Load = &LoadConfig{RunTime: &metav1.Duration{Duration: 30 * time.Minute}}
+	assert.NoError(t, validateMaxDuration(lt))
+
+	lt.Spec.MaxDuration = &metav1.Duration{}
+	err := validateMaxDuration(lt)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "maxDuration must be positive, got 0s")
+
+	lt.Spec.MaxDuration = &metav1.Duration{Duration: 20 * time.Minute}
+	err = validateMaxDuration(lt)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "maxDuration 20m0s is shorter than the 30m0s of load configured")
+
+	lt.Spec.Load = &LoadConfig{Stages: []LoadStage{
+		{Duration: metav1.Duration{Duration: 15 * time.Minute}},
+		{Duration: metav1.Duration{Duration: 10 * time.Minute}},
+	}}
+	err = validateMaxDuration(lt)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "shorter than the 25m0s of load configured")
+}
+
+func TestValidateWorkerGroups(t *testing.T) {
+	lt := newTestLoadLocustTest()
+	assert.NoError(t, validateWorkerGroups(lt), "workerGroups is optional")
+
+	lt.Spec.WorkerGroups = []WorkerGroup{
+		{Name: "browse", Replicas: 2},
+		{Name: "checkout", Replicas: 1},
+	}
+	assert.NoError(t, validateWorkerGroups(lt))
+
+	tests := []struct {
+		name    string
+		crName  string
+		groups  []WorkerGroup
+		wantErr string
+	}{
+		{
+			name:    "ReservedDefault",
+			groups:  []WorkerGroup{{Name: "default", Replicas: 1}},
+			wantErr: `workerGroups name "default" is reserved for spec.worker`,
+		},
+		{
+			name:    "Duplicate",
+			groups:  []WorkerGroup{{Name: "browse", Replicas: 1}, {Name: "browse", Replicas: 2}},
+			wantErr: `workerGroups name "browse" is used more than once`,
+		},
+		{
+			name:    "JobNameTooLong",
+			crName:  strings.Repeat("a", 40),
+			groups:  []WorkerGroup{{Name: "checkout-and-payment", Replicas: 1}},
+			wantErr: "would exceed 63 characters",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lt := newTestLoadLocustTest()
+			if tt.crName != "" {
+				lt.Name = tt.crName
+			}
+			lt.Spec.WorkerGroups = tt.groups
+
+			_, err := validateLocustTest(lt)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestValidateWorkerAutoscaling(t *testing.T) {
+	lt := newTestLoadLocustTest()
+	assert.NoError(t, validateWorkerAutoscaling(lt), "autoscaling is optional")
+
+	lt.Spec.Worker.Replicas = 2
+	lt.Spec.Worker.Autoscaling = &WorkerAutoscaling{MinReplicas: 1, MaxReplicas: 10}
+	assert.NoError(t, validateWorkerAutoscaling(lt))
+
+	tests := []struct {
+		name        string
+		replicas    int32
+		autoscaling WorkerAutoscaling
+		wantErr     string
+	}{
+		{
+			name:        "MinAboveMax",
+			replicas:    5,
+			autoscaling: WorkerAutoscaling{MinReplicas: 6, MaxReplicas: 4},
+			wantErr:     "worker.autoscaling.minReplicas 6 is more than maxReplicas 4",
+		},
+		{
+			name:        "ReplicasAboveMax",
+			replicas:    12,
+			autoscaling: WorkerAutoscaling{MinReplicas: 1, MaxReplicas: 10},
+			wantErr:     "worker.replicas 12 must be between worker.autoscaling.minReplicas 1 and maxReplicas 10",
+		},
+		{
+			name:        "ReplicasBelowMin",
+			replicas:    2,
+			autoscaling: WorkerAutoscaling{MinReplicas: 3, MaxReplicas: 10},
+			wantErr:     "worker.replicas 2 must be between",
+		},
+		{
+			name:        "ZeroCooldown",
+			replicas:    2,
+			autoscaling: WorkerAutoscaling{MaxReplicas: 10, Cooldown: &metav1.Duration{}},
+			wantErr:     "worker.autoscaling.cooldown must be positive, got 0s",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lt := newTestLoadLocustTest()
+			lt.Spec.Worker.Replicas = tt.replicas
+			lt.Spec.Worker.Autoscaling = &tt.autoscaling
+
+			_, err := validateLocustTest(lt)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestValidatePodTemplates_Valid(t *testing.T) {
+	lt := newTestLoadLocustTest()
+	lt.Spec.Master.PodTemplate = &runtime.RawExtension{Raw: []byte(`{
+		"metadata": {"labels": {"team": "perf"}},
+		"spec": {
+			"priorityClassName": "load-testing",
+			"containers": [{"name": "test-master", "env": [{"name": "EXTRA", "value": "1"}]}]
+		}
+	}`)}
+	lt.Spec.Worker.PodTemplate = &runtime.RawExtension{Raw: []byte(`{
+		"spec": {
+			"terminationGracePeriodSeconds": 5,
+			"containers": [{"name": "debug", "image": "busybox", "command": ["sleep", "infinity"]}]
+		}
+	}`)}
+
+	_, err := validateLocustTest(lt)
+	assert.NoError(t, err)
+}
+
+func TestValidatePodTemplates_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		master  string
+		worker  string
+		groups  []WorkerGroup
+		wantErr string
+	}{
+		{
+			name:    "NotAPodTemplate",
+			master:  `{"spec": {"priorityClassName": 5}}`,
+			wantErr: "master.podTemplate is not a valid pod template",
+		},
+		{
+			name:    "PodNameLabel",
+			worker:  `{"metadata": {"labels": {"performance-test-pod-name": "other"}}}`,
+			wantErr: `worker.podTemplate can't override the "performance-test-pod-name" label`,
+		},
+		{
+			name:    "DeletePodNameLabel",
+			master:  `{"metadata": {"labels": {"performance-test-pod-name": null}}}`,
+			wantErr: `master.podTemplate can't override the "performance-test-pod-name" label`,
+		},
+		{
+			name:    "RestartPolicy",
+			master:  `{"spec": {"restartPolicy": "Always"}}`,
+			wantErr: "master.podTemplate can't override the restart policy",
+		},
+		{
+			name:    "LocustContainerArgs",
+			master:  `{"spec": {"containers": [{"name": "test-master", "args": ["--headless"]}]}}`,
+			wantErr: `master.podTemplate can't override the args of the Locust container "test-master"`,
+		},
+		{
+			name:    "LocustContainerCommand",
+			worker:  `{"spec": {"containers": [{"name": "test-worker", "command": ["sh"]}]}}`,
+			wantErr: `worker.podTemplate can't override the command of the Locust container "test-worker"`,
+		},
+		{
+			name:    "WorkerGroupLocustContainer",
+			worker:  `{"spec": {"containers": [{"name": "test-worker-heavy", "args": ["HeavyUser"]}]}}`,
+			groups:  []WorkerGroup{{Name: "heavy", Replicas: 1}},
+			wantErr: `worker.podTemplate can't override the args of the Locust container "test-worker-heavy"`,
+		},
+		{
+			name:    "ReplaceContainers",
+			worker:  `{"spec": {"containers": [{"$patch": "replace"}, {"name": "other", "image": "busybox"}]}}`,
+			wantErr: `worker.podTemplate can't use "$patch" on containers`,
+		},
+		{
+			name:    "ReplaceSpec",
+			master:  `{"spec": {"$patch": "replace", "containers": []}}`,
+			wantErr: `master.podTemplate can't use "$patch" on the pod template, its metadata or its spec`,
+		},
+		{
+			name:    "DeleteLabels",
+			master:  `{"metadata": {"labels": {"$patch": "replace", "team": "perf"}}}`,
+			wantErr: `master.podTemplate can't use "$patch" on the pod labels`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lt := newTestLoadLocustTest()
+			lt.Spec.WorkerGroups = tt.groups
+			if tt.master != "" {
+				lt.Spec.Master.PodTemplate = &runtime.RawExtension{Raw: []byte(tt.master)}
+			}
+			if tt.worker != "" {
+				lt.Spec.Worker.PodTemplate = &runtime.RawExtension{Raw: []byte(tt.worker)}
+			}
+
+			_, err := validateLocustTest(lt)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestValidateUserContainers(t *testing.T) {
+	lt := newTestLoadLocustTest()
+	lt.Spec.Master.Sidecars = []Container{{Container: corev1.Container{Name: "log-shipper", Image: "fluent/fluent-bit:3.1"}}}
+	lt.Spec.Worker.Sidecars = []Container{{Container: corev1.Container{Name: "log-shipper", Image: "fluent/fluent-bit:3.1"}}}
+	lt.Spec.Worker.InitContainers = []Container{{Container: corev1.Container{Name: "warm-token-cache", Image: "busybox"}}}
+	_, err := validateLocustTest(lt)
+	assert.NoError(t, err, "master and worker pods may use the same names")
+
+	// The CRD schema doesn't describe the containers, but they still
+	// serialize as plain containers
+	raw, err := json.Marshal(lt.Spec.Worker.InitContainers[0])
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"warm-token-cache","image":"busybox","resources":{}}`, string(raw))
+
+	tests := []struct {
+		name    string
+		mutate  func(lt *LocustTest)
+		wantErr string
+	}{
+		{
+			name: "MissingName",
+			mutate: func(lt *LocustTest) {
+				lt.Spec.Master.Sidecars = []Container{{Container: corev1.Container{Image: "busybox"}}}
+			},
+			wantErr: `master.sidecars[0].name "" is invalid`,
+		},
+		{
+			name: "InvalidName",
+			mutate: func(lt *LocustTest) {
+				lt.Spec.Worker.InitContainers = []Container{{Container: corev1.Container{Name: "Warm_Cache", Image: "busybox"}}}
+			},
+			wantErr: `worker.initContainers[0].name "Warm_Cache" is invalid`,
+		},
+		{
+			name: "MissingImage",
+			mutate: func(lt *LocustTest) {
+				lt.Spec.Worker.Sidecars = []Container{{Container: corev1.Container{Name: "log-shipper"}}}
+			},
+			wantErr: `worker.sidecars[0].image is required`,
+		},
+		{
+			name: "MetricsExporter",
+			mutate: func(lt *LocustTest) {
+				lt.Spec.Master.Sidecars = []Container{{Container: corev1.Container{Name: "locust-metrics-exporter", Image: "busybox"}}}
+			},
+			wantErr: `master.sidecars name "locust-metrics-exporter" is reserved by the operator`,
+		},
+		{
+			name: "GitClone",
+			mutate: func(lt *LocustTest) {
+				lt.Spec.Worker.InitContainers = []Container{{Container: corev1.Container{Name: "locust-git-clone", Image: "busybox"}}}
+			},
+			wantErr: `worker.initContainers name "locust-git-clone" is reserved by the operator`,
+		},
+		{
+			name: "MasterLocustContainer",
+			mutate: func(lt *LocustTest) {
+				lt.Spec.Master.InitContainers = []Container{{Container: corev1.Container{Name: "test-master", Image: "busybox"}}}
+			},
+			wantErr: `master.initContainers name "test-master" conflicts with the Locust container`,
+		},
+		{
+			name: "WorkerGroupLocustContainer",
+			mutate: func(lt *LocustTest) {
+				lt.Spec.WorkerGroups = []WorkerGroup{{Name: "heavy", Replicas: 1}}
+				lt.Spec.Worker.Sidecars = []Container{{Container: corev1.Container{Name: "test-worker-heavy", Image: "busybox"}}}
+			},
+			wantErr: `worker.sidecars name "test-worker-heavy" conflicts with the Locust container`,
+		},
+		{
+			name: "SidecarAndInitContainer",
+			mutate: func(lt *LocustTest) {
+				lt.Spec.Worker.InitContainers = []Container{{Container: corev1.Container{Name: "helper", Image: "busybox"}}}
+				lt.Spec.Worker.Sidecars = []Container{{Container: corev1.Container{Name: "helper", Image: "busybox"}}}
+			},
+			wantErr: `worker.sidecars name "helper" is already used in worker.initContainers`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lt := newTestLoadLocustTest()
+			tt.mutate(lt)
+
+			_, err := validateLocustTest(lt)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestValidatePrometheusMonitors(t *testing.T) {
+	lt := newTestLoadLocustTest()
+	lt.Spec.Observability = &ObservabilityConfig{
+		MetricsExporter: MetricsExporterSidecar,
+		Prometheus: &PrometheusConfig{
+			ServiceMonitor: &PrometheusMonitorConfig{
+				Relabelings: []PrometheusRelabelConfig{
+					{SourceLabels: []string{"__meta_kubernetes_pod_node_name"}, TargetLabel: "node"},
+					{SourceLabels: []string{"__address__"}, TargetLabel: "shard", Modulus: 4, Action: "hashmod"},
+					{Regex: "pod_template_hash", Action: "labeldrop"},
+				},
+			},
+			PodMonitor: &PrometheusMonitorConfig{},
+		},
+	}
+	_, err := validateLocustTest(lt)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		mutate  func(obs *ObservabilityConfig)
+		wantErr string
+	}{
+		{
+			name: "OpenTelemetry",
+			mutate: func(obs *ObservabilityConfig) {
+				obs.OpenTelemetry = &OpenTelemetryConfig{Enabled: true, Endpoint: "otel-collector:4317"}
+			},
+			wantErr: "observability.prometheus.serviceMonitor requires the metrics exporter sidecar, which OpenTelemetry replaces",
+		},
+		{
+			name: "BuiltinExporter",
+			mutate: func(obs *ObservabilityConfig) {
+				obs.MetricsExporter = MetricsExporterBuiltin
+				obs.Prometheus.ServiceMonitor = nil
+			},
+			wantErr: `observability.prometheus.podMonitor requires the metrics exporter sidecar; with metricsExporter "Builtin" the metrics are served by the operator`,
+		},
+		{
+			name: "ReplaceWithoutTargetLabel",
+			mutate: func(obs *ObservabilityConfig) {
+				obs.Prometheus.ServiceMonitor.Relabelings[0].TargetLabel = ""
+			},
+			wantErr: "observability.prometheus.serviceMonitor.relabelings[0].targetLabel is required for the replace action",
+		},
+		{
+			name: "HashmodWithoutModulus",
+			mutate: func(obs *ObservabilityConfig) {
+				obs.Prometheus.ServiceMonitor.Relabelings[1].Modulus = 0
+			},
+			wantErr: "observability.prometheus.serviceMonitor.relabelings[1].modulus is required for the hashmod action",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lt := lt.DeepCopy()
+			tt.mutate(lt.Spec.Observability)
+
+			_, err := validateLocustTest(lt)
+			require.Error(t, err)
+			assert.Equal(t, tt.wantErr, err.Error())
+		})
+	}
+}
+
+func TestValidateNotifications(t *testing.T) {
+	lt := newTestLoadLocustTest()
+	lt.Spec.Notifications = &NotificationsConfig{
+		Webhooks: []NotificationWebhook{
+			{URL: "https://hooks.example.com/locust", Phases: []Phase{PhaseSucceeded, PhaseFailed}},
+			{URLSecretRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "chat-webhook"},
+				Key:                  "url",
+			}},
+		},
+	}
+	_, err := validateLocustTest(lt)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		mutate  func(webhooks []NotificationWebhook)
+		wantErr string
+	}{
+		{
+			name: "NoURL",
+			mutate: func(webhooks []NotificationWebhook) {
+				webhooks[1].URLSecretRef = nil
+			},
+			wantErr: "notifications.webhooks[1] must set exactly one of url and urlSecretRef",
+		},
+		{
+			name: "URLAndSecretRef",
+			mutate: func(webhooks []NotificationWebhook) {
+				webhooks[1].URL = "https://hooks.example.com/other"
+			},
+			wantErr: "notifications.webhooks[1] must set exactly one of url and urlSecretRef",
+		},
+		{
+			name: "RelativeURL",
+			mutate: func(webhooks []NotificationWebhook) {
+				webhooks[0].URL = "hooks.example.com/locust"
+			},
+			wantErr: `notifications.webhooks[0].url must be an http or https URL, got "hooks.example.com/locust"`,
+		},
+		{
+			name: "UnsupportedScheme",
+			mutate: func(webhooks []NotificationWebhook) {
+				webhooks[0].URL = "ftp://hooks.example.com/locust"
+			},
+			wantErr: `notifications.webhooks[0].url must be an http or https URL, got "ftp://hooks.example.com/locust"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lt := lt.DeepCopy()
+			tt.mutate(lt.Spec.Notifications.Webhooks)
+
+			_, err := validateLocustTest(lt)
+			require.Error(t, err)
+			assert.Equal(t, tt.wantErr, err.Error())
+		})
+	}
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ============================================
+// SPEC
+// ============================================
+
+// LocustTestQueueSpec defines the capacity shared by the tests of a queue.
+// Tests are admitted while the tests already admitted, together with the new
+// one, stay within every limit set. Unset limits don't restrict admission.
+type LocustTestQueueSpec struct {
+	// MaxConcurrentTests is the most tests admitted at the same time.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxConcurrentTests *int32 `json:"maxConcurrentTests,omitempty"`
+
+	// MaxWorkerPods is the most worker pods of the admitted tests, from their
+	// spec.worker.replicas.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxWorkerPods *int32 `json:"maxWorkerPods,omitempty"`
+
+	// MaxCPU is the most CPU requested by the master and worker pods of the
+	// admitted tests, e.g. "64".
+	// +optional
+	MaxCPU *resource.Quantity `json:"maxCPU,omitempty"`
+
+	// MaxMemory is the most memory requested by the master and worker pods
+	// of the admitted tests, e.g. "128Gi".
+	// +optional
+	MaxMemory *resource.Quantity `json:"maxMemory,omitempty"`
+}
+
+// ============================================
+// ROOT TYPES
+// ============================================
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=lotestq
+// +kubebuilder:printcolumn:name="Max Tests",type=integer,JSONPath=`.spec.maxConcurrentTests`,description="Most tests admitted at once"
+// +kubebuilder:printcolumn:name="Max Workers",type=integer,JSONPath=`.spec.maxWorkerPods`,description="Most worker pods of the admitted tests"
+// +kubebuilder:printcolumn:name="Max CPU",type=string,JSONPath=`.spec.maxCPU`
+// +kubebuilder:printcolumn:name="Max Memory",type=string,JSONPath=`.spec.maxMemory`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// LocustTestQueue is the Schema for the locusttestqueues API.
+// It admits the LocustTests of its namespace referencing it within its limits.
+type LocustTestQueue struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec LocustTestQueueSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LocustTestQueueList contains a list of LocustTestQueue.
+type LocustTestQueueList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LocustTestQueue `json:"items"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=clotestq
+// +kubebuilder:printcolumn:name="Max Tests",type=integer,JSONPath=`.spec.maxConcurrentTests`,description="Most tests admitted at once"
+// +kubebuilder:printcolumn:name="Max Workers",type=integer,JSONPath=`.spec.maxWorkerPods`,description="Most worker pods of the admitted tests"
+// +kubebuilder:printcolumn:name="Max CPU",type=string,JSONPath=`.spec.maxCPU`
+// +kubebuilder:printcolumn:name="Max Memory",type=string,JSONPath=`.spec.maxMemory`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ClusterLocustTestQueue is the Schema for the clusterlocusttestqueues API.
+// It admits the LocustTests of all namespaces referencing it within its
+// limits, e.g. to share a load-generation node pool between teams.
+type ClusterLocustTestQueue struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec LocustTestQueueSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterLocustTestQueueList contains a list of ClusterLocustTestQueue.
+type ClusterLocustTestQueueList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterLocustTestQueue `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LocustTestQueue{}, &LocustTestQueueList{},
+		&ClusterLocustTestQueue{}, &ClusterLocustTestQueueList{})
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConcurrencyPolicy describes how a LocustTestSchedule treats a run that is
+// due while a previous run is still in progress.
+// +kubebuilder:validation:Enum=Allow;Forbid;Replace
+type ConcurrencyPolicy string
+
+const (
+	// AllowConcurrent starts the new run alongside any active runs.
+	AllowConcurrent ConcurrencyPolicy = "Allow"
+	// ForbidConcurrent skips the new run while a previous run is still active.
+	ForbidConcurrent ConcurrencyPolicy = "Forbid"
+	// ReplaceConcurrent deletes the active runs and starts the new one.
+	ReplaceConcurrent ConcurrencyPolicy = "Replace"
+)
+
+// ============================================
+// TEST TEMPLATE
+// ============================================
+
+// LocustTestTemplateSpec describes the LocustTest created for every scheduled run.
+type LocustTestTemplateSpec struct {
+	// Metadata holds labels and annotations copied onto every created LocustTest.
+	// +optional
+	Metadata LocustTestTemplateMeta `json:"metadata,omitempty"`
+
+	// Spec is the LocustTest spec used for every scheduled run.
+	// +kubebuilder:validation:Required
+	Spec LocustTestSpec `json:"spec"`
+}
+
+// LocustTestTemplateMeta is the subset of object metadata a schedule may set on its runs.
+type LocustTestTemplateMeta struct {
+	// Labels added to every created LocustTest.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations added to every created LocustTest.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// ============================================
+// SPEC
+// ============================================
+
+// LocustTestScheduleSpec defines the desired state of LocustTestSchedule.
+type LocustTestScheduleSpec struct {
+	// Schedule in Cron format, e.g. "0 2 * * *" or "@daily".
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Schedule string `json:"schedule"`
+
+	// TimeZone is the IANA time zone the schedule is evaluated in (e.g. "Europe/Berlin").
+	// Defaults to the time zone of the operator process, which is UTC in the published image.
+	// Can't be set with an "@every" schedule, which runs at a fixed interval.
+	// +optional
+	TimeZone *string `json:"timeZone,omitempty"`
+
+	// StartingDeadlineSeconds is how late a run may start after its scheduled time.
+	// Runs missed by more than this (e.g. while the operator was down) are skipped.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	StartingDeadlineSeconds *int64 `json:"startingDeadlineSeconds,omitempty"`
+
+	// ConcurrencyPolicy decides what happens when a run is due while a previous run is still active.
+	// +optional
+	// +kubebuilder:default=Forbid
+	ConcurrencyPolicy ConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+
+	// Suspend stops new runs from being created. Active runs are not affected.
+	// +optional
+	// +kubebuilder:default=false
+	Suspend *bool `json:"suspend,omitempty"`
+
+	// SuccessfulTestsHistoryLimit is the number of finished successful runs to keep.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default=3
+	SuccessfulTestsHistoryLimit *int32 `json:"successfulTestsHistoryLimit,omitempty"`
+
+	// FailedTestsHistoryLimit is the number of finished failed runs to keep.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default=1
+	FailedTestsHistoryLimit *int32 `json:"failedTestsHistoryLimit,omitempty"`
+
+	// TestTemplate is the LocustTest created on every scheduled run.
+	// +kubebuilder:validation:Required
+	TestTemplate LocustTestTemplateSpec `json:"testTemplate"`
+}
+
+// ============================================
+// STATUS
+// ============================================
+
+// LocustTestScheduleStatus defines the observed state of LocustTestSchedule.
+type LocustTestScheduleStatus struct {
+	// Active lists the LocustTests created by this schedule that have not finished yet.
+	// +optional
+	// +listType=atomic
+	Active []corev1.ObjectReference `json:"active,omitempty"`
+
+	// LastScheduleTime is the scheduled time of the most recent run.
+	// +optional
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+
+	// LastSuccessfulTime is when the most recent successful run completed.
+	// +optional
+	LastSuccessfulTime *metav1.Time `json:"lastSuccessfulTime,omitempty"`
+}
+
+// ============================================
+// ROOT TYPES
+// ============================================
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=lotestsched
+// +kubebuilder:printcolumn:name="Schedule",type=string,JSONPath=`.spec.schedule`,description="Cron schedule"
+// +kubebuilder:printcolumn:name="Suspend",type=boolean,JSONPath=`.spec.suspend`,description="Whether new runs are suspended"
+// +kubebuilder:printcolumn:name="Last Schedule",type=date,JSONPath=`.status.lastScheduleTime`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// LocustTestSchedule is the Schema for the locusttestschedules API.
+// It creates LocustTests from a template on a cron schedule.
+type LocustTestSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LocustTestScheduleSpec   `json:"spec,omitempty"`
+	Status LocustTestScheduleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LocustTestScheduleList contains a list of LocustTestSchedule.
+type LocustTestScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LocustTestSchedule `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LocustTestSchedule{}, &LocustTestScheduleList{})
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var locusttestschedulelog = logf.Log.WithName("locusttestschedule-resource")
+
+// LocustTestScheduleCustomValidator handles validation for LocustTestSchedule resources.
+type LocustTestScheduleCustomValidator struct{}
+
+// SetupWebhookWithManager sets up the webhook with the Manager.
+func (r *LocustTestSchedule) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, r).
+		WithValidator(&LocustTestScheduleCustomValidator{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-locust-io-v2-locusttestschedule,mutating=false,failurePolicy=fail,sideEffects=None,groups=locust.io,resources=locusttestschedules,verbs=create;update,versions=v2,name=vlocusttestschedule-v2.kb.io,admissionReviewVersions=v1
+
+var _ admission.Validator[*LocustTestSchedule] = &LocustTestScheduleCustomValidator{}
+
+// ValidateCreate implements admission.Validator.
+func (v *LocustTestScheduleCustomValidator) ValidateCreate(ctx context.Context, s *LocustTestSchedule) (admission.Warnings, error) {
+	locusttestschedulelog.Info("validate create", "name", s.Name)
+	return validateLocustTestSchedule(s)
+}
+
+// ValidateUpdate implements admission.Validator.
+func (v *LocustTestScheduleCustomValidator) ValidateUpdate(ctx context.Context, oldS, newS *LocustTestSchedule) (admission.Warnings, error) {
+	locusttestschedulelog.Info("validate update", "name", newS.Name)
+	return validateLocustTestSchedule(newS)
+}
+
+// ValidateDelete implements admission.Validator.
+func (v *LocustTestScheduleCustomValidator) ValidateDelete(ctx context.Context, s *LocustTestSchedule) (admission.Warnings, error) {
+	locusttestschedulelog.Info("validate delete", "name", s.Name)
+	return nil, nil
+}
+
+// validateLocustTestSchedule runs all validation checks.
+func validateLocustTestSchedule(s *LocustTestSchedule) (admission.Warnings, error) {
+	if err := validateScheduleName(s); err != nil {
+		return nil, err
+	}
+
+	if _, err := ParseSchedule(s.Spec.Schedule, s.Spec.TimeZone); err != nil {
+		return nil, err
+	}
+
+	// The template becomes an ordinary LocustTest, so it must pass the same
+	// checks a hand-written LocustTest would. Validate it under the longest
+	// name a run can get so name-derived checks see the real value.
+	run := &LocustTest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ScheduledTestName(s.Name, time.Now()),
+			Namespace: s.Namespace,
+		},
+		Spec: s.Spec.TestTemplate.Spec,
+	}
+	warnings, err := validateLocustTest(run)
+	if err != nil {
+		return warnings, fmt.Errorf("spec.testTemplate: %w", err)
+	}
+
+	return warnings, nil
+}
+
+// validateScheduleName validates that names of the LocustTests created by the
+// schedule, and the Jobs those create in turn, fit the 63 character limit.
+func validateScheduleName(s *LocustTestSchedule) error {
+	const maxNameSuffixLen = 7 // "-worker", see validateCRName
+	const maxK8sNameLen = 63
+
+	maxLen := maxK8sNameLen - maxNameSuffixLen - scheduledTestNameSuffixLen
+	if len(s.Name) > maxLen {
+		return fmt.Errorf(
+			"name %q is too long: generated LocustTest names would exceed 63 characters (max schedule name length is %d)",
+			s.Name, maxLen)
+	}
+
+	return nil
+}
+
+// ParseSchedule parses a standard cron expression (five fields or a
+// descriptor such as "@daily") and binds it to the given IANA time zone.
+// Time zones must be set through the timeZone field; the TZ= and CRON_TZ=
+// prefixes robfig/cron understands are rejected so there is exactly one way to
+// express them, as with batch/v1 CronJob.
+func ParseSchedule(schedule string, timeZone *string) (cron.Schedule, error) {
+	if strings.Contains(schedule, "TZ=") {
+		return nil, fmt.Errorf("schedule %q must not contain TZ or CRON_TZ; use spec.timeZone instead", schedule)
+	}
+
+	sched, err := cron.ParseStandard(schedule)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule %q: %w", schedule, err)
+	}
+
+	if timeZone == nil || *timeZone == "" {
+		return sched, nil
+	}
+
+	loc, err := time.LoadLocation(*timeZone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timeZone %q: %w", *timeZone, err)
+	}
+
+	// ParseStandard only returns *SpecSchedule or, for "@every",
+	// ConstantDelaySchedule, which has no notion of a time zone.
+	spec, ok := sched.(*cron.SpecSchedule)
+	if !ok {
+		return nil, fmt.Errorf("timeZone can't be set with schedule %q, which runs at a fixed interval", schedule)
+	}
+	spec.Location = loc
+
+	return spec, nil
+}
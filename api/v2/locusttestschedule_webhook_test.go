@@ -0,0 +1,155 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func newTestLocustTestSchedule(name string) *LocustTestSchedule {
+	return &LocustTestSchedule{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: LocustTestScheduleSpec{
+			Schedule: "0 2 * * *",
+			TestTemplate: LocustTestTemplateSpec{
+				Spec: LocustTestSpec{
+					Image: "locustio/locust:2.20.0",
+					Master: MasterSpec{
+						Command: "locust -f /lotest/src/locustfile.py",
+					},
+					Worker: WorkerSpec{
+						Command:  "locust -f /lotest/src/locustfile.py",
+						Replicas: 1,
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestScheduleValidateCreate(t *testing.T) {
+	validator := &LocustTestScheduleCustomValidator{}
+	s := newTestLocustTestSchedule("nightly")
+	s.Spec.TimeZone = ptr.To("Europe/Berlin")
+
+	warnings, err := validator.ValidateCreate(context.Background(), s)
+	require.NoError(t, err)
+	assert.Nil(t, warnings)
+}
+
+func TestScheduleValidateCreate_InvalidSchedule(t *testing.T) {
+	validator := &LocustTestScheduleCustomValidator{}
+
+	tests := []struct {
+		name     string
+		schedule string
+		timeZone *string
+		errMsg   string
+	}{
+		{name: "MalformedCron", schedule: "not a cron", errMsg: "invalid schedule"},
+		{name: "TooManyFields", schedule: "0 0 2 * * *", errMsg: "invalid schedule"},
+		{name: "InlineTZ", schedule: "TZ=UTC 0 2 * * *", errMsg: "use spec.timeZone"},
+		{name: "InlineCronTZ", schedule: "CRON_TZ=UTC 0 2 * * *", errMsg: "use spec.timeZone"},
+		{name: "UnknownTimeZone", schedule: "0 2 * * *", timeZone: ptr.To("Mars/Olympus"), errMsg: "invalid timeZone"},
+		{name: "TimeZoneWithEvery", schedule: "@every 1h", timeZone: ptr.To("Europe/Berlin"), errMsg: "timeZone can't be set"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestLocustTestSchedule("nightly")
+			s.Spec.Schedule = tt.schedule
+			s.Spec.TimeZone = tt.timeZone
+
+			_, err := validator.ValidateCreate(context.Background(), s)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestScheduleValidateUpdate_InvalidTemplate(t *testing.T) {
+	validator := &LocustTestScheduleCustomValidator{}
+	oldS := newTestLocustTestSchedule("nightly")
+	newS := oldS.DeepCopy()
+	newS.Spec.TestTemplate.Spec.Env = &EnvConfig{
+		SecretMounts: []SecretMount{
+			{Name: "bad-secret", MountPath: "/lotest/src"},
+		},
+	}
+
+	_, err := validator.ValidateUpdate(context.Background(), oldS, newS)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "spec.testTemplate: ")
+	assert.Contains(t, err.Error(), "conflicts with reserved path")
+}
+
+func TestScheduleValidateDelete(t *testing.T) {
+	validator := &LocustTestScheduleCustomValidator{}
+	s := newTestLocustTestSchedule("nightly")
+	s.Spec.Schedule = "invalid"
+
+	warnings, err := validator.ValidateDelete(context.Background(), s)
+	require.NoError(t, err)
+	assert.Nil(t, warnings)
+}
+
+func TestScheduleValidateCreate_LongName(t *testing.T) {
+	validator := &LocustTestScheduleCustomValidator{}
+
+	t.Run("NameTooLong", func(t *testing.T) {
+		s := newTestLocustTestSchedule(strings.Repeat("a", 46))
+
+		_, err := validator.ValidateCreate(context.Background(), s)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "too long")
+	})
+
+	t.Run("NameAtLimit", func(t *testing.T) {
+		// 45 chars + "-" + minute timestamp + "-worker" stays within 63 chars
+		s := newTestLocustTestSchedule(strings.Repeat("a", 45))
+
+		_, err := validator.ValidateCreate(context.Background(), s)
+		require.NoError(t, err)
+	})
+}
+
+func TestParseSchedule_TimeZone(t *testing.T) {
+	sched, err := ParseSchedule("0 2 * * *", ptr.To("America/New_York"))
+	require.NoError(t, err)
+
+	// 02:00 in New York (EDT, UTC-4 in October) is 06:00 UTC
+	from := time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2026, 10, 16, 6, 0, 0, 0, time.UTC), sched.Next(from).UTC())
+}
+
+func TestScheduledTestName(t *testing.T) {
+	at := time.Date(2026, 10, 16, 2, 0, 0, 0, time.UTC)
+	name := ScheduledTestName("nightly", at)
+
+	assert.Equal(t, "nightly-29868600", name)
+	assert.Equal(t, name, ScheduledTestName("nightly", at.Add(30*time.Second)),
+		"names are stable within the scheduled minute")
+	assert.LessOrEqual(t, len(name)-len("nightly"), scheduledTestNameSuffixLen)
+}
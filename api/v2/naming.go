@@ -19,6 +19,7 @@ package v2
 import (
 	"fmt"
 	"strings"
+	"time"
 )
 
 // Node mode suffixes used when generating resource names from a CR name.
@@ -48,3 +49,26 @@ func SanitizeResourceName(name string) string {
 func GeneratedNodeName(crName, mode string) string {
 	return SanitizeResourceName(fmt.Sprintf("%s-%s", crName, mode))
 }
+
+// DefaultWorkerGroup names the workers of spec.worker in the per-group
+// worker status. Worker groups can't use it.
+const DefaultWorkerGroup = "default"
+
+// GeneratedWorkerGroupName returns the name of the worker Job the operator
+// generates for a worker group, e.g. ("load-test", "browse") ->
+// "load-test-worker-browse".
+func GeneratedWorkerGroupName(crName, group string) string {
+	return GeneratedNodeName(crName, NodeModeWorker+"-"+group)
+}
+
+// scheduledTestNameSuffixLen is the longest suffix ScheduledTestName appends:
+// a dash plus the scheduled time in minutes since the Unix epoch.
+const scheduledTestNameSuffixLen = 11
+
+// ScheduledTestName returns the name of the LocustTest a LocustTestSchedule
+// creates for the run scheduled at t, e.g. ("nightly", 2026-10-16T02:00Z) ->
+// "nightly-29868600". Deriving the name from the scheduled time makes run
+// creation idempotent: a retried reconcile finds the run it already created.
+func ScheduledTestName(scheduleName string, t time.Time) string {
+	return fmt.Sprintf("%s-%d", scheduleName, t.Unix()/60)
+}
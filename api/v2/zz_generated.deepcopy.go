@@ -21,9 +21,9 @@ limitations under the License.
 package v2
 
 import (
-	"k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	runtime "k8s.io/apimachinery/pkg/runtime"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -41,6 +41,64 @@ func (in *AutoquitConfig) DeepCopy() *AutoquitConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterLocustTestQueue) DeepCopyInto(out *ClusterLocustTestQueue) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterLocustTestQueue.
+func (in *ClusterLocustTestQueue) DeepCopy() *ClusterLocustTestQueue {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterLocustTestQueue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterLocustTestQueue) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterLocustTestQueueList) DeepCopyInto(out *ClusterLocustTestQueueList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterLocustTestQueue, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterLocustTestQueueList.
+func (in *ClusterLocustTestQueueList) DeepCopy() *ClusterLocustTestQueueList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterLocustTestQueueList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterLocustTestQueueList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConfigMapEnvSource) DeepCopyInto(out *ConfigMapEnvSource) {
 	*out = *in
@@ -56,6 +114,38 @@ func (in *ConfigMapEnvSource) DeepCopy() *ConfigMapEnvSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Container) DeepCopyInto(out *Container) {
+	*out = *in
+	in.Container.DeepCopyInto(&out.Container)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Container.
+func (in *Container) DeepCopy() *Container {
+	if in == nil {
+		return nil
+	}
+	out := new(Container)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointThresholds) DeepCopyInto(out *EndpointThresholds) {
+	*out = *in
+	in.ThresholdLimits.DeepCopyInto(&out.ThresholdLimits)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointThresholds.
+func (in *EndpointThresholds) DeepCopy() *EndpointThresholds {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointThresholds)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EnvConfig) DeepCopyInto(out *EnvConfig) {
 	*out = *in
@@ -71,7 +161,7 @@ func (in *EnvConfig) DeepCopyInto(out *EnvConfig) {
 	}
 	if in.Variables != nil {
 		in, out := &in.Variables, &out.Variables
-		*out = make([]v1.EnvVar, len(*in))
+		*out = make([]corev1.EnvVar, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -93,6 +183,209 @@ func (in *EnvConfig) DeepCopy() *EnvConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayParentReference) DeepCopyInto(out *GatewayParentReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayParentReference.
+func (in *GatewayParentReference) DeepCopy() *GatewayParentReference {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayParentReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitSource) DeepCopyInto(out *GitSource) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitSource.
+func (in *GitSource) DeepCopy() *GitSource {
+	if in == nil {
+		return nil
+	}
+	out := new(GitSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrafanaIntegration) DeepCopyInto(out *GrafanaIntegration) {
+	*out = *in
+	if in.APITokenSecretRef != nil {
+		in, out := &in.APITokenSecretRef, &out.APITokenSecretRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaIntegration.
+func (in *GrafanaIntegration) DeepCopy() *GrafanaIntegration {
+	if in == nil {
+		return nil
+	}
+	out := new(GrafanaIntegration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntegrationsConfig) DeepCopyInto(out *IntegrationsConfig) {
+	*out = *in
+	if in.Grafana != nil {
+		in, out := &in.Grafana, &out.Grafana
+		*out = new(GrafanaIntegration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Kafka != nil {
+		in, out := &in.Kafka, &out.Kafka
+		*out = new(KafkaIntegration)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationsConfig.
+func (in *IntegrationsConfig) DeepCopy() *IntegrationsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(IntegrationsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaIntegration) DeepCopyInto(out *KafkaIntegration) {
+	*out = *in
+	if in.SecurityEnabled != nil {
+		in, out := &in.SecurityEnabled, &out.SecurityEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UsernameSecretRef != nil {
+		in, out := &in.UsernameSecretRef, &out.UsernameSecretRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SaslJaasConfigSecretRef != nil {
+		in, out := &in.SaslJaasConfigSecretRef, &out.SaslJaasConfigSecretRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaIntegration.
+func (in *KafkaIntegration) DeepCopy() *KafkaIntegration {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaIntegration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LiveStats) DeepCopyInto(out *LiveStats) {
+	*out = *in
+	if in.MedianResponseTime != nil {
+		in, out := &in.MedianResponseTime, &out.MedianResponseTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.P95ResponseTime != nil {
+		in, out := &in.P95ResponseTime, &out.P95ResponseTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LiveStats.
+func (in *LiveStats) DeepCopy() *LiveStats {
+	if in == nil {
+		return nil
+	}
+	out := new(LiveStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadConfig) DeepCopyInto(out *LoadConfig) {
+	*out = *in
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SpawnRate != nil {
+		in, out := &in.SpawnRate, &out.SpawnRate
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.RunTime != nil {
+		in, out := &in.RunTime, &out.RunTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Stages != nil {
+		in, out := &in.Stages, &out.Stages
+		*out = make([]LoadStage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadConfig.
+func (in *LoadConfig) DeepCopy() *LoadConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadStage) DeepCopyInto(out *LoadStage) {
+	*out = *in
+	out.Duration = in.Duration
+	out.SpawnRate = in.SpawnRate.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadStage.
+func (in *LoadStage) DeepCopy() *LoadStage {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadStage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LocustTest) DeepCopyInto(out *LocustTest) {
 	*out = *in
@@ -168,80 +461,392 @@ func (in *LocustTestList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LocustTestSpec) DeepCopyInto(out *LocustTestSpec) {
+func (in *LocustTestQueue) DeepCopyInto(out *LocustTestQueue) {
 	*out = *in
-	if in.ImagePullSecrets != nil {
-		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
-		*out = make([]v1.LocalObjectReference, len(*in))
-		copy(*out, *in)
-	}
-	in.Master.DeepCopyInto(&out.Master)
-	in.Worker.DeepCopyInto(&out.Worker)
-	if in.TestFiles != nil {
-		in, out := &in.TestFiles, &out.TestFiles
-		*out = new(TestFilesConfig)
-		**out = **in
-	}
-	if in.Scheduling != nil {
-		in, out := &in.Scheduling, &out.Scheduling
-		*out = new(SchedulingConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Env != nil {
-		in, out := &in.Env, &out.Env
-		*out = new(EnvConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Volumes != nil {
-		in, out := &in.Volumes, &out.Volumes
-		*out = make([]v1.Volume, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.VolumeMounts != nil {
-		in, out := &in.VolumeMounts, &out.VolumeMounts
-		*out = make([]TargetedVolumeMount, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Security != nil {
-		in, out := &in.Security, &out.Security
-		*out = new(SecurityConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Observability != nil {
-		in, out := &in.Observability, &out.Observability
-		*out = new(ObservabilityConfig)
-		(*in).DeepCopyInto(*out)
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocustTestSpec.
-func (in *LocustTestSpec) DeepCopy() *LocustTestSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocustTestQueue.
+func (in *LocustTestQueue) DeepCopy() *LocustTestQueue {
 	if in == nil {
 		return nil
 	}
-	out := new(LocustTestSpec)
+	out := new(LocustTestQueue)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LocustTestQueue) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LocustTestStatus) DeepCopyInto(out *LocustTestStatus) {
+func (in *LocustTestQueueList) DeepCopyInto(out *LocustTestQueueList) {
 	*out = *in
-	if in.StartTime != nil {
-		in, out := &in.StartTime, &out.StartTime
-		*out = (*in).DeepCopy()
-	}
-	if in.CompletionTime != nil {
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LocustTestQueue, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocustTestQueueList.
+func (in *LocustTestQueueList) DeepCopy() *LocustTestQueueList {
+	if in == nil {
+		return nil
+	}
+	out := new(LocustTestQueueList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LocustTestQueueList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocustTestQueueSpec) DeepCopyInto(out *LocustTestQueueSpec) {
+	*out = *in
+	if in.MaxConcurrentTests != nil {
+		in, out := &in.MaxConcurrentTests, &out.MaxConcurrentTests
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxWorkerPods != nil {
+		in, out := &in.MaxWorkerPods, &out.MaxWorkerPods
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxCPU != nil {
+		in, out := &in.MaxCPU, &out.MaxCPU
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MaxMemory != nil {
+		in, out := &in.MaxMemory, &out.MaxMemory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocustTestQueueSpec.
+func (in *LocustTestQueueSpec) DeepCopy() *LocustTestQueueSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LocustTestQueueSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocustTestSchedule) DeepCopyInto(out *LocustTestSchedule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocustTestSchedule.
+func (in *LocustTestSchedule) DeepCopy() *LocustTestSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(LocustTestSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LocustTestSchedule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocustTestScheduleCustomValidator) DeepCopyInto(out *LocustTestScheduleCustomValidator) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocustTestScheduleCustomValidator.
+func (in *LocustTestScheduleCustomValidator) DeepCopy() *LocustTestScheduleCustomValidator {
+	if in == nil {
+		return nil
+	}
+	out := new(LocustTestScheduleCustomValidator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocustTestScheduleList) DeepCopyInto(out *LocustTestScheduleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LocustTestSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocustTestScheduleList.
+func (in *LocustTestScheduleList) DeepCopy() *LocustTestScheduleList {
+	if in == nil {
+		return nil
+	}
+	out := new(LocustTestScheduleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LocustTestScheduleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocustTestScheduleSpec) DeepCopyInto(out *LocustTestScheduleSpec) {
+	*out = *in
+	if in.TimeZone != nil {
+		in, out := &in.TimeZone, &out.TimeZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.StartingDeadlineSeconds != nil {
+		in, out := &in.StartingDeadlineSeconds, &out.StartingDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Suspend != nil {
+		in, out := &in.Suspend, &out.Suspend
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SuccessfulTestsHistoryLimit != nil {
+		in, out := &in.SuccessfulTestsHistoryLimit, &out.SuccessfulTestsHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FailedTestsHistoryLimit != nil {
+		in, out := &in.FailedTestsHistoryLimit, &out.FailedTestsHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+	in.TestTemplate.DeepCopyInto(&out.TestTemplate)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocustTestScheduleSpec.
+func (in *LocustTestScheduleSpec) DeepCopy() *LocustTestScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LocustTestScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocustTestScheduleStatus) DeepCopyInto(out *LocustTestScheduleStatus) {
+	*out = *in
+	if in.Active != nil {
+		in, out := &in.Active, &out.Active
+		*out = make([]corev1.ObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastScheduleTime != nil {
+		in, out := &in.LastScheduleTime, &out.LastScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSuccessfulTime != nil {
+		in, out := &in.LastSuccessfulTime, &out.LastSuccessfulTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocustTestScheduleStatus.
+func (in *LocustTestScheduleStatus) DeepCopy() *LocustTestScheduleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LocustTestScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocustTestSpec) DeepCopyInto(out *LocustTestSpec) {
+	*out = *in
+	if in.ImagePullSecrets != nil {
+		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
+		*out = make([]corev1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	in.Master.DeepCopyInto(&out.Master)
+	in.Worker.DeepCopyInto(&out.Worker)
+	if in.WorkerGroups != nil {
+		in, out := &in.WorkerGroups, &out.WorkerGroups
+		*out = make([]WorkerGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Load != nil {
+		in, out := &in.Load, &out.Load
+		*out = new(LoadConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxDuration != nil {
+		in, out := &in.MaxDuration, &out.MaxDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.StartAt != nil {
+		in, out := &in.StartAt, &out.StartAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Queue != nil {
+		in, out := &in.Queue, &out.Queue
+		*out = new(QueueConfig)
+		**out = **in
+	}
+	if in.Thresholds != nil {
+		in, out := &in.Thresholds, &out.Thresholds
+		*out = new(ThresholdsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Results != nil {
+		in, out := &in.Results, &out.Results
+		*out = new(ResultsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TestFiles != nil {
+		in, out := &in.TestFiles, &out.TestFiles
+		*out = new(TestFilesConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(SchedulingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = new(EnvConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]corev1.Volume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VolumeMounts != nil {
+		in, out := &in.VolumeMounts, &out.VolumeMounts
+		*out = make([]TargetedVolumeMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Security != nil {
+		in, out := &in.Security, &out.Security
+		*out = new(SecurityConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Observability != nil {
+		in, out := &in.Observability, &out.Observability
+		*out = new(ObservabilityConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WebUI != nil {
+		in, out := &in.WebUI, &out.WebUI
+		*out = new(WebUIConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = new(NotificationsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Integrations != nil {
+		in, out := &in.Integrations, &out.Integrations
+		*out = new(IntegrationsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocustTestSpec.
+func (in *LocustTestSpec) DeepCopy() *LocustTestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LocustTestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocustTestStatus) DeepCopyInto(out *LocustTestStatus) {
+	*out = *in
+	if in.WorkerAutoscaling != nil {
+		in, out := &in.WorkerAutoscaling, &out.WorkerAutoscaling
+		*out = new(WorkerAutoscalingStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = make([]WorkerStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.WorkerGroups != nil {
+		in, out := &in.WorkerGroups, &out.WorkerGroups
+		*out = make([]WorkerGroupStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Stats != nil {
+		in, out := &in.Stats, &out.Stats
+		*out = new(LiveStats)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Results != nil {
+		in, out := &in.Results, &out.Results
+		*out = new(ResultsStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
 		in, out := &in.CompletionTime, &out.CompletionTime
 		*out = (*in).DeepCopy()
 	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -258,6 +863,52 @@ func (in *LocustTestStatus) DeepCopy() *LocustTestStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocustTestTemplateMeta) DeepCopyInto(out *LocustTestTemplateMeta) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocustTestTemplateMeta.
+func (in *LocustTestTemplateMeta) DeepCopy() *LocustTestTemplateMeta {
+	if in == nil {
+		return nil
+	}
+	out := new(LocustTestTemplateMeta)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocustTestTemplateSpec) DeepCopyInto(out *LocustTestTemplateSpec) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocustTestTemplateSpec.
+func (in *LocustTestTemplateSpec) DeepCopy() *LocustTestTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LocustTestTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MasterSpec) DeepCopyInto(out *MasterSpec) {
 	*out = *in
@@ -276,71 +927,337 @@ func (in *MasterSpec) DeepCopyInto(out *MasterSpec) {
 			(*out)[key] = val
 		}
 	}
-	if in.Autostart != nil {
-		in, out := &in.Autostart, &out.Autostart
-		*out = new(bool)
-		**out = **in
+	if in.Autostart != nil {
+		in, out := &in.Autostart, &out.Autostart
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Autoquit != nil {
+		in, out := &in.Autoquit, &out.Autoquit
+		*out = new(AutoquitConfig)
+		**out = **in
+	}
+	if in.ExtraArgs != nil {
+		in, out := &in.ExtraArgs, &out.ExtraArgs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Sidecars != nil {
+		in, out := &in.Sidecars, &out.Sidecars
+		*out = make([]Container, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.InitContainers != nil {
+		in, out := &in.InitContainers, &out.InitContainers
+		*out = make([]Container, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PodTemplate != nil {
+		in, out := &in.PodTemplate, &out.PodTemplate
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MasterSpec.
+func (in *MasterSpec) DeepCopy() *MasterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MasterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationWebhook) DeepCopyInto(out *NotificationWebhook) {
+	*out = *in
+	if in.URLSecretRef != nil {
+		in, out := &in.URLSecretRef, &out.URLSecretRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Phases != nil {
+		in, out := &in.Phases, &out.Phases
+		*out = make([]Phase, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationWebhook.
+func (in *NotificationWebhook) DeepCopy() *NotificationWebhook {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationWebhook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationsConfig) DeepCopyInto(out *NotificationsConfig) {
+	*out = *in
+	if in.Webhooks != nil {
+		in, out := &in.Webhooks, &out.Webhooks
+		*out = make([]NotificationWebhook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationsConfig.
+func (in *NotificationsConfig) DeepCopy() *NotificationsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservabilityConfig) DeepCopyInto(out *ObservabilityConfig) {
+	*out = *in
+	if in.OpenTelemetry != nil {
+		in, out := &in.OpenTelemetry, &out.OpenTelemetry
+		*out = new(OpenTelemetryConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Prometheus != nil {
+		in, out := &in.Prometheus, &out.Prometheus
+		*out = new(PrometheusConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityConfig.
+func (in *ObservabilityConfig) DeepCopy() *ObservabilityConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservabilityConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenTelemetryConfig) DeepCopyInto(out *OpenTelemetryConfig) {
+	*out = *in
+	if in.ExtraEnvVars != nil {
+		in, out := &in.ExtraEnvVars, &out.ExtraEnvVars
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenTelemetryConfig.
+func (in *OpenTelemetryConfig) DeepCopy() *OpenTelemetryConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenTelemetryConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusConfig) DeepCopyInto(out *PrometheusConfig) {
+	*out = *in
+	if in.ServiceMonitor != nil {
+		in, out := &in.ServiceMonitor, &out.ServiceMonitor
+		*out = new(PrometheusMonitorConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodMonitor != nil {
+		in, out := &in.PodMonitor, &out.PodMonitor
+		*out = new(PrometheusMonitorConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusConfig.
+func (in *PrometheusConfig) DeepCopy() *PrometheusConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusMonitorConfig) DeepCopyInto(out *PrometheusMonitorConfig) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Relabelings != nil {
+		in, out := &in.Relabelings, &out.Relabelings
+		*out = make([]PrometheusRelabelConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusMonitorConfig.
+func (in *PrometheusMonitorConfig) DeepCopy() *PrometheusMonitorConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusMonitorConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusRelabelConfig) DeepCopyInto(out *PrometheusRelabelConfig) {
+	*out = *in
+	if in.SourceLabels != nil {
+		in, out := &in.SourceLabels, &out.SourceLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Separator != nil {
+		in, out := &in.Separator, &out.Separator
+		*out = new(string)
+		**out = **in
+	}
+	if in.Replacement != nil {
+		in, out := &in.Replacement, &out.Replacement
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusRelabelConfig.
+func (in *PrometheusRelabelConfig) DeepCopy() *PrometheusRelabelConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusRelabelConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueueConfig) DeepCopyInto(out *QueueConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueConfig.
+func (in *QueueConfig) DeepCopy() *QueueConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(QueueConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResultsConfig) DeepCopyInto(out *ResultsConfig) {
+	*out = *in
+	if in.Formats != nil {
+		in, out := &in.Formats, &out.Formats
+		*out = make([]ResultsFormat, len(*in))
+		copy(*out, *in)
+	}
+	if in.PersistentVolumeClaim != nil {
+		in, out := &in.PersistentVolumeClaim, &out.PersistentVolumeClaim
+		*out = new(ResultsVolumeClaim)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Autoquit != nil {
-		in, out := &in.Autoquit, &out.Autoquit
-		*out = new(AutoquitConfig)
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(ResultsS3Config)
 		**out = **in
 	}
-	if in.ExtraArgs != nil {
-		in, out := &in.ExtraArgs, &out.ExtraArgs
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResultsConfig.
+func (in *ResultsConfig) DeepCopy() *ResultsConfig {
+	if in == nil {
+		return nil
 	}
+	out := new(ResultsConfig)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MasterSpec.
-func (in *MasterSpec) DeepCopy() *MasterSpec {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResultsS3Config) DeepCopyInto(out *ResultsS3Config) {
+	*out = *in
+	out.CredentialsSecretRef = in.CredentialsSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResultsS3Config.
+func (in *ResultsS3Config) DeepCopy() *ResultsS3Config {
 	if in == nil {
 		return nil
 	}
-	out := new(MasterSpec)
+	out := new(ResultsS3Config)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObservabilityConfig) DeepCopyInto(out *ObservabilityConfig) {
+func (in *ResultsStatus) DeepCopyInto(out *ResultsStatus) {
 	*out = *in
-	if in.OpenTelemetry != nil {
-		in, out := &in.OpenTelemetry, &out.OpenTelemetry
-		*out = new(OpenTelemetryConfig)
-		(*in).DeepCopyInto(*out)
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CollectionTime != nil {
+		in, out := &in.CollectionTime, &out.CollectionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.URLs != nil {
+		in, out := &in.URLs, &out.URLs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityConfig.
-func (in *ObservabilityConfig) DeepCopy() *ObservabilityConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResultsStatus.
+func (in *ResultsStatus) DeepCopy() *ResultsStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ObservabilityConfig)
+	out := new(ResultsStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenTelemetryConfig) DeepCopyInto(out *OpenTelemetryConfig) {
+func (in *ResultsVolumeClaim) DeepCopyInto(out *ResultsVolumeClaim) {
 	*out = *in
-	if in.ExtraEnvVars != nil {
-		in, out := &in.ExtraEnvVars, &out.ExtraEnvVars
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	out.Size = in.Size.DeepCopy()
+	if in.StorageClassName != nil {
+		in, out := &in.StorageClassName, &out.StorageClassName
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenTelemetryConfig.
-func (in *OpenTelemetryConfig) DeepCopy() *OpenTelemetryConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResultsVolumeClaim.
+func (in *ResultsVolumeClaim) DeepCopy() *ResultsVolumeClaim {
 	if in == nil {
 		return nil
 	}
-	out := new(OpenTelemetryConfig)
+	out := new(ResultsVolumeClaim)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -350,12 +1267,12 @@ func (in *SchedulingConfig) DeepCopyInto(out *SchedulingConfig) {
 	*out = *in
 	if in.Affinity != nil {
 		in, out := &in.Affinity, &out.Affinity
-		*out = new(v1.Affinity)
+		*out = new(corev1.Affinity)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Tolerations != nil {
 		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
+		*out = make([]corev1.Toleration, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -419,12 +1336,12 @@ func (in *SecurityConfig) DeepCopyInto(out *SecurityConfig) {
 	*out = *in
 	if in.PodSecurityContext != nil {
 		in, out := &in.PodSecurityContext, &out.PodSecurityContext
-		*out = new(v1.PodSecurityContext)
+		*out = new(corev1.PodSecurityContext)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.ContainerSecurityContext != nil {
 		in, out := &in.ContainerSecurityContext, &out.ContainerSecurityContext
-		*out = new(v1.SecurityContext)
+		*out = new(corev1.SecurityContext)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -458,6 +1375,18 @@ func (in *TargetedVolumeMount) DeepCopy() *TargetedVolumeMount {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TestFilesConfig) DeepCopyInto(out *TestFilesConfig) {
 	*out = *in
+	if in.Git != nil {
+		in, out := &in.Git, &out.Git
+		*out = new(GitSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Inline != nil {
+		in, out := &in.Inline, &out.Inline
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestFilesConfig.
@@ -470,6 +1399,258 @@ func (in *TestFilesConfig) DeepCopy() *TestFilesConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThresholdLimits) DeepCopyInto(out *ThresholdLimits) {
+	*out = *in
+	if in.MaxFailureRatio != nil {
+		in, out := &in.MaxFailureRatio, &out.MaxFailureRatio
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MaxP95ResponseTime != nil {
+		in, out := &in.MaxP95ResponseTime, &out.MaxP95ResponseTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxP99ResponseTime != nil {
+		in, out := &in.MaxP99ResponseTime, &out.MaxP99ResponseTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MinRPS != nil {
+		in, out := &in.MinRPS, &out.MinRPS
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThresholdLimits.
+func (in *ThresholdLimits) DeepCopy() *ThresholdLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(ThresholdLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThresholdsConfig) DeepCopyInto(out *ThresholdsConfig) {
+	*out = *in
+	in.ThresholdLimits.DeepCopyInto(&out.ThresholdLimits)
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]EndpointThresholds, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThresholdsConfig.
+func (in *ThresholdsConfig) DeepCopy() *ThresholdsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ThresholdsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebUIConfig) DeepCopyInto(out *WebUIConfig) {
+	*out = *in
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = new(WebUIIngressConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTPRoute != nil {
+		in, out := &in.HTTPRoute, &out.HTTPRoute
+		*out = new(WebUIHTTPRouteConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebUIConfig.
+func (in *WebUIConfig) DeepCopy() *WebUIConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(WebUIConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebUIHTTPRouteConfig) DeepCopyInto(out *WebUIHTTPRouteConfig) {
+	*out = *in
+	if in.ParentRefs != nil {
+		in, out := &in.ParentRefs, &out.ParentRefs
+		*out = make([]GatewayParentReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.Hostnames != nil {
+		in, out := &in.Hostnames, &out.Hostnames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebUIHTTPRouteConfig.
+func (in *WebUIHTTPRouteConfig) DeepCopy() *WebUIHTTPRouteConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(WebUIHTTPRouteConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebUIIngressConfig) DeepCopyInto(out *WebUIIngressConfig) {
+	*out = *in
+	if in.ClassName != nil {
+		in, out := &in.ClassName, &out.ClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebUIIngressConfig.
+func (in *WebUIIngressConfig) DeepCopy() *WebUIIngressConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(WebUIIngressConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerAutoscaling) DeepCopyInto(out *WorkerAutoscaling) {
+	*out = *in
+	if in.Cooldown != nil {
+		in, out := &in.Cooldown, &out.Cooldown
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerAutoscaling.
+func (in *WorkerAutoscaling) DeepCopy() *WorkerAutoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerAutoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerAutoscalingStatus) DeepCopyInto(out *WorkerAutoscalingStatus) {
+	*out = *in
+	if in.LastScaleTime != nil {
+		in, out := &in.LastScaleTime, &out.LastScaleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]WorkerScalingDecision, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerAutoscalingStatus.
+func (in *WorkerAutoscalingStatus) DeepCopy() *WorkerAutoscalingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerAutoscalingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerGroup) DeepCopyInto(out *WorkerGroup) {
+	*out = *in
+	if in.ExtraArgs != nil {
+		in, out := &in.ExtraArgs, &out.ExtraArgs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Resources.DeepCopyInto(&out.Resources)
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(SchedulingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerGroup.
+func (in *WorkerGroup) DeepCopy() *WorkerGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerGroupStatus) DeepCopyInto(out *WorkerGroupStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerGroupStatus.
+func (in *WorkerGroupStatus) DeepCopy() *WorkerGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerScalingDecision) DeepCopyInto(out *WorkerScalingDecision) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerScalingDecision.
+func (in *WorkerScalingDecision) DeepCopy() *WorkerScalingDecision {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerScalingDecision)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkerSpec) DeepCopyInto(out *WorkerSpec) {
 	*out = *in
@@ -493,6 +1674,30 @@ func (in *WorkerSpec) DeepCopyInto(out *WorkerSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(WorkerAutoscaling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Sidecars != nil {
+		in, out := &in.Sidecars, &out.Sidecars
+		*out = make([]Container, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.InitContainers != nil {
+		in, out := &in.InitContainers, &out.InitContainers
+		*out = make([]Container, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PodTemplate != nil {
+		in, out := &in.PodTemplate, &out.PodTemplate
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerSpec.
@@ -504,3 +1709,18 @@ func (in *WorkerSpec) DeepCopy() *WorkerSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerStatus) DeepCopyInto(out *WorkerStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerStatus.
+func (in *WorkerStatus) DeepCopy() *WorkerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -276,6 +276,17 @@ Metrics Exporter Port - new path with fallback to old path
 {{- end }}
 {{- end }}
 
+{{/*
+Metrics Exporter Mode - Sidecar (per-test exporter) or Builtin (operator endpoint)
+*/}}
+{{- define "locust.metricsExporterMode" -}}
+{{- if and .Values.locustPods .Values.locustPods.metricsExporter .Values.locustPods.metricsExporter.mode }}
+{{- .Values.locustPods.metricsExporter.mode }}
+{{- else }}
+{{- "Sidecar" }}
+{{- end }}
+{{- end }}
+
 {{/*
 Metrics Exporter Pull Policy - new path with fallback to old path
 */}}
@@ -554,7 +565,7 @@ Categories:
   - Resource limits: CPU, memory, ephemeral storage for Locust pods
   - Feature flags: Affinity injection, tolerations injection
   - Metrics exporter: Sidecar container configuration
-  - Kafka: Message queue configuration (deprecated)
+  - Kafka: Defaults of spec.integrations.kafka
 =============================================================================
 */}}
 {{- define "locust-k8s-operator.envVars" -}}
@@ -649,9 +660,48 @@ Categories:
 - name: DEFAULT_RUNTIME_CLASS_NAME
   value: {{ $runtimeClassName | quote }}
 {{- end }}
-# Metrics exporter sidecar configuration
+# How often the operator reads live statistics from the master of running tests
+{{- if and .Values.liveStats .Values.liveStats.pollInterval }}
+- name: STATS_POLL_INTERVAL
+  value: {{ .Values.liveStats.pollInterval | quote }}
+{{- end }}
+# Maximum run duration of tests: default for tests without spec.maxDuration,
+# and a cap on every test. Only emitted when set; empty means unlimited.
+{{- if and .Values.maxDuration .Values.maxDuration.default }}
+- name: DEFAULT_MAX_DURATION
+  value: {{ .Values.maxDuration.default | quote }}
+{{- end }}
+{{- if and .Values.maxDuration .Values.maxDuration.limit }}
+- name: MAX_DURATION_LIMIT
+  value: {{ .Values.maxDuration.limit | quote }}
+{{- end }}
+# Webhooks notified of the phase transitions of tests without spec.notifications
+{{- if and .Values.notifications .Values.notifications.webhookURLs }}
+- name: NOTIFICATION_WEBHOOK_URLS
+  value: {{ join "," .Values.notifications.webhookURLs | quote }}
+{{- end }}
+# Grafana annotated with the run of every test
+{{- if and .Values.grafana .Values.grafana.url }}
+- name: GRAFANA_URL
+  value: {{ .Values.grafana.url | quote }}
+{{- if .Values.grafana.apiToken.secretName }}
+- name: GRAFANA_API_TOKEN
+  valueFrom:
+    secretKeyRef:
+      name: {{ .Values.grafana.apiToken.secretName }}
+      key: {{ .Values.grafana.apiToken.key | default "token" }}
+{{- end }}
+{{- end }}
+# Image of the init container cloning testFiles.git
+{{- if and .Values.locustPods .Values.locustPods.gitClone .Values.locustPods.gitClone.image }}
+- name: GIT_CLONE_IMAGE
+  value: {{ .Values.locustPods.gitClone.image | quote }}
+{{- end }}
+# Metrics exporter configuration (Sidecar per test, or Builtin on the operator endpoint)
 # This Prometheus exporter runs alongside the Locust master to expose metrics
 # Note: Not used when OpenTelemetry is enabled (OTel replaces the sidecar)
+- name: METRICS_EXPORTER_MODE
+  value: {{ include "locust.metricsExporterMode" . | quote }}
 - name: METRICS_EXPORTER_IMAGE
   value: {{ include "locust.metricsExporterImage" . | quote }}
 - name: METRICS_EXPORTER_PORT
@@ -677,9 +727,13 @@ Categories:
 - name: JOB_TTL_SECONDS_AFTER_FINISHED
   value: {{ $ttl | quote }}
 {{- end }}
-# Kafka configuration (DEPRECATED - kept for backward compatibility)
-# Consider using OpenTelemetry for metrics export instead
+# Kafka defaults of tests setting spec.integrations.kafka.
+# Credentials are only passed as the name and keys of a Secret, which the
+# Locust pods reference in their own namespace.
 {{- if .Values.kafka.enabled }}
+{{- if .Values.kafka.security.jaasConfig }}
+{{- fail "kafka.security.jaasConfig is no longer supported: store the JAAS configuration in the kafka.credentials Secret and set kafka.credentials.saslJaasConfigKey" }}
+{{- end }}
 - name: KAFKA_BOOTSTRAP_SERVERS
   value: {{ include "locust.kafkaBootstrapServers" . | quote }}
 - name: KAFKA_SECURITY_ENABLED
@@ -689,35 +743,24 @@ Categories:
   value: {{ .Values.kafka.security.protocol | default .Values.config.loadGenerationPods.kafka.acl.protocol | default "SASL_PLAINTEXT" | quote }}
 - name: KAFKA_SASL_MECHANISM
   value: {{ .Values.kafka.security.saslMechanism | default .Values.config.loadGenerationPods.kafka.sasl.mechanism | default "SCRAM-SHA-512" | quote }}
-{{- if .Values.kafka.security.jaasConfig }}
-- name: KAFKA_SASL_JAAS_CONFIG
-  value: {{ .Values.kafka.security.jaasConfig | quote }}
-{{- else if .Values.config }}
-- name: KAFKA_SASL_JAAS_CONFIG
-  value: {{ .Values.config.loadGenerationPods.kafka.sasl.jaas.config | quote }}
-{{- end }}
 {{- if .Values.kafka.credentials.secretName }}
-- name: KAFKA_USERNAME
-  valueFrom:
-    secretKeyRef:
-      name: {{ .Values.kafka.credentials.secretName }}
-      key: {{ .Values.kafka.credentials.usernameKey | default "username" }}
-- name: KAFKA_PASSWORD
-  valueFrom:
-    secretKeyRef:
-      name: {{ .Values.kafka.credentials.secretName }}
-      key: {{ .Values.kafka.credentials.passwordKey | default "password" }}
+- name: KAFKA_CREDENTIALS_SECRET_NAME
+  value: {{ .Values.kafka.credentials.secretName | quote }}
+- name: KAFKA_CREDENTIALS_USERNAME_KEY
+  value: {{ .Values.kafka.credentials.usernameKey | default "username" | quote }}
+- name: KAFKA_CREDENTIALS_PASSWORD_KEY
+  value: {{ .Values.kafka.credentials.passwordKey | default "password" | quote }}
+{{- if .Values.kafka.credentials.saslJaasConfigKey }}
+- name: KAFKA_CREDENTIALS_SASL_JAAS_CONFIG_KEY
+  value: {{ .Values.kafka.credentials.saslJaasConfigKey | quote }}
+{{- end }}
 {{- else if .Values.config }}
-- name: KAFKA_USERNAME
-  valueFrom:
-    secretKeyRef:
-      name: {{ .Values.config.loadGenerationPods.kafka.locustK8sKafkaUser.userName }}
-      key: {{ .Values.config.loadGenerationPods.kafka.acl.secret.userKey }}
-- name: KAFKA_PASSWORD
-  valueFrom:
-    secretKeyRef:
-      name: {{ .Values.config.loadGenerationPods.kafka.locustK8sKafkaUser.userName }}
-      key: {{ .Values.config.loadGenerationPods.kafka.acl.secret.passwordKey }}
+- name: KAFKA_CREDENTIALS_SECRET_NAME
+  value: {{ .Values.config.loadGenerationPods.kafka.locustK8sKafkaUser.userName | quote }}
+- name: KAFKA_CREDENTIALS_USERNAME_KEY
+  value: {{ .Values.config.loadGenerationPods.kafka.acl.secret.userKey | quote }}
+- name: KAFKA_CREDENTIALS_PASSWORD_KEY
+  value: {{ .Values.config.loadGenerationPods.kafka.acl.secret.passwordKey | quote }}
 {{- end }}
 {{- end }}
 {{- end }}
@@ -408,8 +408,9 @@ func setupMetricsServer(flags *flagConfig, tlsOpts []func(*tls.Config)) (metrics
 	return metricsServerOptions, metricsCertWatcher
 }
 
-// registerControllersAndWebhooks registers the LocustTest reconciler and,
-// when enableWebhooks is true, the v1 conversion + v2 validation webhooks.
+// registerControllersAndWebhooks registers the LocustTest and
+// LocustTestSchedule reconcilers and, when enableWebhooks is true, the v1
+// conversion + v2 validation webhooks.
 //
 // When enableWebhooks is false the body MUST NOT call SetupWebhookWithManager
 // or mgr.GetWebhookServer() — either call adds the webhook server as a
@@ -422,14 +423,17 @@ func registerControllersAndWebhooks(mgr ctrl.Manager, enableWebhooks bool) error
 	}
 	setupLog.Info("Operator configuration loaded",
 		"ttlSecondsAfterFinished", cfg.TTLSecondsAfterFinished,
+		"metricsExporterMode", cfg.MetricsExporterMode,
 		"metricsExporterImage", cfg.MetricsExporterImage,
 		"affinityInjection", cfg.EnableAffinityCRInjection,
-		"tolerationsInjection", cfg.EnableTolerationsCRInjection)
+		"tolerationsInjection", cfg.EnableTolerationsCRInjection,
+		"statsPollInterval", cfg.StatsPollInterval)
 
 	if err := (&controller.LocustTestReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
-		Config: cfg,
+		Client:    mgr.GetClient(),
+		Scheme:    mgr.GetScheme(),
+		Config:    cfg,
+		APIReader: mgr.GetAPIReader(),
 		// controller-runtime v0.24 deprecated GetEventRecorderFor in favour of
 		// GetEventRecorder. That is not a drop-in swap: it returns the
 		// events.k8s.io/v1 recorder, whose interface has no Event method and
@@ -442,6 +446,15 @@ func registerControllersAndWebhooks(mgr ctrl.Manager, enableWebhooks bool) error
 		return fmt.Errorf("unable to create controller LocustTest: %w", err)
 	}
 
+	if err := (&controller.LocustTestScheduleReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+		//nolint:staticcheck // SA1019: deliberate, see above
+		Recorder: mgr.GetEventRecorderFor("locusttestschedule-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("unable to create controller LocustTestSchedule: %w", err)
+	}
+
 	if enableWebhooks {
 		if err := (&locustv1.LocustTest{}).SetupWebhookWithManager(mgr); err != nil {
 			return fmt.Errorf("unable to create webhook LocustTest v1: %w", err)
@@ -449,6 +462,9 @@ func registerControllersAndWebhooks(mgr ctrl.Manager, enableWebhooks bool) error
 		if err := (&locustv2.LocustTest{}).SetupWebhookWithManager(mgr); err != nil {
 			return fmt.Errorf("unable to create webhook LocustTest v2: %w", err)
 		}
+		if err := (&locustv2.LocustTestSchedule{}).SetupWebhookWithManager(mgr); err != nil {
+			return fmt.Errorf("unable to create webhook LocustTestSchedule v2: %w", err)
+		}
 	}
 	// +kubebuilder:scaffold:builder
 
@@ -19,14 +19,23 @@ package config
 import (
 	"fmt"
 	"log"
+	"net/url"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/validation"
 )
 
+// Values of METRICS_EXPORTER_MODE, matching the values of
+// spec.observability.metricsExporter.
+const (
+	MetricsExporterModeSidecar = "Sidecar"
+	MetricsExporterModeBuiltin = "Builtin"
+)
+
 // OperatorConfig holds all operator configuration loaded from environment variables.
 type OperatorConfig struct {
 	// Job configuration
@@ -58,6 +67,10 @@ type OperatorConfig struct {
 	WorkerMemLimit                string
 	WorkerEphemeralStorageLimit   string
 
+	// MetricsExporterMode is how the Locust metrics of tests that don't set
+	// spec.observability.metricsExporter are exported: "Sidecar" or "Builtin".
+	MetricsExporterMode string
+
 	// Metrics exporter sidecar configuration
 	MetricsExporterImage                   string
 	MetricsExporterPort                    int32
@@ -69,14 +82,26 @@ type OperatorConfig struct {
 	MetricsExporterMemLimit                string
 	MetricsExporterEphemeralStorageLimit   string
 
-	// Kafka configuration for optional Kafka integration
+	// GitCloneImage is the image of the init container cloning testFiles.git.
+	// It needs git, ssh and a POSIX shell.
+	GitCloneImage string
+
+	// Kafka defaults of tests setting spec.integrations.kafka
 	KafkaBootstrapServers string
 	KafkaSecurityEnabled  bool
 	KafkaSecurityProtocol string
-	KafkaUsername         string
-	KafkaPassword         string
 	KafkaSaslMechanism    string
-	KafkaSaslJaasConfig   string
+
+	// KafkaCredentialsSecretName is the Secret holding the default Kafka
+	// credentials, looked up in the namespace of each test. Its keys are only
+	// referenced from the pods, never read by the operator. Empty means tests
+	// get no credentials unless they reference their own Secrets.
+	KafkaCredentialsSecretName string
+	KafkaUsernameKey           string
+	KafkaPasswordKey           string
+	// KafkaSaslJaasConfigKey is the key of the JAAS configuration in the
+	// credentials Secret. Empty means no JAAS configuration.
+	KafkaSaslJaasConfigKey string
 
 	// Feature flags
 	// EnableAffinityCRInjection enables injecting affinity rules from CR spec
@@ -87,6 +112,27 @@ type OperatorConfig struct {
 	// DefaultRuntimeClassName is the operator-wide default runtimeClassName applied to
 	// generated Locust master/worker pods when the CR does not specify one. Empty means unset.
 	DefaultRuntimeClassName string
+
+	// StatsPollInterval is how often the statistics of running tests are read
+	// from their Locust master.
+	StatsPollInterval time.Duration
+
+	// DefaultMaxDuration is the maximum run duration of tests that don't set
+	// spec.maxDuration. Zero means unlimited.
+	DefaultMaxDuration time.Duration
+	// MaxDurationLimit caps the maximum run duration of every test, including
+	// those setting a longer spec.maxDuration. Zero means no cap.
+	MaxDurationLimit time.Duration
+
+	// NotificationWebhookURLs receive the phase transitions of tests that
+	// don't set spec.notifications.
+	NotificationWebhookURLs []string
+
+	// GrafanaURL is the Grafana annotated with the run of every test, using
+	// GrafanaAPIToken. Empty means only tests setting spec.integrations.grafana
+	// are annotated.
+	GrafanaURL      string
+	GrafanaAPIToken string
 }
 
 // LoadConfig loads operator configuration from environment variables.
@@ -120,6 +166,7 @@ func LoadConfig() (*OperatorConfig, error) {
 		WorkerEphemeralStorageLimit:   getEnv("WORKER_POD_EPHEMERAL_LIMIT", ""),
 
 		// Metrics exporter configuration
+		MetricsExporterMode:                    getEnv("METRICS_EXPORTER_MODE", MetricsExporterModeSidecar),
 		MetricsExporterImage:                   getEnv("METRICS_EXPORTER_IMAGE", "containersol/locust_exporter:v0.5.0"),
 		MetricsExporterPort:                    getEnvInt32("METRICS_EXPORTER_PORT", 9646),
 		MetricsExporterPullPolicy:              getEnv("METRICS_EXPORTER_IMAGE_PULL_POLICY", "Always"),
@@ -130,14 +177,19 @@ func LoadConfig() (*OperatorConfig, error) {
 		MetricsExporterMemLimit:                getEnv("METRICS_EXPORTER_MEM_LIMIT", "1024Mi"),
 		MetricsExporterEphemeralStorageLimit:   getEnv("METRICS_EXPORTER_EPHEMERAL_LIMIT", "50M"),
 
+		// Git clone init container
+		GitCloneImage: getEnv("GIT_CLONE_IMAGE", "alpine/git:v2.47.2"),
+
 		// Kafka configuration
 		KafkaBootstrapServers: getEnv("KAFKA_BOOTSTRAP_SERVERS", "localhost:9092"),
 		KafkaSecurityEnabled:  getEnvBool("KAFKA_SECURITY_ENABLED", false),
 		KafkaSecurityProtocol: getEnv("KAFKA_SECURITY_PROTOCOL_CONFIG", "SASL_PLAINTEXT"),
-		KafkaUsername:         getEnv("KAFKA_USERNAME", ""),
-		KafkaPassword:         getEnv("KAFKA_PASSWORD", ""),
 		KafkaSaslMechanism:    getEnv("KAFKA_SASL_MECHANISM", "SCRAM-SHA-512"),
-		KafkaSaslJaasConfig:   getEnv("KAFKA_SASL_JAAS_CONFIG", ""),
+
+		KafkaCredentialsSecretName: getEnv("KAFKA_CREDENTIALS_SECRET_NAME", ""),
+		KafkaUsernameKey:           getEnv("KAFKA_CREDENTIALS_USERNAME_KEY", "username"),
+		KafkaPasswordKey:           getEnv("KAFKA_CREDENTIALS_PASSWORD_KEY", "password"),
+		KafkaSaslJaasConfigKey:     getEnv("KAFKA_CREDENTIALS_SASL_JAAS_CONFIG_KEY", ""),
 
 		// Feature flags
 		EnableAffinityCRInjection:    getEnvBool("ENABLE_AFFINITY_CR_INJECTION", false),
@@ -145,6 +197,20 @@ func LoadConfig() (*OperatorConfig, error) {
 
 		// Scheduling defaults
 		DefaultRuntimeClassName: getEnv("DEFAULT_RUNTIME_CLASS_NAME", ""),
+
+		// Live statistics
+		StatsPollInterval: getEnvDuration("STATS_POLL_INTERVAL", 10*time.Second),
+
+		// Run duration limits
+		DefaultMaxDuration: getEnvDuration("DEFAULT_MAX_DURATION", 0),
+		MaxDurationLimit:   getEnvDuration("MAX_DURATION_LIMIT", 0),
+
+		// Notifications
+		NotificationWebhookURLs: getEnvList("NOTIFICATION_WEBHOOK_URLS"),
+
+		// Grafana annotations
+		GrafanaURL:      getEnv("GRAFANA_URL", ""),
+		GrafanaAPIToken: getEnv("GRAFANA_API_TOKEN", ""),
 	}
 
 	// Validate all resource quantities at startup
@@ -157,9 +223,76 @@ func LoadConfig() (*OperatorConfig, error) {
 		return nil, fmt.Errorf("invalid operator configuration: %w", err)
 	}
 
+	if cfg.StatsPollInterval < time.Second {
+		return nil, fmt.Errorf("invalid operator configuration: STATS_POLL_INTERVAL must be at least 1s, got %s",
+			cfg.StatsPollInterval)
+	}
+
+	if err := validateMaxDuration(cfg); err != nil {
+		return nil, fmt.Errorf("invalid operator configuration: %w", err)
+	}
+
+	if err := validateKafka(cfg); err != nil {
+		return nil, fmt.Errorf("invalid operator configuration: %w", err)
+	}
+
+	if cfg.MetricsExporterMode != MetricsExporterModeSidecar && cfg.MetricsExporterMode != MetricsExporterModeBuiltin {
+		return nil, fmt.Errorf("invalid operator configuration: METRICS_EXPORTER_MODE must be %q or %q, got %q",
+			MetricsExporterModeSidecar, MetricsExporterModeBuiltin, cfg.MetricsExporterMode)
+	}
+
+	for _, u := range cfg.NotificationWebhookURLs {
+		if parsed, err := url.Parse(u); err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return nil, fmt.Errorf("invalid operator configuration: NOTIFICATION_WEBHOOK_URLS must contain http or https URLs, got %q", u)
+		}
+	}
+
+	if cfg.GrafanaURL != "" {
+		if parsed, err := url.Parse(cfg.GrafanaURL); err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return nil, fmt.Errorf("invalid operator configuration: GRAFANA_URL must be an http or https URL, got %q", cfg.GrafanaURL)
+		}
+	}
+
 	return cfg, nil
 }
 
+// validateMaxDuration validates the run duration limits. A default above the
+// limit is rejected rather than silently capped, since it is clearly a typo.
+func validateMaxDuration(cfg *OperatorConfig) error {
+	if cfg.DefaultMaxDuration < 0 {
+		return fmt.Errorf("DEFAULT_MAX_DURATION must not be negative, got %s", cfg.DefaultMaxDuration)
+	}
+	if cfg.MaxDurationLimit < 0 {
+		return fmt.Errorf("MAX_DURATION_LIMIT must not be negative, got %s", cfg.MaxDurationLimit)
+	}
+	if cfg.MaxDurationLimit > 0 && cfg.DefaultMaxDuration > cfg.MaxDurationLimit {
+		return fmt.Errorf("DEFAULT_MAX_DURATION (%s) must not exceed MAX_DURATION_LIMIT (%s)",
+			cfg.DefaultMaxDuration, cfg.MaxDurationLimit)
+	}
+	return nil
+}
+
+// validateKafka validates the Kafka credentials Secret. The credentials used
+// to be literal values copied into every pod; setting them is rejected rather
+// than ignored, so an upgrade can't silently drop them.
+func validateKafka(cfg *OperatorConfig) error {
+	for _, key := range []string{"KAFKA_USERNAME", "KAFKA_PASSWORD", "KAFKA_SASL_JAAS_CONFIG"} {
+		if _, ok := os.LookupEnv(key); ok {
+			return fmt.Errorf("%s is no longer supported: store the Kafka credentials in a Secret in the namespace of "+
+				"the tests and set KAFKA_CREDENTIALS_SECRET_NAME and its KAFKA_CREDENTIALS_*_KEY keys instead", key)
+		}
+	}
+
+	if cfg.KafkaCredentialsSecretName == "" {
+		return nil
+	}
+	if msgs := validation.IsDNS1123Subdomain(cfg.KafkaCredentialsSecretName); len(msgs) > 0 {
+		return fmt.Errorf("invalid value for KAFKA_CREDENTIALS_SECRET_NAME: %q is not a valid Secret name: %s",
+			cfg.KafkaCredentialsSecretName, strings.Join(msgs, "; "))
+	}
+	return nil
+}
+
 // validateSchedulingDefaults validates scheduling defaults that the operator injects into every
 // generated pod. Unlike the CR fields, these values never pass through CRD schema validation, so
 // an invalid value would be rejected by the API server on every single Job create. Failing at
@@ -268,6 +401,30 @@ func getEnvInt32(key string, defaultValue int32) int32 {
 	return defaultValue
 }
 
+// getEnvDuration returns the duration value of an environment variable (e.g. "30s") or a default value if not set.
+func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
+	if v := os.Getenv(key); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			log.Printf("WARNING: env var %s has unparseable duration value %q, using default %s", key, v, defaultValue) //nolint:gosec // G706 - env var value is safely quoted with %q
+			return defaultValue
+		}
+		return d
+	}
+	return defaultValue
+}
+
+// getEnvList returns the comma-separated values of an environment variable, or nil if not set.
+func getEnvList(key string) []string {
+	var values []string
+	for v := range strings.SplitSeq(os.Getenv(key), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
 // getEnvInt32Ptr returns a pointer to an int32 value of an environment variable, or nil if not set.
 // This is used for optional fields where nil indicates "not configured" vs 0.
 func getEnvInt32Ptr(key string) *int32 {
@@ -22,6 +22,7 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -47,6 +48,7 @@ func TestLoadConfig_DefaultValues(t *testing.T) {
 		"POD_CPU_LIMIT",
 		"POD_MEM_LIMIT",
 		"POD_EPHEMERAL_LIMIT",
+		"METRICS_EXPORTER_MODE",
 		"METRICS_EXPORTER_IMAGE",
 		"METRICS_EXPORTER_PORT",
 		"METRICS_EXPORTER_IMAGE_PULL_POLICY",
@@ -56,16 +58,21 @@ func TestLoadConfig_DefaultValues(t *testing.T) {
 		"METRICS_EXPORTER_CPU_LIMIT",
 		"METRICS_EXPORTER_MEM_LIMIT",
 		"METRICS_EXPORTER_EPHEMERAL_LIMIT",
+		"GIT_CLONE_IMAGE",
 		"KAFKA_BOOTSTRAP_SERVERS",
 		"KAFKA_SECURITY_ENABLED",
 		"KAFKA_SECURITY_PROTOCOL_CONFIG",
-		"KAFKA_USERNAME",
-		"KAFKA_PASSWORD",
 		"KAFKA_SASL_MECHANISM",
-		"KAFKA_SASL_JAAS_CONFIG",
+		"KAFKA_CREDENTIALS_SECRET_NAME",
+		"KAFKA_CREDENTIALS_USERNAME_KEY",
+		"KAFKA_CREDENTIALS_PASSWORD_KEY",
+		"KAFKA_CREDENTIALS_SASL_JAAS_CONFIG_KEY",
 		"ENABLE_AFFINITY_CR_INJECTION",
 		"ENABLE_TAINT_TOLERATIONS_CR_INJECTION",
 		"DEFAULT_RUNTIME_CLASS_NAME",
+		"NOTIFICATION_WEBHOOK_URLS",
+		"GRAFANA_URL",
+		"GRAFANA_API_TOKEN",
 	}
 	for _, env := range envVars {
 		_ = os.Unsetenv(env)
@@ -86,6 +93,7 @@ func TestLoadConfig_DefaultValues(t *testing.T) {
 	assert.Equal(t, "50M", cfg.PodEphemeralStorageLimit)
 
 	// Metrics exporter - match Java application.yml defaults
+	assert.Equal(t, MetricsExporterModeSidecar, cfg.MetricsExporterMode)
 	assert.Equal(t, "containersol/locust_exporter:v0.5.0", cfg.MetricsExporterImage)
 	assert.Equal(t, int32(9646), cfg.MetricsExporterPort)
 	assert.Equal(t, "Always", cfg.MetricsExporterPullPolicy)
@@ -96,14 +104,18 @@ func TestLoadConfig_DefaultValues(t *testing.T) {
 	assert.Equal(t, "1024Mi", cfg.MetricsExporterMemLimit)
 	assert.Equal(t, "50M", cfg.MetricsExporterEphemeralStorageLimit)
 
+	// Git clone init container
+	assert.Equal(t, "alpine/git:v2.47.2", cfg.GitCloneImage)
+
 	// Kafka configuration - match Java application.yml defaults
 	assert.Equal(t, "localhost:9092", cfg.KafkaBootstrapServers)
 	assert.False(t, cfg.KafkaSecurityEnabled)
 	assert.Equal(t, "SASL_PLAINTEXT", cfg.KafkaSecurityProtocol)
-	assert.Equal(t, "", cfg.KafkaUsername) // Empty for security
-	assert.Equal(t, "", cfg.KafkaPassword) // Empty for security
 	assert.Equal(t, "SCRAM-SHA-512", cfg.KafkaSaslMechanism)
-	assert.Equal(t, "", cfg.KafkaSaslJaasConfig) // Empty for security
+	assert.Equal(t, "", cfg.KafkaCredentialsSecretName)
+	assert.Equal(t, "username", cfg.KafkaUsernameKey)
+	assert.Equal(t, "password", cfg.KafkaPasswordKey)
+	assert.Equal(t, "", cfg.KafkaSaslJaasConfigKey)
 
 	// Feature flags
 	assert.False(t, cfg.EnableAffinityCRInjection)
@@ -111,6 +123,20 @@ func TestLoadConfig_DefaultValues(t *testing.T) {
 
 	// Scheduling defaults
 	assert.Equal(t, "", cfg.DefaultRuntimeClassName)
+
+	// Live statistics
+	assert.Equal(t, 10*time.Second, cfg.StatsPollInterval)
+
+	// Run duration limits
+	assert.Zero(t, cfg.DefaultMaxDuration)
+	assert.Zero(t, cfg.MaxDurationLimit)
+
+	// Notifications
+	assert.Empty(t, cfg.NotificationWebhookURLs)
+
+	// Grafana annotations
+	assert.Empty(t, cfg.GrafanaURL)
+	assert.Empty(t, cfg.GrafanaAPIToken)
 }
 
 func TestLoadConfig_EnvironmentOverrides(t *testing.T) {
@@ -125,6 +151,7 @@ func TestLoadConfig_EnvironmentOverrides(t *testing.T) {
 	t.Setenv("METRICS_EXPORTER_IMAGE", "custom/exporter:v1.0.0")
 	t.Setenv("METRICS_EXPORTER_PORT", "9000")
 	t.Setenv("METRICS_EXPORTER_IMAGE_PULL_POLICY", "IfNotPresent")
+	t.Setenv("GIT_CLONE_IMAGE", "registry.example.com/git:2")
 	t.Setenv("ENABLE_AFFINITY_CR_INJECTION", "true")
 	t.Setenv("ENABLE_TAINT_TOLERATIONS_CR_INJECTION", "true")
 	t.Setenv("DEFAULT_RUNTIME_CLASS_NAME", "gvisor")
@@ -144,6 +171,7 @@ func TestLoadConfig_EnvironmentOverrides(t *testing.T) {
 	assert.Equal(t, "custom/exporter:v1.0.0", cfg.MetricsExporterImage)
 	assert.Equal(t, int32(9000), cfg.MetricsExporterPort)
 	assert.Equal(t, "IfNotPresent", cfg.MetricsExporterPullPolicy)
+	assert.Equal(t, "registry.example.com/git:2", cfg.GitCloneImage)
 	assert.True(t, cfg.EnableAffinityCRInjection)
 	assert.True(t, cfg.EnableTolerationsCRInjection)
 	assert.Equal(t, "gvisor", cfg.DefaultRuntimeClassName)
@@ -195,10 +223,11 @@ func TestLoadConfig_KafkaConfiguration(t *testing.T) {
 	t.Setenv("KAFKA_BOOTSTRAP_SERVERS", "kafka.example.com:9092")
 	t.Setenv("KAFKA_SECURITY_ENABLED", "true")
 	t.Setenv("KAFKA_SECURITY_PROTOCOL_CONFIG", "SASL_SSL")
-	t.Setenv("KAFKA_USERNAME", "user")
-	t.Setenv("KAFKA_PASSWORD", "secret")
 	t.Setenv("KAFKA_SASL_MECHANISM", "PLAIN")
-	t.Setenv("KAFKA_SASL_JAAS_CONFIG", "org.apache.kafka.common.security.plain.PlainLoginModule required;")
+	t.Setenv("KAFKA_CREDENTIALS_SECRET_NAME", "kafka-credentials")
+	t.Setenv("KAFKA_CREDENTIALS_USERNAME_KEY", "user")
+	t.Setenv("KAFKA_CREDENTIALS_PASSWORD_KEY", "pass")
+	t.Setenv("KAFKA_CREDENTIALS_SASL_JAAS_CONFIG_KEY", "jaas")
 
 	cfg, err := LoadConfig()
 	require.NoError(t, err)
@@ -206,10 +235,33 @@ func TestLoadConfig_KafkaConfiguration(t *testing.T) {
 	assert.Equal(t, "kafka.example.com:9092", cfg.KafkaBootstrapServers)
 	assert.True(t, cfg.KafkaSecurityEnabled)
 	assert.Equal(t, "SASL_SSL", cfg.KafkaSecurityProtocol)
-	assert.Equal(t, "user", cfg.KafkaUsername)
-	assert.Equal(t, "secret", cfg.KafkaPassword)
 	assert.Equal(t, "PLAIN", cfg.KafkaSaslMechanism)
-	assert.Equal(t, "org.apache.kafka.common.security.plain.PlainLoginModule required;", cfg.KafkaSaslJaasConfig)
+	assert.Equal(t, "kafka-credentials", cfg.KafkaCredentialsSecretName)
+	assert.Equal(t, "user", cfg.KafkaUsernameKey)
+	assert.Equal(t, "pass", cfg.KafkaPasswordKey)
+	assert.Equal(t, "jaas", cfg.KafkaSaslJaasConfigKey)
+}
+
+func TestLoadConfig_RejectsLiteralKafkaCredentials(t *testing.T) {
+	for _, key := range []string{"KAFKA_USERNAME", "KAFKA_PASSWORD", "KAFKA_SASL_JAAS_CONFIG"} {
+		t.Run(key, func(t *testing.T) {
+			t.Setenv(key, "secret")
+
+			cfg, err := LoadConfig()
+			require.Error(t, err)
+			assert.Nil(t, cfg)
+			assert.Contains(t, err.Error(), key+" is no longer supported")
+		})
+	}
+}
+
+func TestLoadConfig_InvalidKafkaCredentialsSecretName(t *testing.T) {
+	t.Setenv("KAFKA_CREDENTIALS_SECRET_NAME", "Kafka_Credentials")
+
+	cfg, err := LoadConfig()
+	require.Error(t, err)
+	assert.Nil(t, cfg)
+	assert.Contains(t, err.Error(), `invalid value for KAFKA_CREDENTIALS_SECRET_NAME: "Kafka_Credentials" is not a valid Secret name`)
 }
 
 func TestLoadConfig_MetricsExporterConfiguration(t *testing.T) {
@@ -529,3 +581,122 @@ func TestGetEnvInt32Ptr_WarnsOnInvalidValue(t *testing.T) {
 	assert.Contains(t, output, "TEST_PTR_WARN")
 	assert.Contains(t, output, "invalid")
 }
+
+func TestLoadConfig_StatsPollInterval(t *testing.T) {
+	t.Setenv("STATS_POLL_INTERVAL", "30s")
+
+	cfg, err := LoadConfig()
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Second, cfg.StatsPollInterval)
+}
+
+func TestLoadConfig_StatsPollIntervalTooShort(t *testing.T) {
+	t.Setenv("STATS_POLL_INTERVAL", "500ms")
+
+	cfg, err := LoadConfig()
+	require.Error(t, err)
+	assert.Nil(t, cfg)
+	assert.Contains(t, err.Error(), "STATS_POLL_INTERVAL must be at least 1s")
+}
+
+func TestLoadConfig_MetricsExporterMode(t *testing.T) {
+	t.Setenv("METRICS_EXPORTER_MODE", "Builtin")
+
+	cfg, err := LoadConfig()
+	require.NoError(t, err)
+	assert.Equal(t, MetricsExporterModeBuiltin, cfg.MetricsExporterMode)
+}
+
+func TestLoadConfig_InvalidMetricsExporterMode(t *testing.T) {
+	t.Setenv("METRICS_EXPORTER_MODE", "builtin")
+
+	cfg, err := LoadConfig()
+	require.Error(t, err)
+	assert.Nil(t, cfg)
+	assert.Contains(t, err.Error(), `METRICS_EXPORTER_MODE must be "Sidecar" or "Builtin", got "builtin"`)
+}
+
+func TestLoadConfig_MaxDuration(t *testing.T) {
+	t.Setenv("DEFAULT_MAX_DURATION", "1h")
+	t.Setenv("MAX_DURATION_LIMIT", "4h")
+
+	cfg, err := LoadConfig()
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour, cfg.DefaultMaxDuration)
+	assert.Equal(t, 4*time.Hour, cfg.MaxDurationLimit)
+}
+
+func TestLoadConfig_InvalidMaxDuration(t *testing.T) {
+	tests := []struct {
+		name            string
+		defaultDuration string
+		limit           string
+		errMsg          string
+	}{
+		{name: "NegativeDefault", defaultDuration: "-1h", errMsg: "DEFAULT_MAX_DURATION must not be negative"},
+		{name: "NegativeLimit", limit: "-1h", errMsg: "MAX_DURATION_LIMIT must not be negative"},
+		{name: "DefaultAboveLimit", defaultDuration: "5h", limit: "4h", errMsg: "DEFAULT_MAX_DURATION (5h0m0s) must not exceed MAX_DURATION_LIMIT (4h0m0s)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("DEFAULT_MAX_DURATION", tt.defaultDuration)
+			t.Setenv("MAX_DURATION_LIMIT", tt.limit)
+
+			cfg, err := LoadConfig()
+			require.Error(t, err)
+			assert.Nil(t, cfg)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestLoadConfig_NotificationWebhookURLs(t *testing.T) {
+	t.Setenv("NOTIFICATION_WEBHOOK_URLS", "https://hooks.example.com/a, http://alerts.monitoring:8080/locust,")
+
+	cfg, err := LoadConfig()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"https://hooks.example.com/a", "http://alerts.monitoring:8080/locust"}, cfg.NotificationWebhookURLs)
+}
+
+func TestLoadConfig_InvalidNotificationWebhookURLs(t *testing.T) {
+	for _, value := range []string{"hooks.example.com/a", "ftp://hooks.example.com/a", "https://"} {
+		t.Run(value, func(t *testing.T) {
+			t.Setenv("NOTIFICATION_WEBHOOK_URLS", value)
+
+			cfg, err := LoadConfig()
+			require.Error(t, err)
+			assert.Nil(t, cfg)
+			assert.Contains(t, err.Error(), "NOTIFICATION_WEBHOOK_URLS must contain http or https URLs")
+		})
+	}
+}
+
+func TestLoadConfig_Grafana(t *testing.T) {
+	t.Setenv("GRAFANA_URL", "https://grafana.example.com")
+	t.Setenv("GRAFANA_API_TOKEN", "glsa_token")
+
+	cfg, err := LoadConfig()
+	require.NoError(t, err)
+	assert.Equal(t, "https://grafana.example.com", cfg.GrafanaURL)
+	assert.Equal(t, "glsa_token", cfg.GrafanaAPIToken)
+}
+
+func TestLoadConfig_InvalidGrafanaURL(t *testing.T) {
+	t.Setenv("GRAFANA_URL", "grafana.example.com")
+
+	cfg, err := LoadConfig()
+	require.Error(t, err)
+	assert.Nil(t, cfg)
+	assert.Contains(t, err.Error(), `GRAFANA_URL must be an http or https URL, got "grafana.example.com"`)
+}
+
+func TestGetEnvDuration_WarnsOnInvalidValue(t *testing.T) {
+	t.Setenv("TEST_DURATION_WARN", "10")
+	output := captureLogOutput(t, func() {
+		result := getEnvDuration("TEST_DURATION_WARN", time.Minute)
+		assert.Equal(t, time.Minute, result, "Should return default on invalid value")
+	})
+	assert.Contains(t, output, "WARNING")
+	assert.Contains(t, output, "TEST_DURATION_WARN")
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/resources"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/stats"
+)
+
+const (
+	// defaultAutoscalingCooldown is the cooldown of worker autoscaling without spec.worker.autoscaling.cooldown.
+	defaultAutoscalingCooldown = time.Minute
+	// autoscalingTolerance is how far the average CPU usage may stray from
+	// the target, as a ratio, before the workers are scaled.
+	autoscalingTolerance = 0.1
+	// maxScalingHistory is the number of scaling decisions kept in status.
+	maxScalingHistory = 10
+)
+
+// workerReplicas returns the number of workers of spec.worker the test runs:
+// the autoscaler's last decision, or spec.worker.replicas before it made one.
+func workerReplicas(lt *locustv2.LocustTest) int32 {
+	if lt.Spec.Worker.Autoscaling != nil && lt.Status.WorkerAutoscaling != nil {
+		return lt.Status.WorkerAutoscaling.Replicas
+	}
+	return lt.Spec.Worker.Replicas
+}
+
+// autoscaleWorkers decides on the number of workers of a running test with
+// spec.worker.autoscaling. Like the HorizontalPodAutoscaler, it scales the
+// workers in proportion to their average CPU usage over the target, within
+// the min and max replicas, at most once per cooldown. The decision is
+// recorded in status.workerAutoscaling and as an event; scaleWorkers applies
+// it. The status is changed in memory only.
+func (r *LocustTestReconciler) autoscaleWorkers(lt *locustv2.LocustTest, report *stats.Report, now time.Time) {
+	autoscaling := lt.Spec.Worker.Autoscaling
+	if autoscaling == nil || report == nil || report.State != stats.RunnerStateRunning ||
+		lt.Status.Phase != locustv2.PhaseRunning {
+		return
+	}
+
+	// Wait for the cooldown since the last decision, or since the test started
+	last := lt.Status.StartTime
+	if lt.Status.WorkerAutoscaling != nil && lt.Status.WorkerAutoscaling.LastScaleTime != nil {
+		last = lt.Status.WorkerAutoscaling.LastScaleTime
+	}
+	if last == nil || now.Before(last.Add(autoscalingCooldown(autoscaling))) {
+		return
+	}
+
+	cpu, ok := averageWorkerCPU(lt, report)
+	if !ok {
+		return
+	}
+	target := float64(max(autoscaling.TargetCPUPercent, 1))
+	if math.Abs(cpu/target-1) <= autoscalingTolerance {
+		return
+	}
+
+	current := workerReplicas(lt)
+	desired := int32(math.Ceil(float64(current) * cpu / target))
+	desired = min(max(desired, autoscaling.MinReplicas, 1), autoscaling.MaxReplicas)
+	if desired == current {
+		return
+	}
+
+	status := lt.Status.WorkerAutoscaling
+	if status == nil {
+		status = &locustv2.WorkerAutoscalingStatus{}
+		lt.Status.WorkerAutoscaling = status
+	}
+	decidedAt := metav1.NewTime(now)
+	status.Replicas = desired
+	status.LastScaleTime = &decidedAt
+	status.History = append(status.History, locustv2.WorkerScalingDecision{
+		Time:     decidedAt,
+		From:     current,
+		To:       desired,
+		CPUUsage: formatFloat(math.Round(cpu*10) / 10),
+	})
+	if len(status.History) > maxScalingHistory {
+		status.History = status.History[len(status.History)-maxScalingHistory:]
+	}
+
+	r.Recorder.Event(lt, corev1.EventTypeNormal, "Autoscaled",
+		fmt.Sprintf("Scaling workers from %d to %d: average CPU usage %s%%, target %d%%",
+			current, desired, formatFloat(math.Round(cpu*10)/10), autoscaling.TargetCPUPercent))
+}
+
+// reportAutoscalerOverride sets the WorkerReplicasApplied condition once the
+// autoscaler decided: from then on its decision, not spec.worker.replicas,
+// is the number of workers. A change of spec.worker.replicas it overrides,
+// e.g. through kubectl scale, is reported with a Warning event. The status is
+// changed in memory only.
+func (r *LocustTestReconciler) reportAutoscalerOverride(lt *locustv2.LocustTest) {
+	if lt.Spec.Worker.Autoscaling == nil || lt.Status.WorkerAutoscaling == nil {
+		return
+	}
+
+	replicas, decided := lt.Spec.Worker.Replicas, lt.Status.WorkerAutoscaling.Replicas
+	if replicas == decided {
+		r.setCondition(lt, locustv2.ConditionTypeWorkerReplicasApplied,
+			metav1.ConditionTrue, locustv2.ReasonWorkerReplicasApplied,
+			fmt.Sprintf("worker.replicas %d is the number of workers the autoscaler decided on", replicas))
+		return
+	}
+
+	message := fmt.Sprintf("worker.replicas %d is ignored: the autoscaler decided on %d workers", replicas, decided)
+	cond := meta.FindStatusCondition(lt.Status.Conditions, locustv2.ConditionTypeWorkerReplicasApplied)
+	if cond != nil && cond.ObservedGeneration != lt.Generation {
+		r.Recorder.Event(lt, corev1.EventTypeWarning, locustv2.ReasonOverriddenByAutoscaler, message)
+	}
+	r.setCondition(lt, locustv2.ConditionTypeWorkerReplicasApplied,
+		metav1.ConditionFalse, locustv2.ReasonOverriddenByAutoscaler, message)
+}
+
+// averageWorkerCPU returns the average CPU usage of the connected workers of
+// spec.worker, leaving out worker groups. Returns false without any.
+func averageWorkerCPU(lt *locustv2.LocustTest, report *stats.Report) (float64, bool) {
+	workerJob := resources.NodeName(lt.Name, resources.Worker)
+
+	var total float64
+	var workers int
+	for _, worker := range report.Workers {
+		if worker.State == stats.WorkerStateMissing {
+			continue
+		}
+		if len(lt.Spec.WorkerGroups) > 0 && workerJobName(worker.ID) != workerJob {
+			continue
+		}
+		total += worker.CPUUsage
+		workers++
+	}
+	if workers == 0 {
+		return 0, false
+	}
+	return total / float64(workers), true
+}
+
+// autoscalingCooldown returns the least time between two scaling decisions.
+func autoscalingCooldown(autoscaling *locustv2.WorkerAutoscaling) time.Duration {
+	if autoscaling.Cooldown == nil {
+		return defaultAutoscalingCooldown
+	}
+	return autoscaling.Cooldown.Duration
+}
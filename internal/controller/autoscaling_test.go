@@ -0,0 +1,344 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/stats"
+)
+
+var autoscalingStart = time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+// newAutoscaledLocustTest returns a running test with 3 workers autoscaled
+// between 2 and 10 replicas at a 50% CPU target.
+func newAutoscaledLocustTest() *locustv2.LocustTest {
+	lt := newTestLocustTestCR("autoscale-test", "default")
+	lt.Spec.Worker.Autoscaling = &locustv2.WorkerAutoscaling{
+		MinReplicas:      2,
+		MaxReplicas:      10,
+		TargetCPUPercent: 50,
+	}
+	lt.Status.Phase = locustv2.PhaseRunning
+	lt.Status.StartTime = &metav1.Time{Time: autoscalingStart}
+	return lt
+}
+
+// newCPUReport returns a running report with one worker of spec.worker per
+// CPU usage.
+func newCPUReport(cpuUsages ...float64) *stats.Report {
+	report := newTestReport()
+	for i, cpu := range cpuUsages {
+		report.Workers = append(report.Workers, stats.WorkerReport{
+			ID:       fmt.Sprintf("autoscale-test-worker-%d_0a1b2c", i),
+			State:    stats.RunnerStateRunning,
+			CPUUsage: cpu,
+		})
+	}
+	return report
+}
+
+func TestAutoscaleWorkers(t *testing.T) {
+	afterCooldown := autoscalingStart.Add(2 * time.Minute)
+
+	tests := []struct {
+		name   string
+		mutate func(lt *locustv2.LocustTest, report *stats.Report)
+		report *stats.Report
+		now    time.Time
+		want   int32
+	}{
+		{
+			name:   "ScalesUpOnHighCPU",
+			report: newCPUReport(90, 80, 100),
+			now:    afterCooldown,
+			want:   6,
+		},
+		{
+			name:   "ScalesDownToMinReplicas",
+			report: newCPUReport(5, 5, 5),
+			now:    afterCooldown,
+			want:   2,
+		},
+		{
+			name:   "ScalesUpToMaxReplicas",
+			report: newCPUReport(100, 100, 100),
+			mutate: func(lt *locustv2.LocustTest, _ *stats.Report) {
+				lt.Spec.Worker.Autoscaling.TargetCPUPercent = 10
+			},
+			now:  afterCooldown,
+			want: 10,
+		},
+		{
+			name:   "WithinTolerance",
+			report: newCPUReport(52, 54, 50),
+			now:    afterCooldown,
+			want:   3,
+		},
+		{
+			name:   "DuringCooldown",
+			report: newCPUReport(90, 80, 100),
+			now:    autoscalingStart.Add(30 * time.Second),
+			want:   3,
+		},
+		{
+			name:   "CustomCooldown",
+			report: newCPUReport(90, 80, 100),
+			mutate: func(lt *locustv2.LocustTest, _ *stats.Report) {
+				lt.Spec.Worker.Autoscaling.Cooldown = &metav1.Duration{Duration: 10 * time.Second}
+			},
+			now:  autoscalingStart.Add(30 * time.Second),
+			want: 6,
+		},
+		{
+			name:   "NotRunning",
+			report: newCPUReport(90, 80, 100),
+			mutate: func(_ *locustv2.LocustTest, report *stats.Report) {
+				report.State = "spawning"
+			},
+			now:  afterCooldown,
+			want: 3,
+		},
+		{
+			name:   "MissingWorkersLeftOut",
+			report: newCPUReport(90, 0, 0),
+			mutate: func(_ *locustv2.LocustTest, report *stats.Report) {
+				report.Workers[1].State = stats.WorkerStateMissing
+				report.Workers[2].State = stats.WorkerStateMissing
+			},
+			now:  afterCooldown,
+			want: 6,
+		},
+		{
+			name:   "NoWorkers",
+			report: newCPUReport(),
+			now:    afterCooldown,
+			want:   3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lt := newAutoscaledLocustTest()
+			if tt.mutate != nil {
+				tt.mutate(lt, tt.report)
+			}
+			reconciler, recorder := newTestReconciler()
+
+			reconciler.autoscaleWorkers(lt, tt.report, tt.now)
+
+			assert.Equal(t, tt.want, workerReplicas(lt))
+			if tt.want == 3 {
+				assert.Nil(t, lt.Status.WorkerAutoscaling)
+				assert.Empty(t, recorder.Events)
+				return
+			}
+			require.NotNil(t, lt.Status.WorkerAutoscaling)
+			assert.Equal(t, tt.now, lt.Status.WorkerAutoscaling.LastScaleTime.Time)
+			require.Len(t, lt.Status.WorkerAutoscaling.History, 1)
+			assert.Equal(t, int32(3), lt.Status.WorkerAutoscaling.History[0].From)
+			assert.Equal(t, tt.want, lt.Status.WorkerAutoscaling.History[0].To)
+			assert.Len(t, recorder.Events, 1)
+		})
+	}
+}
+
+func TestAutoscaleWorkers_RecordsDecision(t *testing.T) {
+	lt := newAutoscaledLocustTest()
+	reconciler, recorder := newTestReconciler()
+	now := autoscalingStart.Add(2 * time.Minute)
+
+	reconciler.autoscaleWorkers(lt, newCPUReport(90, 80, 100), now)
+
+	assert.Equal(t, []locustv2.WorkerScalingDecision{{
+		Time:     metav1.NewTime(now),
+		From:     3,
+		To:       6,
+		CPUUsage: "90",
+	}}, lt.Status.WorkerAutoscaling.History)
+	assert.Equal(t, "Normal Autoscaled Scaling workers from 3 to 6: average CPU usage 90%, target 50%", <-recorder.Events)
+
+	// The next decision waits for the cooldown since this one
+	reconciler.autoscaleWorkers(lt, newCPUReport(5, 5, 5, 5, 5, 5), now.Add(30*time.Second))
+	assert.Equal(t, int32(6), workerReplicas(lt))
+
+	reconciler.autoscaleWorkers(lt, newCPUReport(5, 5, 5, 5, 5, 5), now.Add(time.Minute))
+	assert.Equal(t, int32(2), workerReplicas(lt))
+	assert.Len(t, lt.Status.WorkerAutoscaling.History, 2)
+}
+
+func TestAutoscaleWorkers_TrimsHistory(t *testing.T) {
+	lt := newAutoscaledLocustTest()
+	reconciler, recorder := newTestReconciler()
+	now := autoscalingStart
+
+	// Alternate between scaling up and down
+	for i := range maxScalingHistory + 5 {
+		now = now.Add(time.Minute)
+		cpu := 100.0
+		if i%2 == 1 {
+			cpu = 5
+		}
+		report := newCPUReport()
+		for range workerReplicas(lt) {
+			report.Workers = append(report.Workers, stats.WorkerReport{
+				ID: "autoscale-test-worker-x_0a1b2c", State: stats.RunnerStateRunning, CPUUsage: cpu,
+			})
+		}
+		reconciler.autoscaleWorkers(lt, report, now)
+		drainEvents(recorder)
+	}
+
+	history := lt.Status.WorkerAutoscaling.History
+	require.Len(t, history, maxScalingHistory)
+	assert.Equal(t, now, history[len(history)-1].Time.Time, "the latest decisions are kept")
+}
+
+func TestAverageWorkerCPU_LeavesOutWorkerGroups(t *testing.T) {
+	lt := newAutoscaledLocustTest()
+	lt.Spec.WorkerGroups = []locustv2.WorkerGroup{{Name: "heavy", Replicas: 2}}
+	report := newCPUReport(40, 60)
+	report.Workers = append(report.Workers, stats.WorkerReport{
+		ID:       "autoscale-test-worker-heavy-x1y2z_0a1b2c",
+		State:    stats.RunnerStateRunning,
+		CPUUsage: 100,
+	})
+
+	cpu, ok := averageWorkerCPU(lt, report)
+	require.True(t, ok)
+	assert.InDelta(t, 50, cpu, 0.001)
+}
+
+func TestReconcile_AppliesAutoscalingDecision(t *testing.T) {
+	key := types.NamespacedName{Name: "autoscale-test", Namespace: "default"}
+	cr := newTestLocustTestCR(key.Name, key.Namespace)
+	cr.Spec.Worker.Autoscaling = &locustv2.WorkerAutoscaling{MinReplicas: 1, MaxReplicas: 10, TargetCPUPercent: 50}
+	reconciler, recorder := newTestReconciler(cr)
+
+	_, err := reconciler.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+	drainEvents(recorder)
+
+	// The master runs and the autoscaler decided on 5 workers
+	masterJob := &batchv1.Job{}
+	require.NoError(t, reconciler.Get(context.Background(),
+		types.NamespacedName{Name: "autoscale-test-master", Namespace: "default"}, masterJob))
+	masterJob.Status.Active = 1
+	require.NoError(t, reconciler.Status().Update(context.Background(), masterJob))
+
+	lt := &locustv2.LocustTest{}
+	require.NoError(t, reconciler.Get(context.Background(), key, lt))
+	lt.Status.WorkerAutoscaling = &locustv2.WorkerAutoscalingStatus{Replicas: 5}
+	require.NoError(t, reconciler.Status().Update(context.Background(), lt))
+
+	_, err = reconciler.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+
+	workerJob := &batchv1.Job{}
+	require.NoError(t, reconciler.Get(context.Background(),
+		types.NamespacedName{Name: "autoscale-test-worker", Namespace: "default"}, workerJob))
+	assert.Equal(t, int32(5), *workerJob.Spec.Parallelism)
+	require.NoError(t, reconciler.Get(context.Background(), key, lt))
+	assert.Equal(t, int32(5), lt.Status.ExpectedWorkers)
+	assert.Empty(t, recorder.Events, "the autoscaler already recorded its decision")
+}
+
+func TestReconcile_AutoscalerScaleDownKeepsWorkerJob(t *testing.T) {
+	key := types.NamespacedName{Name: "autoscale-test", Namespace: "default"}
+	cr := newTestLocustTestCR(key.Name, key.Namespace)
+	cr.Spec.Worker.Autoscaling = &locustv2.WorkerAutoscaling{MinReplicas: 1, MaxReplicas: 10, TargetCPUPercent: 50}
+	reconciler, _ := newTestReconciler(cr)
+	ctx := context.Background()
+
+	_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+	masterJob := &batchv1.Job{}
+	require.NoError(t, reconciler.Get(ctx, types.NamespacedName{Name: "autoscale-test-master", Namespace: "default"}, masterJob))
+	masterJob.Status.Active = 1
+	require.NoError(t, reconciler.Status().Update(ctx, masterJob))
+
+	// The autoscaler decided on 1 of the 3 workers
+	lt := &locustv2.LocustTest{}
+	require.NoError(t, reconciler.Get(ctx, key, lt))
+	lt.Status.WorkerAutoscaling = &locustv2.WorkerAutoscalingStatus{Replicas: 1}
+	require.NoError(t, reconciler.Status().Update(ctx, lt))
+
+	_, err = reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+
+	workerJob := &batchv1.Job{}
+	require.NoError(t, reconciler.Get(ctx, types.NamespacedName{Name: "autoscale-test-worker", Namespace: "default"}, workerJob))
+	assert.Equal(t, int32(1), *workerJob.Spec.Parallelism)
+	assert.Equal(t, int32(2), *workerJob.Spec.BackoffLimit, "the workers removed by the autoscaler don't fail the Job")
+}
+
+func TestReportAutoscalerOverride(t *testing.T) {
+	t.Run("NotDecidedYet", func(t *testing.T) {
+		lt := newAutoscaledLocustTest()
+		reconciler, _ := newTestReconciler(lt)
+
+		reconciler.reportAutoscalerOverride(lt)
+		assert.Nil(t, findCondition(lt.Status.Conditions, locustv2.ConditionTypeWorkerReplicasApplied))
+	})
+
+	t.Run("Overridden", func(t *testing.T) {
+		lt := newAutoscaledLocustTest()
+		lt.Generation = 1
+		lt.Status.WorkerAutoscaling = &locustv2.WorkerAutoscalingStatus{Replicas: 5}
+		reconciler, recorder := newTestReconciler(lt)
+
+		reconciler.reportAutoscalerOverride(lt)
+		cond := findCondition(lt.Status.Conditions, locustv2.ConditionTypeWorkerReplicasApplied)
+		require.NotNil(t, cond)
+		assert.Equal(t, metav1.ConditionFalse, cond.Status)
+		assert.Equal(t, locustv2.ReasonOverriddenByAutoscaler, cond.Reason)
+		assert.Equal(t, "worker.replicas 3 is ignored: the autoscaler decided on 5 workers", cond.Message)
+		assert.Empty(t, recorder.Events, "the autoscaler's own decision isn't a warning")
+
+		// kubectl scale
+		lt.Spec.Worker.Replicas = 8
+		lt.Generation = 2
+		reconciler.reportAutoscalerOverride(lt)
+		assert.Equal(t, "Warning OverriddenByAutoscaler worker.replicas 8 is ignored: the autoscaler decided on 5 workers",
+			<-recorder.Events)
+
+		reconciler.reportAutoscalerOverride(lt)
+		assert.Empty(t, recorder.Events, "reported once")
+	})
+
+	t.Run("Applied", func(t *testing.T) {
+		lt := newAutoscaledLocustTest()
+		lt.Status.WorkerAutoscaling = &locustv2.WorkerAutoscalingStatus{Replicas: 3}
+		reconciler, _ := newTestReconciler(lt)
+
+		reconciler.reportAutoscalerOverride(lt)
+		cond := findCondition(lt.Status.Conditions, locustv2.ConditionTypeWorkerReplicasApplied)
+		require.NotNil(t, cond)
+		assert.Equal(t, metav1.ConditionTrue, cond.Status)
+	})
+}
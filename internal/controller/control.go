@@ -0,0 +1,112 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/resources"
+)
+
+// stopTest asks the master to stop the users of a test annotated with the
+// Stop action. Locust then quits after its autoquit timeout, the results are
+// collected as usual and the test ends Cancelled. The request is sent once;
+// a failed request is retried on the next reconcile. The status is changed
+// in memory only.
+func (r *LocustTestReconciler) stopTest(ctx context.Context, lt *locustv2.LocustTest) {
+	if lt.ControlAction() != locustv2.ControlStop || lt.Status.Phase != locustv2.PhaseRunning || r.MasterStopper == nil {
+		return
+	}
+	if cond := meta.FindStatusCondition(lt.Status.Conditions, locustv2.ConditionTypeTestCompleted); cond != nil &&
+		cond.Reason == locustv2.ReasonTestStopping {
+		return
+	}
+
+	if err := r.MasterStopper.Stop(ctx, resources.MasterWebUIURL(lt)); err != nil {
+		logf.FromContext(ctx).V(1).Info("Failed to stop the test", "error", err.Error())
+		r.Recorder.Event(lt, corev1.EventTypeWarning, "StopFailed",
+			fmt.Sprintf("Failed to stop the test, will retry: %v", err))
+		return
+	}
+
+	r.setCondition(lt, locustv2.ConditionTypeTestCompleted,
+		metav1.ConditionFalse, locustv2.ReasonTestStopping,
+		"Stop requested: waiting for Locust to quit")
+	r.Recorder.Event(lt, corev1.EventTypeNormal, "StopRequested",
+		"Stopped the users, Locust quits after its autoquit timeout")
+}
+
+// endTest ends a test without running it to completion: an aborted test, a
+// test stopped before it started or while queued, or a test past its
+// maxDuration. The master and worker Jobs are deleted, killing their pods,
+// and the status moves to Aborted, Cancelled or Failed. No results are
+// collected.
+func (r *LocustTestReconciler) endTest(ctx context.Context, lt *locustv2.LocustTest) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	for _, name := range resources.JobNames(lt) {
+		job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: lt.Namespace,
+		}}
+		if err := r.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); client.IgnoreNotFound(err) != nil {
+			log.Error(err, "Failed to delete Job", "name", job.Name)
+			return ctrl.Result{}, fmt.Errorf("failed to delete Job %s: %w", job.Name, err)
+		}
+	}
+
+	// A queued test leaves its queue
+	lt.Status.QueuePosition = 0
+
+	// The pods are going away, their health no longer matters
+	podHealth := PodHealthStatus{
+		Healthy: true,
+		Reason:  locustv2.ReasonPodsHealthy,
+		Message: "Pods deleted by the operator",
+	}
+	if err := r.updateStatusFromJobs(ctx, lt, nil, nil, podHealth, nil); err != nil {
+		log.Error(err, "Failed to update status after ending the test")
+		return ctrl.Result{}, fmt.Errorf("failed to update status from Jobs: %w", err)
+	}
+	return ctrl.Result{}, nil
+}
+
+// controlledPhase applies the control action to the phase derived from the
+// master Job: an aborted test is Aborted, and a stopped test is Cancelled
+// once Locust quit, or right away if it never started running.
+func controlledPhase(lt *locustv2.LocustTest, phase locustv2.Phase) locustv2.Phase {
+	switch lt.ControlAction() {
+	case locustv2.ControlAbort:
+		return locustv2.PhaseAborted
+	case locustv2.ControlStop:
+		if phase.IsTerminal() || lt.Status.Phase == locustv2.PhasePending ||
+			lt.Status.Phase == locustv2.PhaseQueued || lt.Status.Phase.IsWaiting() {
+			return locustv2.PhaseCancelled
+		}
+	}
+	return phase
+}
@@ -0,0 +1,243 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+)
+
+// fakeStopper is a stats.Stopper recording its calls.
+type fakeStopper struct {
+	err     error
+	baseURL string
+	calls   int
+}
+
+func (f *fakeStopper) Stop(_ context.Context, baseURL string) error {
+	f.calls++
+	f.baseURL = baseURL
+	return f.err
+}
+
+var controlTestKey = types.NamespacedName{Name: "control-test", Namespace: "default"}
+
+// startControlTest creates the resources of a test and marks its Jobs active.
+func startControlTest(t *testing.T, reconciler *LocustTestReconciler) {
+	t.Helper()
+	ctx := context.Background()
+
+	_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: controlTestKey})
+	require.NoError(t, err)
+	for _, jobName := range []string{"control-test-master", "control-test-worker"} {
+		job := &batchv1.Job{}
+		require.NoError(t, reconciler.Get(ctx, types.NamespacedName{Name: jobName, Namespace: "default"}, job))
+		job.Status.Active = 1
+		require.NoError(t, reconciler.Status().Update(ctx, job))
+	}
+}
+
+// annotateControl sets the control annotation and reconciles the test.
+func annotateControl(t *testing.T, reconciler *LocustTestReconciler, action locustv2.ControlAction) *locustv2.LocustTest {
+	t.Helper()
+	ctx := context.Background()
+
+	lt := &locustv2.LocustTest{}
+	require.NoError(t, reconciler.Get(ctx, controlTestKey, lt))
+	if lt.Annotations == nil {
+		lt.Annotations = map[string]string{}
+	}
+	lt.Annotations[locustv2.AnnotationControl] = string(action)
+	require.NoError(t, reconciler.Update(ctx, lt))
+
+	return reconcileControlTest(t, reconciler)
+}
+
+func reconcileControlTest(t *testing.T, reconciler *LocustTestReconciler) *locustv2.LocustTest {
+	t.Helper()
+	_, err := reconciler.Reconcile(context.Background(), ctrl.Request{NamespacedName: controlTestKey})
+	require.NoError(t, err)
+
+	lt := &locustv2.LocustTest{}
+	require.NoError(t, reconciler.Get(context.Background(), controlTestKey, lt))
+	return lt
+}
+
+func TestReconcile_StopRunningTest(t *testing.T) {
+	reconciler, recorder := newTestReconciler(newTestLocustTestCR("control-test", "default"))
+	reconciler.StatsFetcher = &fakeStatsFetcher{report: newTestReport()}
+	stopper := &fakeStopper{}
+	reconciler.MasterStopper = stopper
+	startControlTest(t, reconciler)
+	drainEvents(recorder)
+
+	lt := annotateControl(t, reconciler, locustv2.ControlStop)
+	assert.Equal(t, 1, stopper.calls)
+	assert.Equal(t, "http://control-test-master.default.svc:8089", stopper.baseURL)
+	assert.Equal(t, locustv2.PhaseRunning, lt.Status.Phase, "the test runs until Locust quits")
+	cond := findCondition(lt.Status.Conditions, locustv2.ConditionTypeTestCompleted)
+	require.NotNil(t, cond)
+	assert.Equal(t, locustv2.ReasonTestStopping, cond.Reason)
+	assert.Contains(t, <-recorder.Events, "StopRequested")
+
+	// The stop request is sent once
+	reconcileControlTest(t, reconciler)
+	assert.Equal(t, 1, stopper.calls)
+
+	// Locust quits after autoquit and the master Job completes
+	masterJob := &batchv1.Job{}
+	require.NoError(t, reconciler.Get(context.Background(),
+		types.NamespacedName{Name: "control-test-master", Namespace: "default"}, masterJob))
+	masterJob.Status.Active = 0
+	masterJob.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+	require.NoError(t, reconciler.Status().Update(context.Background(), masterJob))
+
+	lt = reconcileControlTest(t, reconciler)
+	assert.Equal(t, locustv2.PhaseCancelled, lt.Status.Phase)
+	assert.NotNil(t, lt.Status.CompletionTime)
+	cond = findCondition(lt.Status.Conditions, locustv2.ConditionTypeTestCompleted)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+	assert.Equal(t, locustv2.ReasonTestCancelled, cond.Reason)
+	assert.Contains(t, <-recorder.Events, "TestCancelled")
+}
+
+func TestReconcile_StopFailureIsRetried(t *testing.T) {
+	reconciler, recorder := newTestReconciler(newTestLocustTestCR("control-test", "default"))
+	reconciler.StatsFetcher = &fakeStatsFetcher{report: newTestReport()}
+	stopper := &fakeStopper{err: errors.New("connection refused")}
+	reconciler.MasterStopper = stopper
+	startControlTest(t, reconciler)
+	drainEvents(recorder)
+
+	lt := annotateControl(t, reconciler, locustv2.ControlStop)
+	assert.Contains(t, <-recorder.Events, "StopFailed")
+	cond := findCondition(lt.Status.Conditions, locustv2.ConditionTypeTestCompleted)
+	require.NotNil(t, cond)
+	assert.NotEqual(t, locustv2.ReasonTestStopping, cond.Reason)
+
+	stopper.err = nil
+	reconcileControlTest(t, reconciler)
+	assert.Equal(t, 2, stopper.calls)
+}
+
+func TestReconcile_AbortRunningTest(t *testing.T) {
+	reconciler, recorder := newTestReconciler(newTestLocustTestCR("control-test", "default"))
+	reconciler.StatsFetcher = &fakeStatsFetcher{report: newTestReport()}
+	stopper := &fakeStopper{}
+	reconciler.MasterStopper = stopper
+	startControlTest(t, reconciler)
+	drainEvents(recorder)
+
+	lt := annotateControl(t, reconciler, locustv2.ControlAbort)
+	assert.Equal(t, locustv2.PhaseAborted, lt.Status.Phase)
+	assert.NotNil(t, lt.Status.CompletionTime)
+	cond := findCondition(lt.Status.Conditions, locustv2.ConditionTypeTestCompleted)
+	require.NotNil(t, cond)
+	assert.Equal(t, locustv2.ReasonTestAborted, cond.Reason)
+	assert.Contains(t, <-recorder.Events, "TestAborted")
+	assert.Zero(t, stopper.calls, "an aborted test isn't stopped through the master")
+
+	for _, jobName := range []string{"control-test-master", "control-test-worker"} {
+		err := reconciler.Get(context.Background(),
+			types.NamespacedName{Name: jobName, Namespace: "default"}, &batchv1.Job{})
+		assert.True(t, apierrors.IsNotFound(err), "Job %s must be deleted", jobName)
+	}
+
+	// The aborted test isn't recreated
+	lt = reconcileControlTest(t, reconciler)
+	assert.Equal(t, locustv2.PhaseAborted, lt.Status.Phase)
+	err := reconciler.Get(context.Background(),
+		types.NamespacedName{Name: "control-test-master", Namespace: "default"}, &batchv1.Job{})
+	assert.True(t, apierrors.IsNotFound(err))
+}
+
+func TestReconcile_ControlBeforeStart(t *testing.T) {
+	tests := []struct {
+		action locustv2.ControlAction
+		phase  locustv2.Phase
+		reason string
+	}{
+		{action: locustv2.ControlStop, phase: locustv2.PhaseCancelled, reason: locustv2.ReasonTestCancelled},
+		{action: locustv2.ControlAbort, phase: locustv2.PhaseAborted, reason: locustv2.ReasonTestAborted},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.action), func(t *testing.T) {
+			lt := newTestLocustTestCR("control-test", "default")
+			lt.Annotations = map[string]string{locustv2.AnnotationControl: string(tt.action)}
+			reconciler, _ := newTestReconciler(lt)
+			stopper := &fakeStopper{}
+			reconciler.MasterStopper = stopper
+
+			updated := reconcileControlTest(t, reconciler)
+			assert.Equal(t, tt.phase, updated.Status.Phase)
+			assert.Nil(t, updated.Status.StartTime)
+			assert.NotNil(t, updated.Status.CompletionTime)
+			cond := findCondition(updated.Status.Conditions, locustv2.ConditionTypeTestCompleted)
+			require.NotNil(t, cond)
+			assert.Equal(t, tt.reason, cond.Reason)
+			assert.Zero(t, stopper.calls)
+
+			err := reconciler.Get(context.Background(),
+				types.NamespacedName{Name: "control-test-master", Namespace: "default"}, &batchv1.Job{})
+			assert.True(t, apierrors.IsNotFound(err), "no Jobs are created")
+		})
+	}
+}
+
+func TestControlledPhase(t *testing.T) {
+	tests := []struct {
+		name    string
+		action  locustv2.ControlAction
+		current locustv2.Phase
+		derived locustv2.Phase
+		want    locustv2.Phase
+	}{
+		{name: "NoAction", current: locustv2.PhaseRunning, derived: locustv2.PhaseSucceeded, want: locustv2.PhaseSucceeded},
+		{name: "StopWhileRunning", action: locustv2.ControlStop, current: locustv2.PhaseRunning, derived: locustv2.PhaseRunning, want: locustv2.PhaseRunning},
+		{name: "StopAfterQuit", action: locustv2.ControlStop, current: locustv2.PhaseRunning, derived: locustv2.PhaseSucceeded, want: locustv2.PhaseCancelled},
+		{name: "StopAfterFailure", action: locustv2.ControlStop, current: locustv2.PhaseRunning, derived: locustv2.PhaseFailed, want: locustv2.PhaseCancelled},
+		{name: "StopBeforeStart", action: locustv2.ControlStop, current: locustv2.PhasePending, derived: locustv2.PhasePending, want: locustv2.PhaseCancelled},
+		{name: "StopWhileQueued", action: locustv2.ControlStop, current: locustv2.PhaseQueued, derived: locustv2.PhasePending, want: locustv2.PhaseCancelled},
+		{name: "Abort", action: locustv2.ControlAbort, current: locustv2.PhaseRunning, derived: locustv2.PhaseRunning, want: locustv2.PhaseAborted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lt := newTestLocustTestCR("control-test", "default")
+			if tt.action != "" {
+				lt.Annotations = map[string]string{locustv2.AnnotationControl: string(tt.action)}
+			}
+			lt.Status.Phase = tt.current
+
+			assert.Equal(t, tt.want, controlledPhase(lt, tt.derived))
+		})
+	}
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/resources"
+)
+
+// recordGitCommit sets status.gitCommit once the master's git clone init
+// container finished; it writes the cloned commit SHA to its termination
+// message. The status is changed in memory only.
+//
+// The commit is first stored in the git commit ConfigMap the workers wait for,
+// so that they clone the same commit even if the branch or tag moved since.
+// Failing to create it leaves the status unset, to retry on the next reconcile.
+func (r *LocustTestReconciler) recordGitCommit(ctx context.Context, lt *locustv2.LocustTest) {
+	if !resources.HasGitSource(lt) || lt.Status.GitCommit != "" {
+		return
+	}
+
+	podList := &corev1.PodList{}
+	if err := r.List(ctx, podList,
+		client.InNamespace(lt.Namespace),
+		client.MatchingLabels{resources.LabelPodName: resources.NodeName(lt.Name, resources.Master)},
+	); err != nil {
+		logf.FromContext(ctx).V(1).Info("Failed to list master pods for the git commit", "error", err.Error())
+		return
+	}
+
+	for _, pod := range podList.Items {
+		for _, cs := range pod.Status.InitContainerStatuses {
+			if cs.Name != resources.GitCloneContainerName || cs.State.Terminated == nil || cs.State.Terminated.ExitCode != 0 {
+				continue
+			}
+			if commit := strings.TrimSpace(cs.State.Terminated.Message); commit != "" {
+				if configMap := resources.BuildGitCommitConfigMap(lt, commit); configMap != nil {
+					if err := r.createResource(ctx, lt, configMap, "ConfigMap"); err != nil {
+						return
+					}
+				}
+				lt.Status.GitCommit = commit
+				r.Recorder.Event(lt, corev1.EventTypeNormal, "GitCloned",
+					fmt.Sprintf("Cloned %s at %s", lt.Spec.TestFiles.Git.URL, commit))
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+)
+
+const testGitCommit = "4b825dc642cb6eb9a060e54bf8d69288fbee4904"
+
+// newTestGitMasterPod returns the master pod of git-test with the given git clone state.
+func newTestGitMasterPod(state corev1.ContainerState) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "git-test-master-abcde",
+			Namespace: "default",
+			Labels: map[string]string{
+				"performance-test-name":     "git-test",
+				"performance-test-pod-name": "git-test-master",
+			},
+		},
+		Status: corev1.PodStatus{
+			InitContainerStatuses: []corev1.ContainerStatus{
+				{Name: "locust-git-clone", State: state},
+			},
+		},
+	}
+}
+
+func newTestGitLocustTest() *locustv2.LocustTest {
+	lt := newTestLocustTestCR("git-test", "default")
+	lt.Spec.TestFiles = &locustv2.TestFilesConfig{
+		Git: &locustv2.GitSource{URL: "https://github.com/example/load-tests.git", Revision: "main"},
+	}
+	return lt
+}
+
+func TestRecordGitCommit(t *testing.T) {
+	lt := newTestGitLocustTest()
+	reconciler, recorder := newTestReconciler(lt, newTestGitMasterPod(corev1.ContainerState{
+		Terminated: &corev1.ContainerStateTerminated{ExitCode: 0, Message: testGitCommit + "\n"},
+	}))
+
+	ctx := context.Background()
+
+	reconciler.recordGitCommit(ctx, lt)
+
+	assert.Equal(t, testGitCommit, lt.Status.GitCommit)
+	assert.Equal(t, "Normal Created Created ConfigMap git-test-git-commit", <-recorder.Events)
+	assert.Equal(t, "Normal GitCloned Cloned https://github.com/example/load-tests.git at "+testGitCommit,
+		<-recorder.Events)
+
+	configMap := &corev1.ConfigMap{}
+	require.NoError(t, reconciler.Get(ctx, types.NamespacedName{Name: "git-test-git-commit", Namespace: "default"}, configMap))
+	assert.Equal(t, testGitCommit, configMap.Data["commit"], "the workers clone the master's commit")
+}
+
+func TestRecordGitCommit_CommitSHARevision(t *testing.T) {
+	lt := newTestGitLocustTest()
+	lt.Spec.TestFiles.Git.Revision = testGitCommit
+	reconciler, recorder := newTestReconciler(lt, newTestGitMasterPod(corev1.ContainerState{
+		Terminated: &corev1.ContainerStateTerminated{ExitCode: 0, Message: testGitCommit},
+	}))
+	ctx := context.Background()
+
+	reconciler.recordGitCommit(ctx, lt)
+
+	assert.Equal(t, testGitCommit, lt.Status.GitCommit)
+	assert.Contains(t, <-recorder.Events, "Normal GitCloned")
+	err := reconciler.Get(ctx, types.NamespacedName{Name: "git-test-git-commit", Namespace: "default"}, &corev1.ConfigMap{})
+	assert.True(t, apierrors.IsNotFound(err), "the workers clone the SHA directly")
+}
+
+func TestCheckPodHealth_WorkersWaitForGitCommit(t *testing.T) {
+	lt := newTestGitLocustTest()
+	worker := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "git-test-worker-abcde",
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-5 * time.Minute)),
+			Labels:            map[string]string{"performance-test-name": "git-test"},
+		},
+		Status: corev1.PodStatus{
+			InitContainerStatuses: []corev1.ContainerStatus{{
+				Name: "locust-git-clone",
+				State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{
+					Reason:  "CreateContainerConfigError",
+					Message: `configmap "git-test-git-commit" not found`,
+				}},
+			}},
+		},
+	}
+	reconciler, _ := newTestReconciler(lt, worker)
+
+	status, _ := reconciler.checkPodHealth(context.Background(), lt)
+	assert.True(t, status.Healthy, "workers wait for the master's clone: %s", status.Message)
+}
+
+func TestRecordGitCommit_CloneNotFinished(t *testing.T) {
+	for name, state := range map[string]corev1.ContainerState{
+		"Running": {Running: &corev1.ContainerStateRunning{}},
+		"Failed":  {Terminated: &corev1.ContainerStateTerminated{ExitCode: 128, Message: "fatal: repository not found"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			lt := newTestGitLocustTest()
+			reconciler, recorder := newTestReconciler(lt, newTestGitMasterPod(state))
+
+			reconciler.recordGitCommit(context.Background(), lt)
+
+			assert.Empty(t, lt.Status.GitCommit)
+			assert.Empty(t, recorder.Events)
+		})
+	}
+}
+
+func TestRecordGitCommit_RecordsOnce(t *testing.T) {
+	lt := newTestGitLocustTest()
+	lt.Status.GitCommit = "0123456789abcdef0123456789abcdef01234567"
+	reconciler, recorder := newTestReconciler(lt, newTestGitMasterPod(corev1.ContainerState{
+		Terminated: &corev1.ContainerStateTerminated{ExitCode: 0, Message: testGitCommit},
+	}))
+
+	reconciler.recordGitCommit(context.Background(), lt)
+
+	assert.Equal(t, "0123456789abcdef0123456789abcdef01234567", lt.Status.GitCommit)
+	assert.Empty(t, recorder.Events)
+}
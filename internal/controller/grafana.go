@@ -0,0 +1,184 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/grafana"
+)
+
+// grafanaTimeout bounds each request to Grafana.
+const grafanaTimeout = 10 * time.Second
+
+// grafanaBackoff retries failed requests to Grafana a few times before giving up.
+var grafanaBackoff = wait.Backoff{Steps: 3, Duration: 500 * time.Millisecond, Factor: 2}
+
+// startGrafanaAnnotation creates the annotation region marking the run of a
+// started test in Grafana, once, and records its ID in status. The status is
+// changed in memory only.
+func (r *LocustTestReconciler) startGrafanaAnnotation(ctx context.Context, lt *locustv2.LocustTest) {
+	if lt.Status.StartTime == nil || lt.Status.GrafanaAnnotationID != 0 ||
+		meta.FindStatusCondition(lt.Status.Conditions, locustv2.ConditionTypeGrafanaAnnotated) != nil {
+		return
+	}
+
+	target, ok, err := r.grafanaTarget(ctx, lt)
+	if !ok {
+		return
+	}
+	if err != nil {
+		r.setGrafanaAnnotationFailed(lt, err.Error())
+		return
+	}
+
+	annotation := grafana.Annotation{
+		Time: lt.Status.StartTime.UnixMilli(),
+		Tags: grafanaTags(lt),
+		Text: grafanaText(lt),
+	}
+	if spec := grafanaIntegration(lt); spec != nil {
+		annotation.DashboardUID = spec.DashboardUID
+	}
+
+	var id int64
+	err = retry.OnError(grafanaBackoff, func(error) bool { return true }, func() error {
+		var err error
+		id, err = r.Annotator.Create(ctx, target, annotation)
+		return err
+	})
+	if err != nil {
+		r.setGrafanaAnnotationFailed(lt, err.Error())
+		return
+	}
+
+	lt.Status.GrafanaAnnotationID = id
+	r.setCondition(lt, locustv2.ConditionTypeGrafanaAnnotated,
+		metav1.ConditionTrue, locustv2.ReasonGrafanaAnnotationStarted,
+		fmt.Sprintf("Annotation %d marks the start of the test", id))
+}
+
+// endGrafanaAnnotation sets the end of the annotation region of a test that
+// just finished. The status is changed in memory only.
+func (r *LocustTestReconciler) endGrafanaAnnotation(ctx context.Context, lt *locustv2.LocustTest) {
+	id := lt.Status.GrafanaAnnotationID
+	if id == 0 || lt.Status.CompletionTime == nil {
+		return
+	}
+
+	target, _, err := r.grafanaTarget(ctx, lt)
+	if err != nil {
+		r.setGrafanaAnnotationFailed(lt, err.Error())
+		return
+	}
+
+	err = retry.OnError(grafanaBackoff, func(error) bool { return true }, func() error {
+		return r.Annotator.End(ctx, target, id, lt.Status.CompletionTime.Time, grafanaText(lt))
+	})
+	if err != nil {
+		r.setGrafanaAnnotationFailed(lt, err.Error())
+		return
+	}
+
+	r.setCondition(lt, locustv2.ConditionTypeGrafanaAnnotated,
+		metav1.ConditionTrue, locustv2.ReasonGrafanaAnnotationEnded,
+		fmt.Sprintf("Annotation %d spans the run of the test", id))
+}
+
+// setGrafanaAnnotationFailed records a failed request to Grafana. The test
+// itself is unaffected.
+func (r *LocustTestReconciler) setGrafanaAnnotationFailed(lt *locustv2.LocustTest, reason string) {
+	msg := "Failed to annotate Grafana: " + reason
+	r.setCondition(lt, locustv2.ConditionTypeGrafanaAnnotated,
+		metav1.ConditionFalse, locustv2.ReasonGrafanaAnnotationFailed, msg)
+	r.Recorder.Event(lt, corev1.EventTypeWarning, locustv2.ReasonGrafanaAnnotationFailed, msg)
+}
+
+// grafanaIntegration returns spec.integrations.grafana, or nil.
+func grafanaIntegration(lt *locustv2.LocustTest) *locustv2.GrafanaIntegration {
+	if lt.Spec.Integrations == nil {
+		return nil
+	}
+	return lt.Spec.Integrations.Grafana
+}
+
+// grafanaTarget returns the Grafana annotated with the run of lt, and false
+// if lt isn't annotated. Tests are annotated when they set
+// spec.integrations.grafana or the operator has a default Grafana. The
+// operator's token is only sent to the operator's Grafana.
+func (r *LocustTestReconciler) grafanaTarget(ctx context.Context, lt *locustv2.LocustTest) (grafana.Target, bool, error) {
+	spec := grafanaIntegration(lt)
+	if spec == nil {
+		if r.Config.GrafanaURL == "" {
+			return grafana.Target{}, false, nil
+		}
+		return grafana.Target{URL: r.Config.GrafanaURL, Token: r.Config.GrafanaAPIToken}, true, nil
+	}
+
+	target := grafana.Target{URL: cmp.Or(spec.URL, r.Config.GrafanaURL)}
+	if target.URL == "" {
+		return target, true, errors.New("no Grafana URL: set spec.integrations.grafana.url or the operator's GRAFANA_URL")
+	}
+
+	switch ref := spec.APITokenSecretRef; {
+	case ref != nil:
+		secret := &corev1.Secret{}
+		if err := r.Get(ctx, client.ObjectKey{Namespace: lt.Namespace, Name: ref.Name}, secret); err != nil {
+			return target, true, fmt.Errorf("failed to read API token Secret %s: %w", ref.Name, err)
+		}
+		token, found := secret.Data[ref.Key]
+		if !found {
+			return target, true, fmt.Errorf("API token Secret %s has no key %s", ref.Name, ref.Key)
+		}
+		target.Token = string(token)
+	case spec.URL == "":
+		target.Token = r.Config.GrafanaAPIToken
+	}
+	return target, true, nil
+}
+
+// grafanaTags tags the annotation of a test with its namespace, name and
+// image, so dashboards can query the annotations of a service's tests.
+func grafanaTags(lt *locustv2.LocustTest) []string {
+	tags := []string{
+		"locust",
+		"namespace:" + lt.Namespace,
+		"test:" + lt.Name,
+		"image:" + lt.Spec.Image,
+	}
+	if spec := grafanaIntegration(lt); spec != nil {
+		tags = append(tags, spec.Tags...)
+	}
+	return tags
+}
+
+// grafanaText describes the test and its phase in the annotation.
+func grafanaText(lt *locustv2.LocustTest) string {
+	return fmt.Sprintf("Load test %s/%s: %s", lt.Namespace, lt.Name, lt.Status.Phase)
+}
@@ -0,0 +1,291 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/grafana"
+)
+
+// grafanaRequest is a request received by fakeGrafana.
+type grafanaRequest struct {
+	method string
+	path   string
+	token  string
+	body   map[string]any
+}
+
+// fakeGrafana is a local stand-in for the annotations API of Grafana.
+type fakeGrafana struct {
+	*httptest.Server
+	mu       sync.Mutex
+	requests []grafanaRequest
+	status   int
+}
+
+func newFakeGrafana(t *testing.T) *fakeGrafana {
+	g := &fakeGrafana{status: http.StatusOK}
+	g.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := grafanaRequest{method: r.Method, path: r.URL.Path, token: r.Header.Get("Authorization")}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req.body))
+
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		g.requests = append(g.requests, req)
+		w.WriteHeader(g.status)
+		if r.Method == http.MethodPost {
+			_, _ = w.Write([]byte(`{"message":"Annotation added","id":7}`))
+		} else {
+			_, _ = w.Write([]byte(`{"message":"Annotation patched"}`))
+		}
+	}))
+	t.Cleanup(g.Close)
+	return g
+}
+
+func (g *fakeGrafana) received() []grafanaRequest {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return append([]grafanaRequest(nil), g.requests...)
+}
+
+// fastGrafanaBackoff makes retries immediate for the duration of the test.
+func fastGrafanaBackoff(t *testing.T) {
+	previous := grafanaBackoff
+	grafanaBackoff = wait.Backoff{Steps: 2, Duration: time.Millisecond}
+	t.Cleanup(func() { grafanaBackoff = previous })
+}
+
+func newTestGrafanaReconciler(g *fakeGrafana, objs ...client.Object) *LocustTestReconciler {
+	reconciler, _ := newTestReconciler(objs...)
+	reconciler.Annotator = grafana.NewHTTPAnnotator(time.Second)
+	reconciler.Config.GrafanaURL = g.URL
+	reconciler.Config.GrafanaAPIToken = "operator-token"
+	return reconciler
+}
+
+func TestReconcile_AnnotatesRunInGrafana(t *testing.T) {
+	g := newFakeGrafana(t)
+	lt := newTestLocustTestCR("annotated-test", "load")
+	reconciler := newTestGrafanaReconciler(g, lt)
+	reconciler.StatsFetcher = &fakeStatsFetcher{report: newTestReport()}
+	ctx := context.Background()
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "annotated-test", Namespace: "load"}}
+
+	// Create resources, then mark the Jobs active so the test runs
+	_, err := reconciler.Reconcile(ctx, req)
+	require.NoError(t, err)
+	for _, jobName := range []string{"annotated-test-master", "annotated-test-worker"} {
+		job := &batchv1.Job{}
+		require.NoError(t, reconciler.Get(ctx, types.NamespacedName{Name: jobName, Namespace: "load"}, job))
+		job.Status.Active = 1
+		require.NoError(t, reconciler.Status().Update(ctx, job))
+	}
+
+	// The running test is annotated once
+	for range 2 {
+		_, err = reconciler.Reconcile(ctx, req)
+		require.NoError(t, err)
+	}
+	require.NoError(t, reconciler.Get(ctx, req.NamespacedName, lt))
+	assert.Equal(t, int64(7), lt.Status.GrafanaAnnotationID)
+	cond := findCondition(lt.Status.Conditions, locustv2.ConditionTypeGrafanaAnnotated)
+	require.NotNil(t, cond)
+	assert.Equal(t, locustv2.ReasonGrafanaAnnotationStarted, cond.Reason)
+
+	requests := g.received()
+	require.Len(t, requests, 1)
+	assert.Equal(t, http.MethodPost, requests[0].method)
+	assert.Equal(t, "/api/annotations", requests[0].path)
+	assert.Equal(t, "Bearer operator-token", requests[0].token)
+	assert.Equal(t, float64(lt.Status.StartTime.UnixMilli()), requests[0].body["time"])
+	assert.Equal(t, []any{"locust", "namespace:load", "test:annotated-test", "image:locustio/locust:latest"}, requests[0].body["tags"])
+	assert.Equal(t, "Load test load/annotated-test: Running", requests[0].body["text"])
+
+	// The region ends when the test finishes
+	master := &batchv1.Job{}
+	require.NoError(t, reconciler.Get(ctx, types.NamespacedName{Name: "annotated-test-master", Namespace: "load"}, master))
+	master.Status = completedJob().Status
+	require.NoError(t, reconciler.Status().Update(ctx, master))
+	_, err = reconciler.Reconcile(ctx, req)
+	require.NoError(t, err)
+
+	require.NoError(t, reconciler.Get(ctx, req.NamespacedName, lt))
+	assert.Equal(t, locustv2.PhaseSucceeded, lt.Status.Phase)
+	cond = findCondition(lt.Status.Conditions, locustv2.ConditionTypeGrafanaAnnotated)
+	require.NotNil(t, cond)
+	assert.Equal(t, locustv2.ReasonGrafanaAnnotationEnded, cond.Reason)
+
+	requests = g.received()
+	require.Len(t, requests, 2)
+	assert.Equal(t, http.MethodPatch, requests[1].method)
+	assert.Equal(t, "/api/annotations/7", requests[1].path)
+	// status.completionTime is persisted with a precision of a second
+	assert.InDelta(t, float64(lt.Status.CompletionTime.UnixMilli()), requests[1].body["timeEnd"], 1000)
+	assert.Equal(t, "Load test load/annotated-test: Succeeded", requests[1].body["text"])
+}
+
+func TestStartGrafanaAnnotation_PerTestConfig(t *testing.T) {
+	g := newFakeGrafana(t)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "grafana-token", Namespace: "default"},
+		Data:       map[string][]byte{"token": []byte("team-token")},
+	}
+	lt := newTestLocustTestCR("annotated-test", "default")
+	lt.Spec.Integrations = &locustv2.IntegrationsConfig{Grafana: &locustv2.GrafanaIntegration{
+		URL: g.URL,
+		APITokenSecretRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "grafana-token"},
+			Key:                  "token",
+		},
+		DashboardUID: "checkout",
+		Tags:         []string{"team:payments"},
+	}}
+	now := metav1.Now()
+	lt.Status.Phase = locustv2.PhaseRunning
+	lt.Status.StartTime = &now
+	reconciler, _ := newTestReconciler(secret)
+	reconciler.Annotator = grafana.NewHTTPAnnotator(time.Second)
+
+	reconciler.startGrafanaAnnotation(context.Background(), lt)
+
+	assert.Equal(t, int64(7), lt.Status.GrafanaAnnotationID)
+	requests := g.received()
+	require.Len(t, requests, 1)
+	assert.Equal(t, "Bearer team-token", requests[0].token)
+	assert.Equal(t, "checkout", requests[0].body["dashboardUID"])
+	assert.Contains(t, requests[0].body["tags"], "team:payments")
+}
+
+func TestGrafanaTarget(t *testing.T) {
+	tests := []struct {
+		name        string
+		integration *locustv2.GrafanaIntegration
+		operatorURL string
+		want        grafana.Target
+		wantOK      bool
+		wantErr     string
+	}{
+		{
+			name: "NotAnnotated",
+		},
+		{
+			name:        "OperatorDefault",
+			operatorURL: "https://grafana.example.com",
+			want:        grafana.Target{URL: "https://grafana.example.com", Token: "operator-token"},
+			wantOK:      true,
+		},
+		{
+			name:        "OperatorGrafanaForTest",
+			integration: &locustv2.GrafanaIntegration{DashboardUID: "checkout"},
+			operatorURL: "https://grafana.example.com",
+			want:        grafana.Target{URL: "https://grafana.example.com", Token: "operator-token"},
+			wantOK:      true,
+		},
+		{
+			name:        "OwnGrafanaDoesNotGetOperatorToken",
+			integration: &locustv2.GrafanaIntegration{URL: "https://team-grafana.example.com"},
+			operatorURL: "https://grafana.example.com",
+			want:        grafana.Target{URL: "https://team-grafana.example.com"},
+			wantOK:      true,
+		},
+		{
+			name:        "NoURL",
+			integration: &locustv2.GrafanaIntegration{},
+			wantOK:      true,
+			wantErr:     "no Grafana URL: set spec.integrations.grafana.url or the operator's GRAFANA_URL",
+		},
+		{
+			name: "MissingSecret",
+			integration: &locustv2.GrafanaIntegration{
+				URL: "https://team-grafana.example.com",
+				APITokenSecretRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "missing"},
+					Key:                  "token",
+				},
+			},
+			wantOK:  true,
+			wantErr: `failed to read API token Secret missing: secrets "missing" not found`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lt := newTestLocustTestCR("annotated-test", "default")
+			if tt.integration != nil {
+				lt.Spec.Integrations = &locustv2.IntegrationsConfig{Grafana: tt.integration}
+			}
+			reconciler, _ := newTestReconciler()
+			reconciler.Config.GrafanaURL = tt.operatorURL
+			reconciler.Config.GrafanaAPIToken = "operator-token"
+
+			target, ok, err := reconciler.grafanaTarget(context.Background(), lt)
+			assert.Equal(t, tt.wantOK, ok)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantErr, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, target)
+		})
+	}
+}
+
+func TestStartGrafanaAnnotation_FailureIsReportedOnce(t *testing.T) {
+	fastGrafanaBackoff(t)
+	g := newFakeGrafana(t)
+	g.status = http.StatusUnauthorized
+	lt := newTestLocustTestCR("annotated-test", "default")
+	now := metav1.Now()
+	lt.Status.Phase = locustv2.PhaseRunning
+	lt.Status.StartTime = &now
+	reconciler := newTestGrafanaReconciler(g)
+	recorder := reconciler.Recorder.(*record.FakeRecorder)
+
+	reconciler.startGrafanaAnnotation(context.Background(), lt)
+	reconciler.startGrafanaAnnotation(context.Background(), lt)
+
+	assert.Zero(t, lt.Status.GrafanaAnnotationID)
+	cond := findCondition(lt.Status.Conditions, locustv2.ConditionTypeGrafanaAnnotated)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, locustv2.ReasonGrafanaAnnotationFailed, cond.Reason)
+	assert.Len(t, g.received(), 2, "the request is retried, then not attempted again")
+	assert.Equal(t, []string{
+		"Warning GrafanaAnnotationFailed Failed to annotate Grafana: failed to create annotation: unexpected status 401 Unauthorized",
+	}, recordedEvents(recorder))
+}
@@ -18,14 +18,21 @@ package controller
 
 import (
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
 )
@@ -102,8 +109,8 @@ var _ = Describe("LocustTest Controller Integration", func() {
 			// Verify Service properties
 			// Service selector uses performance-test-pod-name label
 			Expect(createdSvc.Spec.Selector).To(HaveKeyWithValue("performance-test-pod-name", "create-service-test-master"))
-			// Service has 3 ports: 5557, 5558, metrics (WebUI 8089 is excluded)
-			Expect(createdSvc.Spec.Ports).To(HaveLen(3))
+			// Service has 4 ports: 5557, 5558, 8089 (web UI), metrics
+			Expect(createdSvc.Spec.Ports).To(HaveLen(4))
 		})
 
 		It("should create master Job when LocustTest is created", func() {
@@ -376,7 +383,7 @@ var _ = Describe("LocustTest Controller Integration", func() {
 			Expect(finalJob.ResourceVersion).To(Equal(originalResourceVersion))
 		})
 
-		It("should NOT modify worker Job when workerReplicas is changed", func() {
+		It("should scale the worker Job in place when workerReplicas is changed", func() {
 			lt := createLocustTest("worker-update-noop-test")
 			lt.Spec.Worker.Replicas = 5
 			Expect(k8sClient.Create(ctx, lt)).To(Succeed())
@@ -415,8 +422,8 @@ var _ = Describe("LocustTest Controller Integration", func() {
 				return k8sClient.Update(ctx, updatedLT)
 			}, timeout, interval).Should(Succeed())
 
-			// Worker Job should remain unchanged
-			Consistently(func() int32 {
+			// Worker Job follows the new replica count
+			Eventually(func() int32 {
 				job := &batchv1.Job{}
 				err := k8sClient.Get(ctx, types.NamespacedName{
 					Name: "worker-update-noop-test-worker", Namespace: testNamespace,
@@ -425,7 +432,7 @@ var _ = Describe("LocustTest Controller Integration", func() {
 					return -1
 				}
 				return *job.Spec.Parallelism
-			}, timeout/2, interval).Should(Equal(int32(5))) // Still 5, not 20
+			}, timeout, interval).Should(Equal(int32(20)))
 
 			// UID should be the same (same Job, not recreated)
 			finalJob := &batchv1.Job{}
@@ -434,6 +441,49 @@ var _ = Describe("LocustTest Controller Integration", func() {
 			}, finalJob)).To(Succeed())
 
 			Expect(finalJob.UID).To(Equal(originalUID))
+
+			// The expected workers follow, and scaling isn't reported as drift
+			updatedLT := &locustv2.LocustTest{}
+			Eventually(func() int32 {
+				_ = k8sClient.Get(ctx, types.NamespacedName{
+					Name: "worker-update-noop-test", Namespace: testNamespace,
+				}, updatedLT)
+				return updatedLT.Status.ExpectedWorkers
+			}, timeout, interval).Should(Equal(int32(20)))
+			Expect(meta.FindStatusCondition(updatedLT.Status.Conditions, locustv2.ConditionTypeSpecDrifted)).To(BeNil())
+		})
+
+		It("should scale the worker Job through the scale subresource", func() {
+			lt := createLocustTest("scale-subresource-test")
+			Expect(k8sClient.Create(ctx, lt)).To(Succeed())
+
+			key := types.NamespacedName{Name: "scale-subresource-test", Namespace: testNamespace}
+			Eventually(func() locustv2.Phase {
+				_ = k8sClient.Get(ctx, key, lt)
+				return lt.Status.Phase
+			}, timeout, interval).Should(Equal(locustv2.PhaseRunning))
+
+			scale := &autoscalingv1.Scale{}
+			Expect(k8sClient.SubResource("scale").Get(ctx, lt, scale)).To(Succeed())
+			Expect(scale.Spec.Replicas).To(Equal(lt.Spec.Worker.Replicas))
+			scale.Spec.Replicas = 7
+			Expect(k8sClient.SubResource("scale").Update(ctx, lt, client.WithSubResourceBody(scale))).To(Succeed())
+
+			Eventually(func() int32 {
+				job := &batchv1.Job{}
+				if err := k8sClient.Get(ctx, types.NamespacedName{
+					Name: "scale-subresource-test-worker", Namespace: testNamespace,
+				}, job); err != nil {
+					return -1
+				}
+				return *job.Spec.Parallelism
+			}, timeout, interval).Should(Equal(int32(7)))
+
+			Eventually(func() string {
+				_ = k8sClient.Get(ctx, key, lt)
+				return lt.Status.WorkerSelector
+			}, timeout, interval).Should(Equal(
+				"performance-test-pod-name=scale-subresource-test-worker,!performance-test-worker-group"))
 		})
 
 		It("should NOT modify master Job when masterCommandSeed is changed", func() {
@@ -761,4 +811,327 @@ var _ = Describe("LocustTest Controller Integration", func() {
 			}, timeout, interval).Should(Succeed())
 		})
 	})
+
+	// ==================== LIVE STATISTICS TESTS ====================
+	Describe("Live Statistics", func() {
+		It("should mirror the master's statistics into status while Running", func() {
+			lt := createLocustTest("live-stats-test")
+			Expect(k8sClient.Create(ctx, lt)).To(Succeed())
+
+			// envtest runs no Job controller: mark the master Job active
+			masterJob := &batchv1.Job{}
+			Eventually(func() error {
+				return k8sClient.Get(ctx, types.NamespacedName{
+					Name: "live-stats-test-master", Namespace: testNamespace,
+				}, masterJob)
+			}, timeout, interval).Should(Succeed())
+			Eventually(func() error {
+				if err := k8sClient.Get(ctx, types.NamespacedName{
+					Name: "live-stats-test-master", Namespace: testNamespace,
+				}, masterJob); err != nil {
+					return err
+				}
+				now := metav1.Now()
+				masterJob.Status.StartTime = &now
+				masterJob.Status.Active = 1
+				return k8sClient.Status().Update(ctx, masterJob)
+			}, timeout, interval).Should(Succeed())
+
+			// The fake master serves the statistics
+			updatedLT := &locustv2.LocustTest{}
+			Eventually(func() string {
+				if err := k8sClient.Get(ctx, types.NamespacedName{
+					Name: "live-stats-test", Namespace: testNamespace,
+				}, updatedLT); err != nil || updatedLT.Status.Stats == nil {
+					return ""
+				}
+				return updatedLT.Status.Stats.RPS
+			}, timeout, interval).Should(Equal("42.5"))
+
+			Expect(updatedLT.Status.Phase).To(Equal(locustv2.PhaseRunning))
+			Expect(updatedLT.Status.Stats.State).To(Equal("running"))
+			Expect(updatedLT.Status.Stats.Users).To(Equal(int32(30)))
+			Expect(updatedLT.Status.Stats.FailureRatio).To(Equal("0.01"))
+			Expect(updatedLT.Status.Stats.P95ResponseTime.Duration).To(Equal(80 * time.Millisecond))
+		})
+
+		It("should count workers registered with the master as connected", func() {
+			lt := createLocustTest("live-workers-test")
+			Expect(k8sClient.Create(ctx, lt)).To(Succeed())
+
+			// Mark both Jobs active: all 3 worker pods run, but the fake
+			// master reports one of the workers as missing
+			for _, jobName := range []string{"live-workers-test-master", "live-workers-test-worker"} {
+				job := &batchv1.Job{}
+				Eventually(func() error {
+					if err := k8sClient.Get(ctx, types.NamespacedName{
+						Name: jobName, Namespace: testNamespace,
+					}, job); err != nil {
+						return err
+					}
+					now := metav1.Now()
+					job.Status.StartTime = &now
+					job.Status.Active = 3
+					if jobName == "live-workers-test-master" {
+						job.Status.Active = 1
+					}
+					return k8sClient.Status().Update(ctx, job)
+				}, timeout, interval).Should(Succeed())
+			}
+
+			updatedLT := &locustv2.LocustTest{}
+			Eventually(func() int {
+				if err := k8sClient.Get(ctx, types.NamespacedName{
+					Name: "live-workers-test", Namespace: testNamespace,
+				}, updatedLT); err != nil {
+					return 0
+				}
+				return len(updatedLT.Status.Workers)
+			}, timeout, interval).Should(Equal(3))
+
+			Expect(updatedLT.Status.ConnectedWorkers).To(Equal(int32(2)))
+			workersCond := findCondition(updatedLT.Status.Conditions, locustv2.ConditionTypeWorkersConnected)
+			Expect(workersCond).NotTo(BeNil())
+			Expect(workersCond.Status).To(Equal(metav1.ConditionFalse))
+			Expect(workersCond.Message).To(Equal("2/3 workers registered with the master"))
+		})
+	})
+
+	Describe("Results", func() {
+		It("should create the results claim and mount it in the master Job", func() {
+			lt := createLocustTest("results-claim-test")
+			lt.Spec.Results = &locustv2.ResultsConfig{Storage: locustv2.ResultsStoragePersistentVolumeClaim}
+			Expect(k8sClient.Create(ctx, lt)).To(Succeed())
+
+			claim := &corev1.PersistentVolumeClaim{}
+			Eventually(func() error {
+				return k8sClient.Get(ctx, types.NamespacedName{
+					Name: "results-claim-test-results", Namespace: testNamespace,
+				}, claim)
+			}, timeout, interval).Should(Succeed())
+			Expect(claim.OwnerReferences).To(HaveLen(1))
+			Expect(claim.OwnerReferences[0].Name).To(Equal("results-claim-test"))
+
+			masterJob := &batchv1.Job{}
+			Eventually(func() error {
+				return k8sClient.Get(ctx, types.NamespacedName{
+					Name: "results-claim-test-master", Namespace: testNamespace,
+				}, masterJob)
+			}, timeout, interval).Should(Succeed())
+			podSpec := masterJob.Spec.Template.Spec
+			Expect(podSpec.Containers[0].Args).To(ContainElement("--only-summary"))
+			Expect(podSpec.Volumes).To(ContainElement(HaveField("Name", "locust-results")))
+		})
+	})
+
+	Describe("Git test files", func() {
+		It("should clone the repository in an init container of both Jobs", func() {
+			lt := createLocustTest("git-test")
+			lt.Spec.TestFiles = &locustv2.TestFilesConfig{
+				Git: &locustv2.GitSource{URL: "https://github.com/example/load-tests.git", Revision: "v1.2.0"},
+			}
+			Expect(k8sClient.Create(ctx, lt)).To(Succeed())
+
+			for _, name := range []string{"git-test-master", "git-test-worker"} {
+				job := &batchv1.Job{}
+				Eventually(func() error {
+					return k8sClient.Get(ctx, types.NamespacedName{Name: name, Namespace: testNamespace}, job)
+				}, timeout, interval).Should(Succeed())
+				podSpec := job.Spec.Template.Spec
+				Expect(podSpec.InitContainers[0].Name).To(Equal("locust-git-clone"))
+				Expect(podSpec.Volumes).To(ContainElement(HaveField("Name", "locust-git")))
+			}
+		})
+	})
+
+	Describe("Web UI", func() {
+		It("should create an owned Service and Ingress and publish the URL", func() {
+			lt := createLocustTest("webui-test")
+			lt.Spec.WebUI = &locustv2.WebUIConfig{
+				Enabled: true,
+				Ingress: &locustv2.WebUIIngressConfig{Host: "load.example.com"},
+			}
+			Expect(k8sClient.Create(ctx, lt)).To(Succeed())
+
+			key := types.NamespacedName{Name: "webui-test-webui", Namespace: testNamespace}
+			service := &corev1.Service{}
+			Eventually(func() error {
+				return k8sClient.Get(ctx, key, service)
+			}, timeout, interval).Should(Succeed())
+			Expect(service.Spec.Ports).To(HaveLen(1))
+			Expect(service.OwnerReferences).To(ContainElement(HaveField("Name", "webui-test")))
+
+			ingress := &networkingv1.Ingress{}
+			Eventually(func() error {
+				return k8sClient.Get(ctx, key, ingress)
+			}, timeout, interval).Should(Succeed())
+			Expect(ingress.OwnerReferences).To(ContainElement(HaveField("Name", "webui-test")))
+
+			Eventually(func() string {
+				updated := &locustv2.LocustTest{}
+				_ = k8sClient.Get(ctx, types.NamespacedName{Name: "webui-test", Namespace: testNamespace}, updated)
+				return updated.Status.WebUIURL
+			}, timeout, interval).Should(Equal("http://load.example.com/"))
+		})
+	})
+
+	Describe("Inline test files", func() {
+		It("should create an immutable ConfigMap mounted by both Jobs", func() {
+			lt := createLocustTest("inline-test")
+			lt.Spec.TestFiles = &locustv2.TestFilesConfig{
+				Inline: map[string]string{"locustfile.py": "from locust import HttpUser\n"},
+			}
+			Expect(k8sClient.Create(ctx, lt)).To(Succeed())
+
+			cm := &corev1.ConfigMap{}
+			Eventually(func() error {
+				return k8sClient.Get(ctx, types.NamespacedName{Name: "inline-test-test-files", Namespace: testNamespace}, cm)
+			}, timeout, interval).Should(Succeed())
+			Expect(cm.Data).To(Equal(lt.Spec.TestFiles.Inline))
+			Expect(cm.Immutable).To(HaveValue(BeTrue()))
+
+			for _, name := range []string{"inline-test-master", "inline-test-worker"} {
+				job := &batchv1.Job{}
+				Eventually(func() error {
+					return k8sClient.Get(ctx, types.NamespacedName{Name: name, Namespace: testNamespace}, job)
+				}, timeout, interval).Should(Succeed())
+				var configMaps []string
+				for _, v := range job.Spec.Template.Spec.Volumes {
+					if v.ConfigMap != nil {
+						configMaps = append(configMaps, v.ConfigMap.Name)
+					}
+				}
+				Expect(configMaps).To(ContainElement("inline-test-test-files"))
+			}
+		})
+	})
+
+	Describe("Control actions", func() {
+		It("should delete the Jobs and end Aborted on Abort", func() {
+			lt := createLocustTest("abort-test")
+			Expect(k8sClient.Create(ctx, lt)).To(Succeed())
+
+			key := types.NamespacedName{Name: "abort-test", Namespace: testNamespace}
+			Eventually(func() locustv2.Phase {
+				_ = k8sClient.Get(ctx, key, lt)
+				return lt.Status.Phase
+			}, timeout, interval).Should(Equal(locustv2.PhaseRunning))
+
+			Eventually(func() error {
+				if err := k8sClient.Get(ctx, key, lt); err != nil {
+					return err
+				}
+				lt.Annotations = map[string]string{locustv2.AnnotationControl: string(locustv2.ControlAbort)}
+				return k8sClient.Update(ctx, lt)
+			}, timeout, interval).Should(Succeed())
+
+			Eventually(func() locustv2.Phase {
+				_ = k8sClient.Get(ctx, key, lt)
+				return lt.Status.Phase
+			}, timeout, interval).Should(Equal(locustv2.PhaseAborted))
+			Expect(lt.Status.CompletionTime).NotTo(BeNil())
+
+			for _, name := range []string{"abort-test-master", "abort-test-worker"} {
+				Eventually(func() bool {
+					err := k8sClient.Get(ctx, types.NamespacedName{Name: name, Namespace: testNamespace}, &batchv1.Job{})
+					return apierrors.IsNotFound(err)
+				}, timeout, interval).Should(BeTrue())
+			}
+		})
+	})
+
+	Describe("Suspend", func() {
+		It("should create suspended Jobs and start them when spec.suspend is cleared", func() {
+			lt := createLocustTest("suspend-test")
+			lt.Spec.Suspend = true
+			Expect(k8sClient.Create(ctx, lt)).To(Succeed())
+
+			key := types.NamespacedName{Name: "suspend-test", Namespace: testNamespace}
+			Eventually(func() locustv2.Phase {
+				_ = k8sClient.Get(ctx, key, lt)
+				return lt.Status.Phase
+			}, timeout, interval).Should(Equal(locustv2.PhaseSuspended))
+
+			masterKey := types.NamespacedName{Name: "suspend-test-master", Namespace: testNamespace}
+			job := &batchv1.Job{}
+			Expect(k8sClient.Get(ctx, masterKey, job)).To(Succeed())
+			Expect(job.Spec.Suspend).To(HaveValue(BeTrue()))
+
+			Eventually(func() error {
+				if err := k8sClient.Get(ctx, key, lt); err != nil {
+					return err
+				}
+				lt.Spec.Suspend = false
+				return k8sClient.Update(ctx, lt)
+			}, timeout, interval).Should(Succeed())
+
+			Eventually(func() locustv2.Phase {
+				_ = k8sClient.Get(ctx, key, lt)
+				return lt.Status.Phase
+			}, timeout, interval).Should(Equal(locustv2.PhaseRunning))
+			Expect(lt.Status.StartTime).NotTo(BeNil())
+			Expect(k8sClient.Get(ctx, masterKey, job)).To(Succeed())
+			Expect(job.Spec.Suspend).To(HaveValue(BeFalse()))
+		})
+	})
+
+	Describe("Queue", func() {
+		It("should hold a test in the Queued phase until the queue has capacity", func() {
+			queue := &locustv2.LocustTestQueue{
+				ObjectMeta: metav1.ObjectMeta{Name: "one-at-a-time", Namespace: testNamespace},
+				Spec:       locustv2.LocustTestQueueSpec{MaxConcurrentTests: ptr.To[int32](1)},
+			}
+			Expect(k8sClient.Create(ctx, queue)).To(Succeed())
+
+			first := createLocustTest("queue-first")
+			first.Spec.Queue = &locustv2.QueueConfig{Name: "one-at-a-time"}
+			Expect(k8sClient.Create(ctx, first)).To(Succeed())
+			firstKey := types.NamespacedName{Name: "queue-first", Namespace: testNamespace}
+			Eventually(func() locustv2.Phase {
+				_ = k8sClient.Get(ctx, firstKey, first)
+				return first.Status.Phase
+			}, timeout, interval).Should(Equal(locustv2.PhaseRunning))
+
+			second := createLocustTest("queue-second")
+			second.Spec.Queue = &locustv2.QueueConfig{Name: "one-at-a-time"}
+			Expect(k8sClient.Create(ctx, second)).To(Succeed())
+			secondKey := types.NamespacedName{Name: "queue-second", Namespace: testNamespace}
+			Eventually(func() int32 {
+				_ = k8sClient.Get(ctx, secondKey, second)
+				return second.Status.QueuePosition
+			}, timeout, interval).Should(Equal(int32(1)))
+			Expect(second.Status.Phase).To(Equal(locustv2.PhaseQueued))
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "queue-second-master", Namespace: testNamespace},
+				&batchv1.Job{})).NotTo(Succeed())
+
+			// Deleting the first test frees the queue
+			Expect(k8sClient.Delete(ctx, first)).To(Succeed())
+			Eventually(func() locustv2.Phase {
+				_ = k8sClient.Get(ctx, secondKey, second)
+				return second.Status.Phase
+			}, timeout, interval).Should(Equal(locustv2.PhaseRunning))
+		})
+	})
+
+	Describe("Worker groups", func() {
+		It("should create a worker Job per group and expect all their workers", func() {
+			lt := createLocustTest("groups-test")
+			lt.Spec.WorkerGroups = []locustv2.WorkerGroup{
+				{Name: "checkout", Replicas: 2, ExtraArgs: []string{"CheckoutUser"}},
+			}
+			Expect(k8sClient.Create(ctx, lt)).To(Succeed())
+
+			groupKey := types.NamespacedName{Name: "groups-test-worker-checkout", Namespace: testNamespace}
+			job := &batchv1.Job{}
+			Eventually(func() error {
+				return k8sClient.Get(ctx, groupKey, job)
+			}, timeout, interval).Should(Succeed())
+			Expect(job.Spec.Parallelism).To(HaveValue(Equal(int32(2))))
+			Expect(job.Spec.Template.Spec.Containers[0].Args).To(ContainElement("CheckoutUser"))
+
+			key := types.NamespacedName{Name: "groups-test", Namespace: testNamespace}
+			Expect(k8sClient.Get(ctx, key, lt)).To(Succeed())
+			Expect(lt.Status.ExpectedWorkers).To(Equal(lt.Spec.Worker.Replicas + 2))
+		})
+	})
 })
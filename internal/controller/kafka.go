@@ -0,0 +1,87 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/resources"
+)
+
+// checkKafkaCredentials checks the Secrets the Kafka env vars of the test
+// reference, and returns the KafkaCredentialsAvailable condition to set, or
+// nil if the test has no Kafka credentials.
+//
+// The pods of a test missing one don't start until it is created; the
+// condition and a Warning event say which Secret to create, rather than
+// leaving the pods to explain it.
+func (r *LocustTestReconciler) checkKafkaCredentials(ctx context.Context, lt *locustv2.LocustTest) (*metav1.Condition, error) {
+	var refs, missing []string
+	for _, env := range resources.BuildKafkaEnvVars(lt, r.Config) {
+		if env.ValueFrom == nil || env.ValueFrom.SecretKeyRef == nil {
+			continue
+		}
+		ref := env.ValueFrom.SecretKeyRef
+		if ref.Optional != nil && *ref.Optional {
+			continue
+		}
+		refs = append(refs, "Secret "+ref.Name)
+
+		secret := &corev1.Secret{}
+		err := r.Get(ctx, client.ObjectKey{Namespace: lt.Namespace, Name: ref.Name}, secret)
+		switch {
+		case apierrors.IsNotFound(err):
+			missing = append(missing, "Secret "+ref.Name)
+		case err != nil:
+			return nil, fmt.Errorf("failed to read Kafka credentials Secret %s: %w", ref.Name, err)
+		default:
+			if _, found := secret.Data[ref.Key]; !found {
+				missing = append(missing, fmt.Sprintf("key %s of Secret %s", ref.Key, ref.Name))
+			}
+		}
+	}
+	if len(refs) == 0 {
+		return nil, nil
+	}
+
+	if len(missing) > 0 {
+		message := fmt.Sprintf("Kafka credentials not found in namespace %s: %s; the pods start once they are created",
+			lt.Namespace, strings.Join(slices.Compact(slices.Sorted(slices.Values(missing))), ", "))
+		r.Recorder.Event(lt, corev1.EventTypeWarning, locustv2.ReasonKafkaCredentialsMissing, message)
+		return &metav1.Condition{
+			Status:  metav1.ConditionFalse,
+			Reason:  locustv2.ReasonKafkaCredentialsMissing,
+			Message: message,
+		}, nil
+	}
+
+	slices.Sort(refs)
+	return &metav1.Condition{
+		Status:  metav1.ConditionTrue,
+		Reason:  locustv2.ReasonKafkaCredentialsFound,
+		Message: "Kafka credentials found in " + strings.Join(slices.Compact(refs), ", "),
+	}, nil
+}
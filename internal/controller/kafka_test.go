@@ -0,0 +1,131 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+)
+
+func newTestKafkaLocustTestCR() *locustv2.LocustTest {
+	lt := newTestLocustTestCR("kafka-test", "load")
+	lt.Spec.Integrations = &locustv2.IntegrationsConfig{Kafka: &locustv2.KafkaIntegration{
+		SecurityEnabled: ptr.To(true),
+	}}
+	return lt
+}
+
+func TestReconcile_ReportsMissingKafkaCredentials(t *testing.T) {
+	reconciler, recorder := newTestReconciler(newTestKafkaLocustTestCR())
+	reconciler.Config.KafkaCredentialsSecretName = "kafka-credentials"
+	reconciler.Config.KafkaUsernameKey = "username"
+	reconciler.Config.KafkaPasswordKey = "password"
+	key := types.NamespacedName{Name: "kafka-test", Namespace: "load"}
+
+	_, err := reconciler.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+
+	// The Jobs are still created, their pods wait for the Secret
+	updated := &locustv2.LocustTest{}
+	require.NoError(t, reconciler.Get(context.Background(), key, updated))
+	assert.Equal(t, locustv2.PhaseRunning, updated.Status.Phase)
+	cond := findCondition(updated.Status.Conditions, locustv2.ConditionTypeKafkaCredentialsAvailable)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, locustv2.ReasonKafkaCredentialsMissing, cond.Reason)
+	assert.Contains(t, recordedEvents(recorder),
+		"Warning KafkaCredentialsMissing Kafka credentials not found in namespace load: Secret kafka-credentials; the pods start once they are created")
+}
+
+func TestCheckKafkaCredentials(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "kafka-credentials", Namespace: "load"},
+		Data:       map[string][]byte{"username": []byte("user"), "password": []byte("secret")},
+	}
+
+	tests := []struct {
+		name        string
+		kafka       *locustv2.KafkaIntegration
+		secretName  string
+		wantReason  string
+		wantMessage string
+	}{
+		{
+			name:  "NoCredentials",
+			kafka: &locustv2.KafkaIntegration{SecurityEnabled: ptr.To(true)},
+		},
+		{
+			name:        "OperatorSecretFound",
+			kafka:       &locustv2.KafkaIntegration{SecurityEnabled: ptr.To(true)},
+			secretName:  "kafka-credentials",
+			wantReason:  locustv2.ReasonKafkaCredentialsFound,
+			wantMessage: "Kafka credentials found in Secret kafka-credentials",
+		},
+		{
+			name: "MissingKey",
+			kafka: &locustv2.KafkaIntegration{
+				SaslJaasConfigSecretRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "kafka-credentials"},
+					Key:                  "jaas",
+				},
+			},
+			wantReason:  locustv2.ReasonKafkaCredentialsMissing,
+			wantMessage: "Kafka credentials not found in namespace load: key jaas of Secret kafka-credentials; the pods start once they are created",
+		},
+		{
+			name: "OptionalRefNotChecked",
+			kafka: &locustv2.KafkaIntegration{
+				PasswordSecretRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "missing"},
+					Key:                  "password",
+					Optional:             ptr.To(true),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lt := newTestKafkaLocustTestCR()
+			lt.Spec.Integrations.Kafka = tt.kafka
+			reconciler, _ := newTestReconciler(secret)
+			reconciler.Config.KafkaCredentialsSecretName = tt.secretName
+			reconciler.Config.KafkaUsernameKey = "username"
+			reconciler.Config.KafkaPasswordKey = "password"
+
+			cond, err := reconciler.checkKafkaCredentials(context.Background(), lt)
+			require.NoError(t, err)
+			if tt.wantReason == "" {
+				assert.Nil(t, cond)
+				return
+			}
+			require.NotNil(t, cond)
+			assert.Equal(t, tt.wantReason, cond.Reason)
+			assert.Equal(t, tt.wantMessage, cond.Message)
+		})
+	}
+}
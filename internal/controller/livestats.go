@@ -0,0 +1,128 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/resources"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/stats"
+)
+
+// statsFetchTimeout bounds a single request to the master's web API.
+const statsFetchTimeout = 5 * time.Second
+
+// shouldPollMaster reports whether the master of the test is polled for statistics.
+func (r *LocustTestReconciler) shouldPollMaster(lt *locustv2.LocustTest) bool {
+	return r.StatsFetcher != nil && lt.Status.Phase == locustv2.PhaseRunning
+}
+
+// pollMaster reads the live statistics of a running test from its master and
+// evaluates its thresholds. The status is only updated in memory;
+// updateStatusFromJobs persists it. Returns the master's report, or nil if
+// the master wasn't polled or couldn't be reached.
+func (r *LocustTestReconciler) pollMaster(ctx context.Context, lt *locustv2.LocustTest) *stats.Report {
+	if !r.shouldPollMaster(lt) {
+		return nil
+	}
+
+	report := r.updateLiveStats(ctx, lt)
+	r.checkThresholds(ctx, lt)
+
+	return report
+}
+
+// updateLiveStats copies the master's live statistics into status.stats.
+// If the master can't be reached, the previous values are kept and nil is returned.
+func (r *LocustTestReconciler) updateLiveStats(ctx context.Context, lt *locustv2.LocustTest) *stats.Report {
+	log := logf.FromContext(ctx)
+
+	report, err := r.StatsFetcher.Report(ctx, resources.MasterWebUIURL(lt))
+	if err != nil {
+		log.V(1).Info("Master statistics unavailable, keeping previous live statistics", "error", err.Error())
+		return nil
+	}
+
+	lt.Status.Stats = buildLiveStats(report, metav1.Now())
+	return report
+}
+
+// ignoreLiveStatsUpdates filters out the LocustTest updates that only change
+// the live statistics, which every poll of the master writes. Reconciling on
+// them would poll the master again right away instead of after
+// StatsPollInterval.
+var ignoreLiveStatsUpdates = predicate.Funcs{
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldLt, okOld := e.ObjectOld.(*locustv2.LocustTest)
+		newLt, okNew := e.ObjectNew.(*locustv2.LocustTest)
+		if !okOld || !okNew {
+			return true
+		}
+		return !equality.Semantic.DeepEqual(withoutLiveStats(oldLt), withoutLiveStats(newLt))
+	},
+}
+
+// withoutLiveStats returns a copy of the test without the live statistics
+// and the metadata every write changes.
+func withoutLiveStats(lt *locustv2.LocustTest) *locustv2.LocustTest {
+	lt = lt.DeepCopy()
+	lt.ResourceVersion = ""
+	lt.ManagedFields = nil
+	lt.Status.Stats = nil
+	lt.Status.Workers = nil
+	lt.Status.WorkerGroups = nil
+	return lt
+}
+
+// buildLiveStats converts a master report into the status summary.
+func buildLiveStats(report *stats.Report, now metav1.Time) *locustv2.LiveStats {
+	return &locustv2.LiveStats{
+		State:              report.State,
+		Users:              report.Users,
+		RPS:                formatFloat(report.RPS),
+		FailureRatio:       formatFloat(report.FailureRatio),
+		MedianResponseTime: &metav1.Duration{Duration: report.MedianResponseTime.Round(time.Millisecond)},
+		P95ResponseTime:    &metav1.Duration{Duration: report.P95ResponseTime.Round(time.Millisecond)},
+		LastUpdateTime:     &now,
+	}
+}
+
+// buildWorkerStatuses converts the master's worker list into the status breakdown.
+func buildWorkerStatuses(workers []stats.WorkerReport) []locustv2.WorkerStatus {
+	result := make([]locustv2.WorkerStatus, 0, len(workers))
+	for _, w := range workers {
+		result = append(result, locustv2.WorkerStatus{
+			ID:       w.ID,
+			State:    w.State,
+			Users:    w.Users,
+			CPUUsage: formatFloat(math.Round(w.CPUUsage*10) / 10),
+		})
+	}
+	slices.SortFunc(result, func(a, b locustv2.WorkerStatus) int { return strings.Compare(a.ID, b.ID) })
+	return result
+}
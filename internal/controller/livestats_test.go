@@ -0,0 +1,321 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/config"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/metrics"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/stats"
+)
+
+func newTestReport() *stats.Report {
+	return &stats.Report{
+		State:              "running",
+		Users:              150,
+		RPS:                152.33333,
+		FailureRatio:       0.0125,
+		MedianResponseTime: 42 * time.Millisecond,
+		P95ResponseTime:    310*time.Millisecond + 400*time.Microsecond,
+	}
+}
+
+func TestBuildLiveStats(t *testing.T) {
+	now := metav1.NewTime(time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC))
+
+	got := buildLiveStats(newTestReport(), now)
+
+	assert.Equal(t, &locustv2.LiveStats{
+		State:              "running",
+		Users:              150,
+		RPS:                "152.3333",
+		FailureRatio:       "0.0125",
+		MedianResponseTime: &metav1.Duration{Duration: 42 * time.Millisecond},
+		P95ResponseTime:    &metav1.Duration{Duration: 310 * time.Millisecond},
+		LastUpdateTime:     &now,
+	}, got)
+}
+
+func TestPollMaster_Running(t *testing.T) {
+	lt := newTestLocustTestCR("live-test", "default")
+	lt.Status.Phase = locustv2.PhaseRunning
+	reconciler, _ := newTestReconciler(lt)
+	fetcher := &fakeStatsFetcher{report: newTestReport()}
+	reconciler.StatsFetcher = fetcher
+
+	reconciler.pollMaster(context.Background(), lt)
+	assert.Equal(t, "http://live-test-master.default.svc:8089", fetcher.baseURL)
+	require.NotNil(t, lt.Status.Stats)
+	assert.Equal(t, "running", lt.Status.Stats.State)
+	assert.Equal(t, int32(150), lt.Status.Stats.Users)
+}
+
+func TestPollMaster_NotRunning(t *testing.T) {
+	for _, phase := range []locustv2.Phase{locustv2.PhasePending, locustv2.PhaseSucceeded, locustv2.PhaseFailed} {
+		t.Run(string(phase), func(t *testing.T) {
+			lt := newTestLocustTestCR("live-test", "default")
+			lt.Status.Phase = phase
+			reconciler, _ := newTestReconciler(lt)
+			fetcher := &fakeStatsFetcher{report: newTestReport()}
+			reconciler.StatsFetcher = fetcher
+
+			reconciler.pollMaster(context.Background(), lt)
+			assert.Zero(t, fetcher.calls)
+			assert.Nil(t, lt.Status.Stats)
+		})
+	}
+}
+
+func TestPollMaster_MasterUnreachableKeepsStats(t *testing.T) {
+	lt := newTestLocustTestCR("live-test", "default")
+	lt.Status.Phase = locustv2.PhaseRunning
+	previous := buildLiveStats(newTestReport(), metav1.Now())
+	lt.Status.Stats = previous
+	reconciler, _ := newTestReconciler(lt)
+	reconciler.StatsFetcher = &fakeStatsFetcher{err: errors.New("connection refused")}
+
+	reconciler.pollMaster(context.Background(), lt)
+	assert.Same(t, previous, lt.Status.Stats)
+}
+
+func TestReconcile_RunningTestPollsLiveStats(t *testing.T) {
+	lt := newTestLocustTestCR("live-test", "default")
+	reconciler, _ := newTestReconciler(lt)
+	reconciler.StatsFetcher = &fakeStatsFetcher{report: newTestReport()}
+	ctx := context.Background()
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "live-test", Namespace: "default"}}
+
+	// Create resources, then mark the Jobs active so the test runs
+	_, err := reconciler.Reconcile(ctx, req)
+	require.NoError(t, err)
+	for _, jobName := range []string{"live-test-master", "live-test-worker"} {
+		job := &batchv1.Job{}
+		require.NoError(t, reconciler.Get(ctx, types.NamespacedName{Name: jobName, Namespace: "default"}, job))
+		job.Status.Active = 1
+		require.NoError(t, reconciler.Status().Update(ctx, job))
+	}
+
+	// The test starts running; the master is polled on the next reconcile
+	result, err := reconciler.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, 10*time.Second, result.RequeueAfter, "running tests are polled at the configured interval")
+
+	_, err = reconciler.Reconcile(ctx, req)
+	require.NoError(t, err)
+
+	require.NoError(t, reconciler.Get(ctx, req.NamespacedName, lt))
+	assert.Equal(t, locustv2.PhaseRunning, lt.Status.Phase)
+	require.NotNil(t, lt.Status.Stats, "statistics must be persisted")
+	assert.Equal(t, "152.3333", lt.Status.Stats.RPS)
+	assert.Equal(t, "0.0125", lt.Status.Stats.FailureRatio)
+}
+
+func TestReconcile_LiveStatsDoNotRetrigger(t *testing.T) {
+	lt := newTestLocustTestCR("live-test", "default")
+	reconciler, _ := newTestReconciler(lt)
+	report := newTestReport()
+	report.Workers = newTestWorkerReports()
+	fetcher := &fakeStatsFetcher{report: report}
+	reconciler.StatsFetcher = fetcher
+	ctx := context.Background()
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "live-test", Namespace: "default"}}
+
+	_, err := reconciler.Reconcile(ctx, req)
+	require.NoError(t, err)
+	for _, jobName := range []string{"live-test-master", "live-test-worker"} {
+		job := &batchv1.Job{}
+		require.NoError(t, reconciler.Get(ctx, types.NamespacedName{Name: jobName, Namespace: "default"}, job))
+		job.Status.Active = 1
+		require.NoError(t, reconciler.Status().Update(ctx, job))
+	}
+	_, err = reconciler.Reconcile(ctx, req)
+	require.NoError(t, err)
+	before := &locustv2.LocustTest{}
+	require.NoError(t, reconciler.Get(ctx, req.NamespacedName, before))
+
+	// The next poll reads new statistics
+	report.RPS = 160
+	report.Workers[0].CPUUsage = 55
+	result, err := reconciler.Reconcile(ctx, req)
+	require.NoError(t, err)
+	after := &locustv2.LocustTest{}
+	require.NoError(t, reconciler.Get(ctx, req.NamespacedName, after))
+
+	require.NotEqual(t, before.ResourceVersion, after.ResourceVersion, "the statistics are written")
+	assert.NotEqual(t, before.Status.Stats, after.Status.Stats)
+	assert.False(t, ignoreLiveStatsUpdates.Update(event.UpdateEvent{ObjectOld: before, ObjectNew: after}),
+		"writing the statistics doesn't trigger another reconcile")
+	assert.Equal(t, 10*time.Second, result.RequeueAfter, "the master is polled again after StatsPollInterval")
+}
+
+func TestIgnoreLiveStatsUpdates(t *testing.T) {
+	old := newTestLocustTestCR("live-test", "default")
+	old.Status.Phase = locustv2.PhaseRunning
+	old.Status.Stats = buildLiveStats(newTestReport(), metav1.Now())
+
+	tests := []struct {
+		name   string
+		mutate func(lt *locustv2.LocustTest)
+		want   bool
+	}{
+		{
+			name: "LiveStats",
+			mutate: func(lt *locustv2.LocustTest) {
+				lt.ResourceVersion = "2"
+				lt.Status.Stats.RPS = "160"
+				lt.Status.Workers = buildWorkerStatuses(newTestWorkerReports())
+			},
+		},
+		{name: "Phase", mutate: func(lt *locustv2.LocustTest) { lt.Status.Phase = locustv2.PhaseSucceeded }, want: true},
+		{name: "Spec", mutate: func(lt *locustv2.LocustTest) { lt.Spec.Worker.Replicas = 6 }, want: true},
+		{
+			name:   "Annotation",
+			mutate: func(lt *locustv2.LocustTest) { lt.Annotations = map[string]string{"locust.io/control": "Stop"} },
+			want:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated := old.DeepCopy()
+			tt.mutate(updated)
+			assert.Equal(t, tt.want, ignoreLiveStatsUpdates.Update(event.UpdateEvent{ObjectOld: old, ObjectNew: updated}))
+		})
+	}
+}
+
+func newTestWorkerReports() []stats.WorkerReport {
+	return []stats.WorkerReport{
+		{ID: "live-test-worker-c_3f2a", State: "running", Users: 50, CPUUsage: 41.26},
+		{ID: "live-test-worker-a_9b1c", State: "running", Users: 50, CPUUsage: 37.5},
+		{ID: "live-test-worker-b_77d0", State: stats.WorkerStateMissing, Users: 50},
+	}
+}
+
+func TestUpdateStatusFromJobs_WorkersFromMaster(t *testing.T) {
+	lt := newTestLocustTestCR("live-test", "default")
+	lt.Status.Phase = locustv2.PhaseRunning
+	lt.Status.ExpectedWorkers = 3
+	reconciler, _ := newTestReconciler(lt)
+
+	// All worker pods are active, but one worker stopped sending heartbeats
+	workerJob := &batchv1.Job{Status: batchv1.JobStatus{Active: 3}}
+	report := &stats.Report{Workers: newTestWorkerReports()}
+
+	err := reconciler.updateStatusFromJobs(context.Background(), lt, runningJob(), workerJob, healthyPodStatus(), report)
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(2), lt.Status.ConnectedWorkers)
+	cond := findCondition(lt.Status.Conditions, locustv2.ConditionTypeWorkersConnected)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, locustv2.ReasonWorkersMissing, cond.Reason)
+	assert.Equal(t, "2/3 workers registered with the master", cond.Message)
+
+	assert.Equal(t, []locustv2.WorkerStatus{
+		{ID: "live-test-worker-a_9b1c", State: "running", Users: 50, CPUUsage: "37.5"},
+		{ID: "live-test-worker-b_77d0", State: "missing", Users: 50, CPUUsage: "0"},
+		{ID: "live-test-worker-c_3f2a", State: "running", Users: 50, CPUUsage: "41.3"},
+	}, lt.Status.Workers, "workers are sorted by ID")
+}
+
+func TestUpdateStatusFromJobs_WorkersFallBackToJob(t *testing.T) {
+	tests := []struct {
+		name   string
+		report *stats.Report
+	}{
+		{name: "MasterUnreachable", report: nil},
+		{name: "NoWorkerList", report: &stats.Report{State: "running"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lt := newTestLocustTestCR("live-test", "default")
+			lt.Status.Phase = locustv2.PhaseRunning
+			lt.Status.ExpectedWorkers = 3
+			previous := []locustv2.WorkerStatus{{ID: "live-test-worker-a_9b1c", State: "running"}}
+			lt.Status.Workers = previous
+			reconciler, _ := newTestReconciler(lt)
+			workerJob := &batchv1.Job{Status: batchv1.JobStatus{Active: 3}}
+
+			err := reconciler.updateStatusFromJobs(context.Background(), lt, runningJob(), workerJob, healthyPodStatus(), tt.report)
+			require.NoError(t, err)
+
+			assert.Equal(t, int32(3), lt.Status.ConnectedWorkers)
+			cond := findCondition(lt.Status.Conditions, locustv2.ConditionTypeWorkersConnected)
+			require.NotNil(t, cond)
+			assert.Equal(t, metav1.ConditionTrue, cond.Status)
+			assert.Equal(t, "3/3 workers connected", cond.Message)
+			assert.Equal(t, previous, lt.Status.Workers, "the last worker list is kept")
+		})
+	}
+}
+
+func TestUpdateStatusFromJobs_ExportsBuiltinMetrics(t *testing.T) {
+	tests := []struct {
+		name       string
+		mode       string
+		masterJob  *batchv1.Job
+		report     *stats.Report
+		wantSeries bool
+	}{
+		{name: "Running", mode: config.MetricsExporterModeBuiltin, masterJob: runningJob(), report: newTestReport(), wantSeries: true},
+		{name: "MasterUnreachableKeepsSeries", mode: config.MetricsExporterModeBuiltin, masterJob: runningJob(), wantSeries: true},
+		{name: "SidecarMode", mode: config.MetricsExporterModeSidecar, masterJob: runningJob(), report: newTestReport()},
+		{name: "Finished", mode: config.MetricsExporterModeBuiltin, masterJob: completedJob()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lt := newTestLocustTestCR("metrics-test", "default")
+			lt.Status.Phase = locustv2.PhaseRunning
+			reconciler, _ := newTestReconciler(lt)
+			reconciler.Config.MetricsExporterMode = tt.mode
+			reconciler.LocustMetrics = metrics.NewLocustCollector()
+			reconciler.LocustMetrics.Set(types.NamespacedName{Namespace: "default", Name: "metrics-test"}, newTestReport())
+			workerJob := &batchv1.Job{Status: batchv1.JobStatus{Active: 3}}
+
+			err := reconciler.updateStatusFromJobs(context.Background(), lt, tt.masterJob, workerJob, healthyPodStatus(), tt.report)
+			require.NoError(t, err)
+
+			series := testutil.CollectAndCount(reconciler.LocustMetrics, "locust_users")
+			if tt.wantSeries {
+				assert.Equal(t, 1, series)
+			} else {
+				assert.Zero(t, series)
+			}
+		})
+	}
+}
+
+func runningJob() *batchv1.Job {
+	return &batchv1.Job{Status: batchv1.JobStatus{Active: 1}}
+}
@@ -27,18 +27,26 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
 	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/config"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/grafana"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/metrics"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/notify"
 	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/resources"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/results"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/stats"
 )
 
 const (
@@ -54,14 +62,51 @@ type LocustTestReconciler struct {
 	Scheme   *runtime.Scheme
 	Config   *config.OperatorConfig
 	Recorder record.EventRecorder
+	// StatsFetcher reads statistics from the Locust master.
+	// Defaults to an HTTP fetcher in SetupWithManager.
+	StatsFetcher stats.Fetcher
+	// ResultsFetcher reads result files from the master's results collector.
+	// Defaults to an HTTP fetcher in SetupWithManager.
+	ResultsFetcher results.Fetcher
+	// LogReader reads the master's summary from its logs.
+	// Defaults to the pods/log API in SetupWithManager.
+	LogReader results.LogReader
+	// ResultsUploader uploads results to S3-compatible storage.
+	// Defaults to an HTTP uploader in SetupWithManager.
+	ResultsUploader results.Uploader
+	// MasterStopper stops the users of a test given the Stop control action.
+	// Defaults to an HTTP fetcher in SetupWithManager.
+	MasterStopper stats.Stopper
+	// APIReader reads the tests of a queue without the cache when admitting
+	// a queued test. Defaults to the client.
+	APIReader client.Reader
+	// LocustMetrics exports the statistics of running tests in the Builtin
+	// metrics exporter mode. Defaults to a collector registered on the
+	// operator's metrics endpoint in SetupWithManager.
+	LocustMetrics *metrics.LocustCollector
+	// Notifier sends phase transitions to the webhooks of spec.notifications.
+	// Defaults to an HTTP sender in SetupWithManager.
+	Notifier notify.Sender
+	// Annotator marks the run of tests in Grafana.
+	// Defaults to an HTTP annotator in SetupWithManager.
+	Annotator grafana.Annotator
 }
 
 // +kubebuilder:rbac:groups=locust.io,resources=locusttests,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups=locust.io,resources=locusttests/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=locust.io,resources=locusttests/finalizers,verbs=update
-// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=locust.io,resources=locusttestqueues,verbs=get;list;watch
+// +kubebuilder:rbac:groups=locust.io,resources=clusterlocusttestqueues,verbs=get;list;watch
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;patch;delete
 // +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=servicemonitors;podmonitors,verbs=get;list;watch;create;delete
 // +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=pods/log,verbs=get
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 // Reconcile handles LocustTest CR events.
@@ -77,6 +122,8 @@ func (r *LocustTestReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		if apierrors.IsNotFound(err) {
 			// CR deleted - nothing to do (cleanup via owner references)
 			log.V(1).Info("LocustTest not found, likely deleted")
+			r.LocustMetrics.Delete(req.NamespacedName)
+			testPhases.forget(req.NamespacedName)
 			return ctrl.Result{}, nil
 		}
 		log.Error(err, "Failed to fetch LocustTest")
@@ -85,6 +132,8 @@ func (r *LocustTestReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 
 	// Handle deletion: finalizer ensures visible logs and events
 	if !locustTest.DeletionTimestamp.IsZero() {
+		r.LocustMetrics.Delete(req.NamespacedName)
+		testPhases.forget(req.NamespacedName)
 		if controllerutil.ContainsFinalizer(locustTest, finalizerName) {
 			log.Info("LocustTest deleted, cleaning up resources via owner references",
 				"name", locustTest.Name,
@@ -99,6 +148,7 @@ func (r *LocustTestReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		}
 		return ctrl.Result{}, nil
 	}
+	testPhases.observe(locustTest)
 
 	// Add finalizer on first reconcile if not present
 	if !controllerutil.ContainsFinalizer(locustTest, finalizerName) {
@@ -130,14 +180,27 @@ func (r *LocustTestReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		}
 	}
 
+	// Queued tests wait for their queue to admit them before anything is created
+	if locustTest.Status.Phase == locustv2.PhaseQueued {
+		return r.reconcileQueued(ctx, locustTest)
+	}
+
+	// Suspended or scheduled tests wait for spec.suspend or spec.startAt to start
+	if locustTest.Status.Phase.IsWaiting() {
+		return r.reconcileWaiting(ctx, locustTest)
+	}
+
 	// If resources already exist (Phase is Running or terminal), check Job status
 	// This handles reconciles triggered by Job status changes
-	if locustTest.Status.Phase == locustv2.PhaseRunning ||
-		locustTest.Status.Phase == locustv2.PhaseSucceeded ||
-		locustTest.Status.Phase == locustv2.PhaseFailed {
+	if locustTest.Status.Phase == locustv2.PhaseRunning || locustTest.Status.Phase.IsTerminal() {
 		return r.reconcileStatus(ctx, locustTest)
 	}
 
+	// A test stopped or aborted before it started is never created
+	if locustTest.ControlAction() != "" {
+		return r.endTest(ctx, locustTest)
+	}
+
 	// Phase == Pending: create resources
 	// Log informational message if this is a spec update (generation > 1)
 	// The phase-based state machine handles this correctly — Pending always creates
@@ -153,22 +216,25 @@ func (r *LocustTestReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		"namespace", locustTest.Namespace)
 
 	// Log detailed CR information (debug level)
-	var configMapRef string
-	if locustTest.Spec.TestFiles != nil {
-		configMapRef = locustTest.Spec.TestFiles.ConfigMapRef
-	}
+	configMapRef := resources.TestFilesConfigMapName(locustTest)
 	log.V(1).Info("Custom resource information",
 		"image", locustTest.Spec.Image,
 		"masterCommand", locustTest.Spec.Master.Command,
 		"workerCommand", locustTest.Spec.Worker.Command,
 		"workerReplicas", locustTest.Spec.Worker.Replicas,
+		"workerGroups", len(locustTest.Spec.WorkerGroups),
 		"configMap", configMapRef)
 
 	// Create resources
 	return r.createResources(ctx, locustTest)
 }
 
-// createResources creates the master Service, master Job, and worker Job.
+// createResources creates the master Service, the inline test files ConfigMap
+// (when testFiles.inline is set), the load shape ConfigMap (when stages are
+// configured), the results PersistentVolumeClaim (when results are
+// kept on a claim), master Job, worker Job, a Job per worker group, the
+// web UI Service, Ingress or HTTPRoute (when webUI is enabled), and the
+// ServiceMonitor and PodMonitor (when observability.prometheus is configured).
 // Resources are created with owner references for automatic garbage collection.
 func (r *LocustTestReconciler) createResources(ctx context.Context, lt *locustv2.LocustTest) (ctrl.Result, error) {
 	log := logf.FromContext(ctx)
@@ -184,6 +250,30 @@ func (r *LocustTestReconciler) createResources(ctx context.Context, lt *locustv2
 	}
 	log.V(1).Info("Master Service reconciled", "name", masterService.Name)
 
+	// Create the inline test files before the Jobs that mount them
+	if testFiles := resources.BuildInlineTestFilesConfigMap(lt); testFiles != nil {
+		if err := r.createResource(ctx, lt, testFiles, "ConfigMap"); err != nil {
+			return ctrl.Result{}, err
+		}
+		log.V(1).Info("Inline test files ConfigMap reconciled", "name", testFiles.Name)
+	}
+
+	// Create the generated LoadTestShape before the master Job that mounts it
+	if loadShape := resources.BuildLoadShapeConfigMap(lt); loadShape != nil {
+		if err := r.createResource(ctx, lt, loadShape, "ConfigMap"); err != nil {
+			return ctrl.Result{}, err
+		}
+		log.V(1).Info("Load shape ConfigMap reconciled", "name", loadShape.Name)
+	}
+
+	// Create the results claim before the master Job that writes to it
+	if claim := resources.BuildResultsVolumeClaim(lt); claim != nil {
+		if err := r.createResource(ctx, lt, claim, "PersistentVolumeClaim"); err != nil {
+			return ctrl.Result{}, err
+		}
+		log.V(1).Info("Results PersistentVolumeClaim reconciled", "name", claim.Name)
+	}
+
 	// Create master Job
 	if err := r.createResource(ctx, lt, masterJob, kindJob); err != nil {
 		return ctrl.Result{}, err
@@ -196,6 +286,41 @@ func (r *LocustTestReconciler) createResources(ctx context.Context, lt *locustv2
 	}
 	log.V(1).Info("Worker Job reconciled", "name", workerJob.Name)
 
+	// Create a worker Job per worker group
+	for _, groupJob := range resources.BuildWorkerGroupJobs(lt, r.Config, log) {
+		if err := r.createResource(ctx, lt, groupJob, kindJob); err != nil {
+			return ctrl.Result{}, err
+		}
+		log.V(1).Info("Worker group Job reconciled", "name", groupJob.Name)
+	}
+
+	// Expose the web UI once the master exists
+	webUIURL, err := r.createWebUI(ctx, lt)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Let the Prometheus Operator scrape the metrics exporter
+	monitors, err := r.createMonitors(ctx, lt)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Explain pods waiting for Kafka credentials
+	kafkaCredentials, err := r.checkKafkaCredentials(ctx, lt)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Jobs of a test with a deferred start are created suspended: keep them
+	// suspended while it waits, or start them if spec.startAt already passed
+	waiting := waitingPhase(lt, time.Now())
+	if waiting == "" && resources.HasDeferredStart(lt) {
+		if err := r.startJobs(ctx, lt); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
 	log.Info("All resources created successfully",
 		"locustTest", lt.Name,
 		"masterService", masterService.Name,
@@ -203,23 +328,42 @@ func (r *LocustTestReconciler) createResources(ctx context.Context, lt *locustv2
 		"workerJob", workerJob.Name)
 
 	// Update status after successful resource creation (with conflict retry)
+	var previous locustv2.Phase
 	if err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
 		if err := r.Get(ctx, client.ObjectKeyFromObject(lt), lt); err != nil {
 			return err
 		}
-		lt.Status.Phase = locustv2.PhaseRunning
-		lt.Status.ObservedGeneration = lt.Generation
-		if lt.Status.StartTime == nil {
-			now := metav1.Now()
-			lt.Status.StartTime = &now
+		previous = lt.Status.Phase
+		if waiting != "" {
+			r.setWaiting(lt, waiting)
+		} else {
+			lt.Status.Phase = locustv2.PhaseRunning
+			if lt.Status.StartTime == nil {
+				now := metav1.Now()
+				lt.Status.StartTime = &now
+			}
 		}
+		lt.Status.ObservedGeneration = lt.Generation
+		lt.Status.WebUIURL = webUIURL
 		r.setReady(lt, true, locustv2.ReasonResourcesCreated, "All resources created")
+		if monitors != nil {
+			r.setCondition(lt, locustv2.ConditionTypeMonitorsCreated, monitors.Status, monitors.Reason, monitors.Message)
+		}
+		if kafkaCredentials != nil {
+			r.setCondition(lt, locustv2.ConditionTypeKafkaCredentialsAvailable,
+				kafkaCredentials.Status, kafkaCredentials.Reason, kafkaCredentials.Message)
+		}
 		return r.Status().Update(ctx, lt)
 	}); err != nil {
 		log.Error(err, "Failed to update status after resource creation")
 		return ctrl.Result{}, fmt.Errorf("failed to update status after resource creation: %w", err)
 	}
+	r.notifyPhase(ctx, lt, previous)
 
+	// Wake up at spec.startAt
+	if waiting == locustv2.PhaseScheduled {
+		return ctrl.Result{RequeueAfter: time.Until(lt.Spec.StartAt.Time)}, nil
+	}
 	return ctrl.Result{}, nil
 }
 
@@ -375,14 +519,26 @@ func (r *LocustTestReconciler) checkResourcesExist(
 		return nil, nil, true, requeueAfter, nil
 	}
 
+	// Check for externally deleted worker group Jobs
+	for _, group := range lt.Spec.WorkerGroups {
+		groupJobName := resources.WorkerGroupNodeName(lt.Name, group.Name)
+		if shouldRequeue, requeueAfter, err := r.handleExternalResourceDeletion(
+			ctx, lt, groupJobName, "Worker group Job", &batchv1.Job{},
+		); err != nil {
+			return nil, nil, false, 0, err
+		} else if shouldRequeue {
+			return nil, nil, true, requeueAfter, nil
+		}
+	}
+
 	// All resources exist
 	return masterJob, workerJob, false, 0, nil
 }
 
 // shouldSkipStatusUpdate checks if the LocustTest is in a terminal state where status updates should be skipped.
-// Returns true if Phase is Succeeded or Failed (terminal states).
+// Returns true if Phase is Succeeded, Failed, Cancelled or Aborted (terminal states).
 func shouldSkipStatusUpdate(lt *locustv2.LocustTest) bool {
-	return lt.Status.Phase == locustv2.PhaseSucceeded || lt.Status.Phase == locustv2.PhaseFailed
+	return lt.Status.Phase.IsTerminal()
 }
 
 // reconcileStatus updates the LocustTest status based on owned Job states.
@@ -404,16 +560,67 @@ func (r *LocustTestReconciler) reconcileStatus(ctx context.Context, lt *locustv2
 		return ctrl.Result{}, nil
 	}
 
+	// Mark the start of the run in Grafana
+	r.startGrafanaAnnotation(ctx, lt)
+
+	// Abort: kill the pods without waiting for Locust or collecting results
+	if lt.ControlAction() == locustv2.ControlAbort {
+		return r.endTest(ctx, lt)
+	}
+
+	// Enforce maxDuration when the Jobs' active deadline didn't end the test
+	if r.timedOut(lt, nil) && !derivePhaseFromJob(masterJob).IsTerminal() {
+		return r.endTest(ctx, lt)
+	}
+
+	// Stop: ask the master to stop the users, Locust quits on its own
+	r.stopTest(ctx, lt)
+
 	// Check pod health before updating status from Jobs
 	podHealthStatus, requeueAfter := r.checkPodHealth(ctx, lt)
 
-	// Update status from Jobs (pass pod health to update logic)
-	if err := r.updateStatusFromJobs(ctx, lt, masterJob, workerJob, podHealthStatus); err != nil {
+	// Record the commit the test files were cloned at
+	r.recordGitCommit(ctx, lt)
+
+	// Publish the LoadBalancer address of the web UI once assigned
+	r.recordWebUIURL(ctx, lt)
+
+	// Read live statistics and evaluate thresholds while the test runs
+	report := r.pollMaster(ctx, lt)
+
+	// Follow spec.worker.replicas, changed directly or through the scale
+	// subresource, or the autoscaler's decision
+	r.autoscaleWorkers(lt, report, time.Now())
+	r.reportAutoscalerOverride(lt)
+	if err := r.scaleWorkers(ctx, lt, workerJob); err != nil {
+		log.Error(err, "Failed to scale workers")
+		return ctrl.Result{}, err
+	}
+
+	// Collect the results once Locust exited, before its pod goes away
+	if retryAfter := r.collectResults(ctx, lt); retryAfter > 0 && (requeueAfter == 0 || retryAfter < requeueAfter) {
+		requeueAfter = retryAfter
+	}
+
+	// Update status from Jobs (pass pod health and the master's report to update logic)
+	if err := r.updateStatusFromJobs(ctx, lt, masterJob, workerJob, podHealthStatus, report); err != nil {
 		log.Error(err, "Failed to update status from Jobs")
 		return ctrl.Result{}, fmt.Errorf("failed to update status from Jobs: %w", err)
 	}
 
-	// Requeue if pods are in grace period
+	// Keep polling the master while the test runs
+	if r.shouldPollMaster(lt) && (requeueAfter == 0 || requeueAfter > r.Config.StatsPollInterval) {
+		requeueAfter = r.Config.StatsPollInterval
+	}
+
+	// Wake up when the test runs past its maxDuration
+	if remaining, ok := r.maxDurationRemaining(lt); ok && lt.Status.Phase == locustv2.PhaseRunning &&
+		(requeueAfter == 0 || remaining < requeueAfter) {
+		requeueAfter = remaining
+	}
+
+	// Requeue if pods are in grace period, the master is polled, results are
+	// pending or the test may time out
 	if requeueAfter > 0 {
 		return ctrl.Result{RequeueAfter: requeueAfter}, nil
 	}
@@ -469,14 +676,53 @@ func (r *LocustTestReconciler) mapPodToLocustTest(ctx context.Context, obj clien
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *LocustTestReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.StatsFetcher == nil {
+		r.StatsFetcher = stats.NewHTTPFetcher(statsFetchTimeout)
+	}
+	if r.MasterStopper == nil {
+		r.MasterStopper = stats.NewHTTPFetcher(statsFetchTimeout)
+	}
+	if r.ResultsFetcher == nil {
+		r.ResultsFetcher = results.NewHTTPFetcher(resultsFetchTimeout)
+	}
+	if r.ResultsUploader == nil {
+		r.ResultsUploader = results.NewS3Uploader(resultsUploadTimeout)
+	}
+	if r.LogReader == nil {
+		clientset, err := kubernetes.NewForConfig(mgr.GetConfig())
+		if err != nil {
+			return fmt.Errorf("failed to create clientset for reading logs: %w", err)
+		}
+		r.LogReader = &results.KubeLogReader{Clientset: clientset}
+	}
+	if r.Notifier == nil {
+		r.Notifier = notify.NewHTTPSender(notificationTimeout)
+	}
+	if r.Annotator == nil {
+		r.Annotator = grafana.NewHTTPAnnotator(grafanaTimeout)
+	}
+	if r.LocustMetrics == nil {
+		r.LocustMetrics = metrics.NewLocustCollector()
+		if err := ctrlmetrics.Registry.Register(r.LocustMetrics); err != nil {
+			return fmt.Errorf("failed to register the Locust metrics: %w", err)
+		}
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&locustv2.LocustTest{}).
+		For(&locustv2.LocustTest{}, builder.WithPredicates(ignoreLiveStatsUpdates)).
 		Owns(&batchv1.Job{}).    // Watch owned Jobs for status updates
 		Owns(&corev1.Service{}). // Watch owned Services
 		Watches(                 // Watch pods via custom mapping (pods are owned by Jobs, not LocustTest)
 			&corev1.Pod{},
 			handler.EnqueueRequestsFromMapFunc(r.mapPodToLocustTest),
 		).
+		Watches( // Check queued tests again when their queue's tests or limits change
+			&locustv2.LocustTest{},
+			handler.EnqueueRequestsFromMapFunc(r.mapToQueuedTests),
+			builder.WithPredicates(ignoreLiveStatsUpdates),
+		).
+		Watches(&locustv2.LocustTestQueue{}, handler.EnqueueRequestsFromMapFunc(r.mapToQueuedTests)).
+		Watches(&locustv2.ClusterLocustTestQueue{}, handler.EnqueueRequestsFromMapFunc(r.mapToQueuedTests)).
 		Named("locusttest").
 		Complete(r)
 }
@@ -27,7 +27,9 @@ import (
 	"github.com/stretchr/testify/require"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -47,6 +49,7 @@ func newTestScheme() *runtime.Scheme {
 	_ = locustv2.AddToScheme(scheme)
 	_ = batchv1.AddToScheme(scheme)
 	_ = corev1.AddToScheme(scheme)
+	_ = networkingv1.AddToScheme(scheme)
 	return scheme
 }
 
@@ -88,8 +91,12 @@ func newTestOperatorConfig() *config.OperatorConfig {
 		MetricsExporterMemLimit:                "1024Mi",
 		MetricsExporterEphemeralStorageLimit:   "50M",
 
+		GitCloneImage: "alpine/git:v2.47.2",
+
 		KafkaBootstrapServers: "localhost:9092",
 		KafkaSecurityEnabled:  false,
+
+		StatsPollInterval: 10 * time.Second,
 	}
 }
 
@@ -339,8 +346,8 @@ func TestReconcile_VerifyServiceConfiguration(t *testing.T) {
 	// Verify service has correct selector
 	assert.Equal(t, "svc-test-master", svc.Spec.Selector["performance-test-pod-name"])
 
-	// Verify service has ports (3 ports: 5557, 5558, metrics)
-	assert.Len(t, svc.Spec.Ports, 3)
+	// Verify service has ports (4 ports: 5557, 5558, 8089, metrics)
+	assert.Len(t, svc.Spec.Ports, 4)
 }
 
 func TestReconcile_VerifyMasterJobConfiguration(t *testing.T) {
@@ -548,6 +555,107 @@ func TestReconcile_WithLibConfigMap(t *testing.T) {
 	assert.True(t, libVolumeFound, "Lib volume should exist")
 }
 
+func TestReconcile_WithLoadStages(t *testing.T) {
+	lt := newTestLocustTestCR("staged-test", "default")
+	lt.Spec.Load = &locustv2.LoadConfig{
+		Stages: []locustv2.LoadStage{
+			{Duration: metav1.Duration{Duration: time.Minute}, Users: 10, SpawnRate: resource.MustParse("1")},
+			{Duration: metav1.Duration{Duration: 4 * time.Minute}, Users: 50, SpawnRate: resource.MustParse("5")},
+		},
+	}
+	reconciler, _ := newTestReconciler(lt)
+
+	_, err := reconciler.Reconcile(context.Background(), ctrl.Request{
+		NamespacedName: types.NamespacedName{
+			Name:      "staged-test",
+			Namespace: "default",
+		},
+	})
+	require.NoError(t, err)
+
+	// Generated LoadTestShape ConfigMap is created and owned by the LocustTest
+	cm := &corev1.ConfigMap{}
+	err = reconciler.Get(context.Background(), types.NamespacedName{
+		Name:      "staged-test-load-shape",
+		Namespace: "default",
+	}, cm)
+	require.NoError(t, err)
+	assert.Contains(t, cm.Data["locust_shape.py"], "LoadTestShape")
+	require.Len(t, cm.OwnerReferences, 1)
+	assert.Equal(t, "staged-test", cm.OwnerReferences[0].Name)
+
+	// Configured load profile is reported in the status
+	updated := &locustv2.LocustTest{}
+	err = reconciler.Get(context.Background(), types.NamespacedName{
+		Name:      "staged-test",
+		Namespace: "default",
+	}, updated)
+	require.NoError(t, err)
+	assert.Equal(t, "2 stages, peak 50 users, 5m0s", updated.Status.LoadProfile)
+}
+
+func TestReconcile_WithoutLoadStages_NoLoadShapeConfigMap(t *testing.T) {
+	lt := newTestLocustTestCR("plain-test", "default")
+	reconciler, _ := newTestReconciler(lt)
+
+	_, err := reconciler.Reconcile(context.Background(), ctrl.Request{
+		NamespacedName: types.NamespacedName{
+			Name:      "plain-test",
+			Namespace: "default",
+		},
+	})
+	require.NoError(t, err)
+
+	cms := &corev1.ConfigMapList{}
+	require.NoError(t, reconciler.List(context.Background(), cms, client.InNamespace("default")))
+	assert.Empty(t, cms.Items)
+}
+
+func TestReconcile_WithInlineTestFiles(t *testing.T) {
+	lt := newTestLocustTestCR("inline-test", "default")
+	lt.Spec.TestFiles = &locustv2.TestFilesConfig{
+		Inline: map[string]string{"locustfile.py": "from locust import HttpUser\n"},
+	}
+	reconciler, _ := newTestReconciler(lt)
+
+	_, err := reconciler.Reconcile(context.Background(), ctrl.Request{
+		NamespacedName: types.NamespacedName{
+			Name:      "inline-test",
+			Namespace: "default",
+		},
+	})
+	require.NoError(t, err)
+
+	// Inline files are materialized into an owned, immutable ConfigMap
+	cm := &corev1.ConfigMap{}
+	err = reconciler.Get(context.Background(), types.NamespacedName{
+		Name:      "inline-test-test-files",
+		Namespace: "default",
+	}, cm)
+	require.NoError(t, err)
+	assert.Equal(t, lt.Spec.TestFiles.Inline, cm.Data)
+	require.NotNil(t, cm.Immutable)
+	assert.True(t, *cm.Immutable)
+	require.Len(t, cm.OwnerReferences, 1)
+	assert.Equal(t, "inline-test", cm.OwnerReferences[0].Name)
+
+	// and mounted by the Jobs
+	for _, name := range []string{"inline-test-master", "inline-test-worker"} {
+		job := &batchv1.Job{}
+		require.NoError(t, reconciler.Get(context.Background(), types.NamespacedName{
+			Name:      name,
+			Namespace: "default",
+		}, job))
+		var mounted bool
+		for _, v := range job.Spec.Template.Spec.Volumes {
+			if v.ConfigMap != nil && v.ConfigMap.Name == "inline-test-test-files" {
+				mounted = true
+			}
+		}
+		assert.True(t, mounted, name)
+	}
+}
+
 func TestReconcile_MultipleNamespaces(t *testing.T) {
 	lt1 := newTestLocustTestCR("test1", "namespace-a")
 	lt2 := newTestLocustTestCR("test2", "namespace-b")
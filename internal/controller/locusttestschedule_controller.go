@@ -0,0 +1,368 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ref "k8s.io/client-go/tools/reference"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+)
+
+const (
+	// LabelScheduleName is set on every LocustTest created by a LocustTestSchedule.
+	LabelScheduleName = "locust.io/schedule"
+	// AnnotationScheduledTime records the scheduled time of the run that created a LocustTest.
+	AnnotationScheduledTime = "locust.io/scheduled-at"
+
+	// maxMissedSchedules bounds how many missed run times are walked before giving up.
+	// Reaching it almost always means a clock skew or a schedule like "* * * * *"
+	// combined with a long operator outage and no startingDeadlineSeconds.
+	maxMissedSchedules = 100
+)
+
+// Clock knows how to get the current time. Tests replace it with a fixed clock.
+type Clock interface {
+	Now() time.Time
+}
+
+// realClock is the Clock used outside of tests.
+type realClock struct{}
+
+func (realClock) Now() time.Time { return time.Now() }
+
+// LocustTestScheduleReconciler reconciles a LocustTestSchedule object.
+type LocustTestScheduleReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	Clock    Clock
+}
+
+// +kubebuilder:rbac:groups=locust.io,resources=locusttestschedules,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=locust.io,resources=locusttestschedules/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=locust.io,resources=locusttests,verbs=create;delete
+
+// Reconcile creates LocustTests from the schedule's template when a run is due,
+// tracks which of them are still active and prunes finished runs beyond the
+// configured history limits. It requeues itself for the next scheduled time.
+func (r *LocustTestScheduleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	schedule := &locustv2.LocustTestSchedule{}
+	if err := r.Get(ctx, req.NamespacedName, schedule); err != nil {
+		if apierrors.IsNotFound(err) {
+			// Schedule deleted - its runs are cleaned up via owner references
+			return ctrl.Result{}, nil
+		}
+		log.Error(err, "Failed to fetch LocustTestSchedule")
+		return ctrl.Result{}, err
+	}
+
+	// List the runs this schedule created
+	var runs locustv2.LocustTestList
+	if err := r.List(ctx, &runs,
+		client.InNamespace(schedule.Namespace),
+		client.MatchingLabels{LabelScheduleName: schedule.Name},
+	); err != nil {
+		log.Error(err, "Failed to list scheduled LocustTests")
+		return ctrl.Result{}, err
+	}
+
+	var active, successful, failed []*locustv2.LocustTest
+	var mostRecentTime *time.Time
+	for i := range runs.Items {
+		run := &runs.Items[i]
+		if !metav1.IsControlledBy(run, schedule) {
+			continue
+		}
+
+		switch run.Status.Phase {
+		case locustv2.PhaseSucceeded:
+			successful = append(successful, run)
+		case locustv2.PhaseFailed, locustv2.PhaseCancelled, locustv2.PhaseAborted:
+			failed = append(failed, run)
+		default:
+			active = append(active, run)
+		}
+
+		if scheduledAt, ok := scheduledTimeForRun(run); ok {
+			if mostRecentTime == nil || scheduledAt.After(*mostRecentTime) {
+				mostRecentTime = &scheduledAt
+			}
+		}
+	}
+
+	// Update status from the observed runs
+	oldStatus := schedule.Status.DeepCopy()
+	if mostRecentTime != nil {
+		schedule.Status.LastScheduleTime = &metav1.Time{Time: *mostRecentTime}
+	}
+	for _, run := range successful {
+		if run.Status.CompletionTime == nil {
+			continue
+		}
+		if schedule.Status.LastSuccessfulTime == nil ||
+			run.Status.CompletionTime.After(schedule.Status.LastSuccessfulTime.Time) {
+			schedule.Status.LastSuccessfulTime = run.Status.CompletionTime.DeepCopy()
+		}
+	}
+	schedule.Status.Active = nil
+	for _, run := range active {
+		runRef, err := ref.GetReference(r.Scheme, run)
+		if err != nil {
+			log.Error(err, "Failed to build reference to active run", "locustTest", run.Name)
+			continue
+		}
+		schedule.Status.Active = append(schedule.Status.Active, *runRef)
+	}
+	if !equality.Semantic.DeepEqual(oldStatus, &schedule.Status) {
+		if err := r.Status().Update(ctx, schedule); err != nil {
+			log.Error(err, "Failed to update LocustTestSchedule status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Prune finished runs beyond the history limits. Best effort: a failed
+	// delete is retried on the next reconcile.
+	r.pruneHistory(ctx, successful, historyLimit(schedule.Spec.SuccessfulTestsHistoryLimit, 3))
+	r.pruneHistory(ctx, failed, historyLimit(schedule.Spec.FailedTestsHistoryLimit, 1))
+
+	if schedule.Spec.Suspend != nil && *schedule.Spec.Suspend {
+		log.V(1).Info("Schedule suspended, not creating runs")
+		return ctrl.Result{}, nil
+	}
+
+	sched, err := locustv2.ParseSchedule(schedule.Spec.Schedule, schedule.Spec.TimeZone)
+	if err != nil {
+		// Not retryable until the spec changes, which triggers a new reconcile
+		log.Error(err, "Unparseable schedule", "schedule", schedule.Spec.Schedule)
+		r.Recorder.Event(schedule, corev1.EventTypeWarning, "InvalidSchedule", err.Error())
+		return ctrl.Result{}, nil
+	}
+
+	now := r.Clock.Now()
+	missedRun, nextRun, err := getNextSchedule(schedule, sched, now)
+	if err != nil {
+		log.Error(err, "Unable to compute schedule times")
+		r.Recorder.Event(schedule, corev1.EventTypeWarning, "InvalidSchedule", err.Error())
+		return ctrl.Result{}, nil
+	}
+
+	scheduledResult := ctrl.Result{RequeueAfter: nextRun.Sub(now)}
+	log = log.WithValues("now", now, "nextRun", nextRun)
+
+	if missedRun.IsZero() {
+		log.V(1).Info("No upcoming scheduled times, sleeping until next")
+		return scheduledResult, nil
+	}
+
+	log = log.WithValues("currentRun", missedRun)
+	if deadline := schedule.Spec.StartingDeadlineSeconds; deadline != nil &&
+		missedRun.Add(time.Duration(*deadline)*time.Second).Before(now) {
+		log.V(1).Info("Missed starting deadline for last run, sleeping until next")
+		r.Recorder.Eventf(schedule, corev1.EventTypeWarning, "MissedSchedule",
+			"Missed starting deadline for run scheduled at %s", missedRun.Format(time.RFC3339))
+		return scheduledResult, nil
+	}
+
+	switch schedule.Spec.ConcurrencyPolicy {
+	case locustv2.AllowConcurrent:
+		// Nothing to do
+	case locustv2.ReplaceConcurrent:
+		for _, run := range active {
+			if err := r.Delete(ctx, run, client.PropagationPolicy(metav1.DeletePropagationBackground)); client.IgnoreNotFound(err) != nil {
+				log.Error(err, "Unable to delete active run", "locustTest", run.Name)
+				return ctrl.Result{}, err
+			}
+			r.Recorder.Eventf(schedule, corev1.EventTypeNormal, "ReplacedRun",
+				"Deleted active run %s to start the run scheduled at %s", run.Name, missedRun.Format(time.RFC3339))
+		}
+	default: // ForbidConcurrent, also the API default
+		if len(active) > 0 {
+			log.V(1).Info("Concurrency policy blocks concurrent runs, skipping", "activeRuns", len(active))
+			r.Recorder.Eventf(schedule, corev1.EventTypeNormal, "SkippedRun",
+				"Skipped run scheduled at %s: %d run(s) still active", missedRun.Format(time.RFC3339), len(active))
+			return scheduledResult, nil
+		}
+	}
+
+	run, err := r.buildScheduledTest(schedule, missedRun)
+	if err != nil {
+		log.Error(err, "Unable to construct LocustTest from template")
+		return scheduledResult, nil
+	}
+
+	if err := r.Create(ctx, run); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			// Created by an earlier reconcile that did not get to update status
+			log.V(1).Info("Scheduled run already exists", "locustTest", run.Name)
+			return scheduledResult, nil
+		}
+		log.Error(err, "Unable to create LocustTest for scheduled run", "locustTest", run.Name)
+		return ctrl.Result{}, err
+	}
+
+	log.Info("Created LocustTest for scheduled run", "locustTest", run.Name)
+	r.Recorder.Eventf(schedule, corev1.EventTypeNormal, "SuccessfulCreate",
+		"Created LocustTest %s", run.Name)
+
+	return scheduledResult, nil
+}
+
+// buildScheduledTest builds the LocustTest for the run scheduled at scheduledTime.
+func (r *LocustTestScheduleReconciler) buildScheduledTest(
+	schedule *locustv2.LocustTestSchedule,
+	scheduledTime time.Time,
+) (*locustv2.LocustTest, error) {
+	tmpl := schedule.Spec.TestTemplate
+
+	labels := make(map[string]string, len(tmpl.Metadata.Labels)+1)
+	for k, v := range tmpl.Metadata.Labels {
+		labels[k] = v
+	}
+	labels[LabelScheduleName] = schedule.Name
+
+	annotations := make(map[string]string, len(tmpl.Metadata.Annotations)+1)
+	for k, v := range tmpl.Metadata.Annotations {
+		annotations[k] = v
+	}
+	annotations[AnnotationScheduledTime] = scheduledTime.Format(time.RFC3339)
+
+	run := &locustv2.LocustTest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        locustv2.ScheduledTestName(schedule.Name, scheduledTime),
+			Namespace:   schedule.Namespace,
+			Labels:      labels,
+			Annotations: annotations,
+		},
+		Spec: *tmpl.Spec.DeepCopy(),
+	}
+
+	if err := controllerutil.SetControllerReference(schedule, run, r.Scheme); err != nil {
+		return nil, err
+	}
+
+	return run, nil
+}
+
+// pruneHistory deletes the oldest finished runs so that at most limit remain.
+func (r *LocustTestScheduleReconciler) pruneHistory(ctx context.Context, runs []*locustv2.LocustTest, limit int32) {
+	log := logf.FromContext(ctx)
+
+	if int32(len(runs)) <= limit {
+		return
+	}
+
+	sort.Slice(runs, func(i, j int) bool {
+		return runs[i].CreationTimestamp.Before(&runs[j].CreationTimestamp)
+	})
+
+	for _, run := range runs[:int32(len(runs))-limit] {
+		if err := r.Delete(ctx, run, client.PropagationPolicy(metav1.DeletePropagationBackground)); client.IgnoreNotFound(err) != nil {
+			log.Error(err, "Unable to delete old run", "locustTest", run.Name)
+			continue
+		}
+		log.V(1).Info("Deleted old run", "locustTest", run.Name, "phase", run.Status.Phase)
+	}
+}
+
+// historyLimit returns the configured limit, or def when unset.
+func historyLimit(limit *int32, def int32) int32 {
+	if limit == nil {
+		return def
+	}
+	return *limit
+}
+
+// scheduledTimeForRun reads the scheduled time a run was created for.
+func scheduledTimeForRun(run *locustv2.LocustTest) (time.Time, bool) {
+	raw, ok := run.Annotations[AnnotationScheduledTime]
+	if !ok {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// getNextSchedule returns the most recent run time that is due but has not been
+// started yet (zero if none) and the next run time after now.
+func getNextSchedule(schedule *locustv2.LocustTestSchedule, sched cron.Schedule, now time.Time) (time.Time, time.Time, error) {
+	// Start from the last run, or from creation if there never was one
+	var earliest time.Time
+	if schedule.Status.LastScheduleTime != nil {
+		earliest = schedule.Status.LastScheduleTime.Time
+	} else {
+		earliest = schedule.CreationTimestamp.Time
+	}
+
+	// Runs older than the starting deadline would be skipped anyway
+	if deadline := schedule.Spec.StartingDeadlineSeconds; deadline != nil {
+		if limit := now.Add(-time.Duration(*deadline) * time.Second); limit.After(earliest) {
+			earliest = limit
+		}
+	}
+
+	if earliest.After(now) {
+		return time.Time{}, sched.Next(now), nil
+	}
+
+	var lastMissed time.Time
+	missed := 0
+	for t := sched.Next(earliest); !t.After(now); t = sched.Next(t) {
+		lastMissed = t
+		missed++
+		if missed > maxMissedSchedules {
+			return time.Time{}, time.Time{}, fmt.Errorf(
+				"too many missed start times (> %d); set or decrease startingDeadlineSeconds or check clock skew",
+				maxMissedSchedules)
+		}
+	}
+
+	return lastMissed, sched.Next(now), nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *LocustTestScheduleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Clock == nil {
+		r.Clock = realClock{}
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&locustv2.LocustTestSchedule{}).
+		Owns(&locustv2.LocustTest{}). // Watch runs to track completion and history
+		Named("locusttestschedule").
+		Complete(r)
+}
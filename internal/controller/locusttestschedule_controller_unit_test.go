@@ -0,0 +1,345 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+)
+
+// fixedClock is a Clock that always returns the same time.
+type fixedClock struct{ t time.Time }
+
+func (c fixedClock) Now() time.Time { return c.t }
+
+// scheduleTestNow is the fixed "now" used by the schedule tests: 02:00:30 UTC.
+var scheduleTestNow = time.Date(2026, 10, 16, 2, 0, 30, 0, time.UTC)
+
+func newTestScheduleReconciler(now time.Time, objs ...client.Object) (*LocustTestScheduleReconciler, *record.FakeRecorder) {
+	scheme := newTestScheme()
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&locustv2.LocustTestSchedule{}, &locustv2.LocustTest{}).
+		Build()
+	recorder := record.NewFakeRecorder(10)
+
+	return &LocustTestScheduleReconciler{
+		Client:   fakeClient,
+		Scheme:   scheme,
+		Recorder: recorder,
+		Clock:    fixedClock{t: now},
+	}, recorder
+}
+
+func newTestSchedule(name string) *locustv2.LocustTestSchedule {
+	return &locustv2.LocustTestSchedule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "default",
+			UID:               "schedule-uid-12345",
+			CreationTimestamp: metav1.NewTime(scheduleTestNow.Add(-24 * time.Hour).Add(-30 * time.Minute)),
+		},
+		Spec: locustv2.LocustTestScheduleSpec{
+			Schedule:          "0 2 * * *",
+			ConcurrencyPolicy: locustv2.ForbidConcurrent,
+			TestTemplate: locustv2.LocustTestTemplateSpec{
+				Metadata: locustv2.LocustTestTemplateMeta{
+					Labels: map[string]string{"team": "checkout"},
+				},
+				Spec: newTestLocustTestCR("unused", "default").Spec,
+			},
+		},
+	}
+}
+
+// newTestScheduledRun creates a run owned by the schedule with the given phase.
+func newTestScheduledRun(
+	t *testing.T,
+	r *LocustTestScheduleReconciler,
+	schedule *locustv2.LocustTestSchedule,
+	scheduledAt time.Time,
+	phase locustv2.Phase,
+) *locustv2.LocustTest {
+	t.Helper()
+	run, err := r.buildScheduledTest(schedule, scheduledAt)
+	require.NoError(t, err)
+	run.CreationTimestamp = metav1.NewTime(scheduledAt)
+	run.Status.Phase = phase
+	if phase == locustv2.PhaseSucceeded || phase == locustv2.PhaseFailed {
+		run.Status.CompletionTime = ptr.To(metav1.NewTime(scheduledAt.Add(15 * time.Minute)))
+	}
+	return run
+}
+
+func reconcileSchedule(t *testing.T, r *LocustTestScheduleReconciler, name string) ctrl.Result {
+	t.Helper()
+	result, err := r.Reconcile(context.Background(), ctrl.Request{
+		NamespacedName: types.NamespacedName{Name: name, Namespace: "default"},
+	})
+	require.NoError(t, err)
+	return result
+}
+
+func listScheduledRuns(t *testing.T, r *LocustTestScheduleReconciler, scheduleName string) []locustv2.LocustTest {
+	t.Helper()
+	runs := &locustv2.LocustTestList{}
+	require.NoError(t, r.List(context.Background(), runs,
+		client.InNamespace("default"),
+		client.MatchingLabels{LabelScheduleName: scheduleName}))
+	return runs.Items
+}
+
+func TestScheduleReconcile_NotFound(t *testing.T) {
+	r, _ := newTestScheduleReconciler(scheduleTestNow)
+	result := reconcileSchedule(t, r, "missing")
+	assert.Equal(t, ctrl.Result{}, result)
+}
+
+func TestScheduleReconcile_CreatesDueRun(t *testing.T) {
+	schedule := newTestSchedule("nightly")
+	r, recorder := newTestScheduleReconciler(scheduleTestNow, schedule)
+
+	result := reconcileSchedule(t, r, "nightly")
+
+	runs := listScheduledRuns(t, r, "nightly")
+	require.Len(t, runs, 1)
+	run := runs[0]
+
+	due := time.Date(2026, 10, 16, 2, 0, 0, 0, time.UTC)
+	assert.Equal(t, locustv2.ScheduledTestName("nightly", due), run.Name)
+	assert.Equal(t, "checkout", run.Labels["team"], "template labels are copied")
+	assert.Equal(t, "nightly", run.Labels[LabelScheduleName])
+	assert.Equal(t, due.Format(time.RFC3339), run.Annotations[AnnotationScheduledTime])
+	assert.Equal(t, schedule.Spec.TestTemplate.Spec, run.Spec, "spec is taken from the template unchanged")
+
+	owner := metav1.GetControllerOf(&run)
+	require.NotNil(t, owner, "run must be controlled by the schedule")
+	assert.Equal(t, "LocustTestSchedule", owner.Kind)
+	assert.Equal(t, "nightly", owner.Name)
+
+	// Requeued for the next run, 24h after the one just created
+	assert.Equal(t, due.Add(24*time.Hour).Sub(scheduleTestNow), result.RequeueAfter)
+
+	select {
+	case event := <-recorder.Events:
+		assert.Contains(t, event, "SuccessfulCreate")
+	default:
+		t.Fatal("expected a SuccessfulCreate event")
+	}
+}
+
+func TestScheduleReconcile_NothingDue(t *testing.T) {
+	schedule := newTestSchedule("nightly")
+	// Created after today's run, so nothing is due yet
+	schedule.CreationTimestamp = metav1.NewTime(scheduleTestNow.Add(-10 * time.Second))
+	r, _ := newTestScheduleReconciler(scheduleTestNow, schedule)
+
+	result := reconcileSchedule(t, r, "nightly")
+
+	assert.Empty(t, listScheduledRuns(t, r, "nightly"))
+	next := time.Date(2026, 10, 17, 2, 0, 0, 0, time.UTC)
+	assert.Equal(t, next.Sub(scheduleTestNow), result.RequeueAfter)
+}
+
+func TestScheduleReconcile_UnchangedStatusNotWritten(t *testing.T) {
+	schedule := newTestSchedule("nightly")
+	r, _ := newTestScheduleReconciler(scheduleTestNow, schedule)
+	// Today's run already completed, so no run is created
+	run := newTestScheduledRun(t, r, schedule, scheduleTestNow.Truncate(time.Hour), locustv2.PhaseSucceeded)
+	require.NoError(t, r.Create(context.Background(), run))
+
+	reconcileSchedule(t, r, "nightly")
+	updated := &locustv2.LocustTestSchedule{}
+	require.NoError(t, r.Get(context.Background(), client.ObjectKeyFromObject(schedule), updated))
+	require.NotNil(t, updated.Status.LastSuccessfulTime)
+
+	reconcileSchedule(t, r, "nightly")
+	again := &locustv2.LocustTestSchedule{}
+	require.NoError(t, r.Get(context.Background(), client.ObjectKeyFromObject(schedule), again))
+	assert.Equal(t, updated.ResourceVersion, again.ResourceVersion, "an unchanged status isn't written again")
+}
+
+func TestScheduleReconcile_TimeZone(t *testing.T) {
+	schedule := newTestSchedule("nightly")
+	// 02:00 in Berlin (CEST, UTC+2 in October) is 00:00 UTC
+	schedule.Spec.TimeZone = ptr.To("Europe/Berlin")
+	r, _ := newTestScheduleReconciler(scheduleTestNow, schedule)
+
+	reconcileSchedule(t, r, "nightly")
+
+	runs := listScheduledRuns(t, r, "nightly")
+	require.Len(t, runs, 1)
+	due := time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, locustv2.ScheduledTestName("nightly", due), runs[0].Name)
+}
+
+func TestScheduleReconcile_Suspended(t *testing.T) {
+	schedule := newTestSchedule("nightly")
+	schedule.Spec.Suspend = ptr.To(true)
+	r, _ := newTestScheduleReconciler(scheduleTestNow, schedule)
+
+	result := reconcileSchedule(t, r, "nightly")
+
+	assert.Empty(t, listScheduledRuns(t, r, "nightly"))
+	assert.Equal(t, ctrl.Result{}, result)
+}
+
+func TestScheduleReconcile_MissedStartingDeadline(t *testing.T) {
+	schedule := newTestSchedule("nightly")
+	schedule.Spec.StartingDeadlineSeconds = ptr.To(int64(10))
+	r, _ := newTestScheduleReconciler(scheduleTestNow, schedule)
+
+	result := reconcileSchedule(t, r, "nightly")
+
+	assert.Empty(t, listScheduledRuns(t, r, "nightly"), "run is 30s late with a 10s deadline")
+	next := time.Date(2026, 10, 17, 2, 0, 0, 0, time.UTC)
+	assert.Equal(t, next.Sub(scheduleTestNow), result.RequeueAfter)
+}
+
+func TestScheduleReconcile_ForbidSkipsWhileActive(t *testing.T) {
+	schedule := newTestSchedule("nightly")
+	r, recorder := newTestScheduleReconciler(scheduleTestNow, schedule)
+	previous := newTestScheduledRun(t, r, schedule, scheduleTestNow.Add(-24*time.Hour).Truncate(time.Hour), locustv2.PhaseRunning)
+	require.NoError(t, r.Create(context.Background(), previous))
+
+	reconcileSchedule(t, r, "nightly")
+
+	runs := listScheduledRuns(t, r, "nightly")
+	require.Len(t, runs, 1, "no new run while the previous one is active")
+	assert.Equal(t, previous.Name, runs[0].Name)
+
+	updated := &locustv2.LocustTestSchedule{}
+	require.NoError(t, r.Get(context.Background(), client.ObjectKeyFromObject(schedule), updated))
+	require.Len(t, updated.Status.Active, 1)
+	assert.Equal(t, previous.Name, updated.Status.Active[0].Name)
+
+	select {
+	case event := <-recorder.Events:
+		assert.Contains(t, event, "SkippedRun")
+	default:
+		t.Fatal("expected a SkippedRun event")
+	}
+}
+
+func TestScheduleReconcile_AllowRunsConcurrently(t *testing.T) {
+	schedule := newTestSchedule("nightly")
+	schedule.Spec.ConcurrencyPolicy = locustv2.AllowConcurrent
+	r, _ := newTestScheduleReconciler(scheduleTestNow, schedule)
+	previous := newTestScheduledRun(t, r, schedule, scheduleTestNow.Add(-24*time.Hour).Truncate(time.Hour), locustv2.PhaseRunning)
+	require.NoError(t, r.Create(context.Background(), previous))
+
+	reconcileSchedule(t, r, "nightly")
+
+	assert.Len(t, listScheduledRuns(t, r, "nightly"), 2)
+}
+
+func TestScheduleReconcile_ReplaceDeletesActive(t *testing.T) {
+	schedule := newTestSchedule("nightly")
+	schedule.Spec.ConcurrencyPolicy = locustv2.ReplaceConcurrent
+	r, _ := newTestScheduleReconciler(scheduleTestNow, schedule)
+	previous := newTestScheduledRun(t, r, schedule, scheduleTestNow.Add(-24*time.Hour).Truncate(time.Hour), locustv2.PhaseRunning)
+	require.NoError(t, r.Create(context.Background(), previous))
+
+	reconcileSchedule(t, r, "nightly")
+
+	runs := listScheduledRuns(t, r, "nightly")
+	require.Len(t, runs, 1)
+	assert.NotEqual(t, previous.Name, runs[0].Name, "active run is replaced by the new one")
+}
+
+func TestScheduleReconcile_PrunesHistory(t *testing.T) {
+	schedule := newTestSchedule("nightly")
+	schedule.Spec.SuccessfulTestsHistoryLimit = ptr.To(int32(1))
+	schedule.Spec.FailedTestsHistoryLimit = ptr.To(int32(0))
+	schedule.Spec.Suspend = ptr.To(true) // only exercise pruning
+	r, _ := newTestScheduleReconciler(scheduleTestNow, schedule)
+
+	base := scheduleTestNow.Truncate(time.Hour)
+	oldSuccess := newTestScheduledRun(t, r, schedule, base.Add(-72*time.Hour), locustv2.PhaseSucceeded)
+	newSuccess := newTestScheduledRun(t, r, schedule, base.Add(-48*time.Hour), locustv2.PhaseSucceeded)
+	failed := newTestScheduledRun(t, r, schedule, base.Add(-24*time.Hour), locustv2.PhaseFailed)
+	for _, run := range []*locustv2.LocustTest{oldSuccess, newSuccess, failed} {
+		require.NoError(t, r.Create(context.Background(), run))
+	}
+
+	reconcileSchedule(t, r, "nightly")
+
+	runs := listScheduledRuns(t, r, "nightly")
+	require.Len(t, runs, 1)
+	assert.Equal(t, newSuccess.Name, runs[0].Name, "only the newest successful run is kept")
+
+	updated := &locustv2.LocustTestSchedule{}
+	require.NoError(t, r.Get(context.Background(), client.ObjectKeyFromObject(schedule), updated))
+	require.NotNil(t, updated.Status.LastScheduleTime)
+	assert.True(t, updated.Status.LastScheduleTime.Equal(&metav1.Time{Time: base.Add(-24 * time.Hour)}))
+	require.NotNil(t, updated.Status.LastSuccessfulTime)
+	assert.True(t, updated.Status.LastSuccessfulTime.Equal(newSuccess.Status.CompletionTime))
+	assert.Empty(t, updated.Status.Active)
+}
+
+func TestScheduleReconcile_IgnoresRunsNotControlledBySchedule(t *testing.T) {
+	schedule := newTestSchedule("nightly")
+	schedule.Spec.Suspend = ptr.To(true)
+	r, _ := newTestScheduleReconciler(scheduleTestNow, schedule)
+
+	// Carries the label but is owned by someone else
+	stray := newTestScheduledRun(t, r, schedule, scheduleTestNow.Add(-24*time.Hour), locustv2.PhaseRunning)
+	stray.OwnerReferences = nil
+	other := newTestSchedule("other")
+	other.UID = "other-uid"
+	require.NoError(t, controllerutil.SetControllerReference(other, stray, r.Scheme))
+	require.NoError(t, r.Create(context.Background(), stray))
+
+	reconcileSchedule(t, r, "nightly")
+
+	updated := &locustv2.LocustTestSchedule{}
+	require.NoError(t, r.Get(context.Background(), client.ObjectKeyFromObject(schedule), updated))
+	assert.Empty(t, updated.Status.Active)
+}
+
+func TestGetNextSchedule_TooManyMissed(t *testing.T) {
+	schedule := newTestSchedule("every-minute")
+	schedule.Spec.Schedule = "* * * * *"
+	sched, err := locustv2.ParseSchedule(schedule.Spec.Schedule, nil)
+	require.NoError(t, err)
+
+	_, _, err = getNextSchedule(schedule, sched, scheduleTestNow)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "too many missed start times")
+
+	// A starting deadline bounds the walk
+	schedule.Spec.StartingDeadlineSeconds = ptr.To(int64(300))
+	missed, next, err := getNextSchedule(schedule, sched, scheduleTestNow)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 10, 16, 2, 0, 0, 0, time.UTC), missed.UTC())
+	assert.Equal(t, time.Date(2026, 10, 16, 2, 1, 0, 0, time.UTC), next.UTC())
+}
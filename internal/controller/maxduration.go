@@ -0,0 +1,57 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/resources"
+)
+
+// maxDurationGrace is how long past its maxDuration the controller leaves
+// the Jobs' active deadline to end a test before ending it itself. The Jobs'
+// deadline counts from their own start, slightly after the test's.
+const maxDurationGrace = 30 * time.Second
+
+// maxDurationRemaining returns how long a started test has left before the
+// controller ends it, and false if it has no maximum duration.
+func (r *LocustTestReconciler) maxDurationRemaining(lt *locustv2.LocustTest) (time.Duration, bool) {
+	maxDuration := resources.MaxDuration(lt, r.Config)
+	if maxDuration <= 0 || lt.Status.StartTime == nil {
+		return 0, false
+	}
+	return time.Until(lt.Status.StartTime.Add(maxDuration + maxDurationGrace)), true
+}
+
+// timedOut reports whether the test ran past its maxDuration: the master
+// Job's active deadline fired, or the controller's own deadline passed.
+func (r *LocustTestReconciler) timedOut(lt *locustv2.LocustTest, masterJob *batchv1.Job) bool {
+	if masterJob != nil {
+		for _, condition := range masterJob.Status.Conditions {
+			if condition.Type == batchv1.JobFailed && condition.Status == corev1.ConditionTrue &&
+				condition.Reason == batchv1.JobReasonDeadlineExceeded {
+				return true
+			}
+		}
+	}
+	remaining, ok := r.maxDurationRemaining(lt)
+	return ok && remaining <= 0
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+)
+
+var maxDurationTestKey = types.NamespacedName{Name: "deadline-test", Namespace: "default"}
+
+// startMaxDurationTest creates the resources of a test limited to an hour,
+// marks its Jobs active and backdates its start by startedAgo.
+func startMaxDurationTest(t *testing.T, startedAgo time.Duration) (*LocustTestReconciler, chan string) {
+	t.Helper()
+	ctx := context.Background()
+
+	lt := newTestLocustTestCR("deadline-test", "default")
+	lt.Spec.MaxDuration = &metav1.Duration{Duration: time.Hour}
+	reconciler, recorder := newTestReconciler(lt)
+
+	_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: maxDurationTestKey})
+	require.NoError(t, err)
+	for _, jobName := range []string{"deadline-test-master", "deadline-test-worker"} {
+		job := &batchv1.Job{}
+		require.NoError(t, reconciler.Get(ctx, types.NamespacedName{Name: jobName, Namespace: "default"}, job))
+		require.NotNil(t, job.Spec.ActiveDeadlineSeconds)
+		assert.Equal(t, int64(3600), *job.Spec.ActiveDeadlineSeconds)
+		job.Status.Active = 1
+		require.NoError(t, reconciler.Status().Update(ctx, job))
+	}
+
+	require.NoError(t, reconciler.Get(ctx, maxDurationTestKey, lt))
+	lt.Status.StartTime = &metav1.Time{Time: time.Now().Add(-startedAgo)}
+	require.NoError(t, reconciler.Status().Update(ctx, lt))
+	drainEvents(recorder)
+
+	return reconciler, recorder.Events
+}
+
+func TestReconcile_MaxDurationRequeue(t *testing.T) {
+	reconciler, _ := startMaxDurationTest(t, 10*time.Minute)
+
+	result, err := reconciler.Reconcile(context.Background(), ctrl.Request{NamespacedName: maxDurationTestKey})
+	require.NoError(t, err)
+	assert.Greater(t, result.RequeueAfter, 50*time.Minute)
+	assert.LessOrEqual(t, result.RequeueAfter, 50*time.Minute+maxDurationGrace)
+}
+
+func TestReconcile_MaxDurationExceeded(t *testing.T) {
+	reconciler, events := startMaxDurationTest(t, 2*time.Hour)
+	ctx := context.Background()
+
+	_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: maxDurationTestKey})
+	require.NoError(t, err)
+
+	lt := &locustv2.LocustTest{}
+	require.NoError(t, reconciler.Get(ctx, maxDurationTestKey, lt))
+	assert.Equal(t, locustv2.PhaseFailed, lt.Status.Phase)
+	assert.NotNil(t, lt.Status.CompletionTime)
+	cond := findCondition(lt.Status.Conditions, locustv2.ConditionTypeTestCompleted)
+	require.NotNil(t, cond)
+	assert.Equal(t, locustv2.ReasonTimedOut, cond.Reason)
+	assert.Equal(t, "Test exceeded its maximum duration of 1h0m0s", cond.Message)
+	assert.Contains(t, <-events, "TimedOut")
+
+	for _, jobName := range []string{"deadline-test-master", "deadline-test-worker"} {
+		err := reconciler.Get(ctx, types.NamespacedName{Name: jobName, Namespace: "default"}, &batchv1.Job{})
+		assert.True(t, apierrors.IsNotFound(err), "Job %s must be deleted", jobName)
+	}
+}
+
+func TestUpdateStatusFromJobs_JobDeadlineExceeded(t *testing.T) {
+	lt := newTestLocustTestCR("deadline-test", "default")
+	lt.Spec.MaxDuration = &metav1.Duration{Duration: time.Hour}
+	lt.Status.Phase = locustv2.PhaseRunning
+	lt.Status.StartTime = &metav1.Time{Time: time.Now().Add(-time.Hour)}
+	reconciler, recorder := newTestReconciler(lt)
+	masterJob := &batchv1.Job{Status: batchv1.JobStatus{Conditions: []batchv1.JobCondition{{
+		Type:   batchv1.JobFailed,
+		Status: corev1.ConditionTrue,
+		Reason: batchv1.JobReasonDeadlineExceeded,
+	}}}}
+
+	err := reconciler.updateStatusFromJobs(context.Background(), lt, masterJob, nil, healthyPodStatus(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, locustv2.PhaseFailed, lt.Status.Phase)
+	cond := findCondition(lt.Status.Conditions, locustv2.ConditionTypeTestCompleted)
+	require.NotNil(t, cond)
+	assert.Equal(t, locustv2.ReasonTimedOut, cond.Reason)
+	assert.Contains(t, <-recorder.Events, "TimedOut")
+}
+
+func TestUpdateStatusFromJobs_CompletedPastMaxDuration(t *testing.T) {
+	lt := newTestLocustTestCR("deadline-test", "default")
+	lt.Spec.MaxDuration = &metav1.Duration{Duration: time.Hour}
+	lt.Status.Phase = locustv2.PhaseRunning
+	lt.Status.StartTime = &metav1.Time{Time: time.Now().Add(-2 * time.Hour)}
+	reconciler, _ := newTestReconciler(lt)
+	masterJob := &batchv1.Job{Status: batchv1.JobStatus{Conditions: []batchv1.JobCondition{{
+		Type:   batchv1.JobComplete,
+		Status: corev1.ConditionTrue,
+	}}}}
+
+	err := reconciler.updateStatusFromJobs(context.Background(), lt, masterJob, nil, healthyPodStatus(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, locustv2.PhaseSucceeded, lt.Status.Phase, "a test that completed doesn't time out")
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+)
+
+// Lifecycle metrics of LocustTests, served next to the controller-runtime
+// metrics on the manager's metrics endpoint.
+var (
+	testsByPhase = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "locust_operator_tests",
+		Help: "Number of LocustTests in each phase.",
+	}, []string{"namespace", "phase"})
+
+	phaseTransitions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "locust_operator_phase_transitions_total",
+		Help: "Total number of LocustTest phase transitions.",
+	}, []string{"namespace", "from", "to"})
+
+	testDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "locust_operator_test_duration_seconds",
+		Help: "Time from the start of a LocustTest to its completion, by final phase.",
+		// 1 minute to about 8.5 hours
+		Buckets: prometheus.ExponentialBuckets(60, 2, 10),
+	}, []string{"namespace", "phase"})
+
+	podFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "locust_operator_pod_failures_total",
+		Help: "Total number of pod health failures detected, by reason.",
+	}, []string{"namespace", "reason"})
+
+	workersConnectedDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "locust_operator_workers_connected_seconds",
+		Help: "Time from the start of a LocustTest, or from losing a worker, until all workers are connected.",
+		// 5 seconds to about 21 minutes
+		Buckets: prometheus.ExponentialBuckets(5, 2, 9),
+	}, []string{"namespace"})
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(
+		testsByPhase, phaseTransitions, testDuration, podFailures, workersConnectedDuration)
+}
+
+// testPhases tracks the phase of every LocustTest the controller reconciled,
+// to keep locust_operator_tests current and count transitions between phases.
+var testPhases = &phaseTracker{phases: map[types.NamespacedName]locustv2.Phase{}}
+
+// phaseTracker remembers the last phase seen for each test.
+type phaseTracker struct {
+	mu     sync.Mutex
+	phases map[types.NamespacedName]locustv2.Phase
+}
+
+// observe records the phase of a test as read at the start of a reconcile.
+// Every status update but those of the live statistics alone triggers a
+// reconcile, so every phase a test passes through is seen, in order. The first phase seen for a test, after it was
+// created or the operator restarted, isn't counted as a transition.
+func (t *phaseTracker) observe(lt *locustv2.LocustTest) {
+	phase := lt.Status.Phase
+	if phase == "" {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	key := client.ObjectKeyFromObject(lt)
+	previous, known := t.phases[key]
+	if known && previous == phase {
+		return
+	}
+	if known {
+		testsByPhase.WithLabelValues(lt.Namespace, string(previous)).Dec()
+		phaseTransitions.WithLabelValues(lt.Namespace, string(previous), string(phase)).Inc()
+	}
+	testsByPhase.WithLabelValues(lt.Namespace, string(phase)).Inc()
+	t.phases[key] = phase
+}
+
+// forget stops counting a deleted test.
+func (t *phaseTracker) forget(key types.NamespacedName) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if phase, known := t.phases[key]; known {
+		testsByPhase.WithLabelValues(key.Namespace, string(phase)).Dec()
+		delete(t.phases, key)
+	}
+}
+
+// observeCompletion records the run duration of a test that just reached a
+// terminal phase. Tests that never started aren't recorded.
+func observeCompletion(lt *locustv2.LocustTest) {
+	if lt.Status.StartTime == nil || lt.Status.CompletionTime == nil {
+		return
+	}
+	testDuration.WithLabelValues(lt.Namespace, string(lt.Status.Phase)).
+		Observe(lt.Status.CompletionTime.Sub(lt.Status.StartTime.Time).Seconds())
+}
+
+// observeWorkersConnected records how long a test waited for all its workers
+// when they just became connected: since the test started, or since the
+// workers stopped being all connected if that was later.
+func observeWorkersConnected(lt *locustv2.LocustTest, waitingSince time.Time, now time.Time) {
+	if lt.Status.StartTime == nil {
+		return
+	}
+	if lt.Status.StartTime.After(waitingSince) {
+		waitingSince = lt.Status.StartTime.Time
+	}
+	workersConnectedDuration.WithLabelValues(lt.Namespace).Observe(now.Sub(waitingSince).Seconds())
+}
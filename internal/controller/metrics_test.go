@@ -0,0 +1,146 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+)
+
+// The metrics are package-wide, so each test uses its own namespace.
+
+func histogramSamples(t *testing.T, vec *prometheus.HistogramVec, labels ...string) *dto.Histogram {
+	t.Helper()
+	metric := &dto.Metric{}
+	require.NoError(t, vec.WithLabelValues(labels...).(prometheus.Metric).Write(metric))
+	return metric.GetHistogram()
+}
+
+func TestPhaseTracker(t *testing.T) {
+	const namespace = "phase-tracker"
+	tracker := &phaseTracker{phases: map[types.NamespacedName]locustv2.Phase{}}
+	lt := newTestLocustTestCR("tracked", namespace)
+
+	tracker.observe(lt)
+	assert.Zero(t, testutil.ToFloat64(testsByPhase.WithLabelValues(namespace, "")), "tests without a phase aren't counted")
+
+	lt.Status.Phase = locustv2.PhasePending
+	tracker.observe(lt)
+	assert.Equal(t, 1.0, testutil.ToFloat64(testsByPhase.WithLabelValues(namespace, string(locustv2.PhasePending))))
+
+	lt.Status.Phase = locustv2.PhaseRunning
+	tracker.observe(lt)
+	tracker.observe(lt)
+	assert.Zero(t, testutil.ToFloat64(testsByPhase.WithLabelValues(namespace, string(locustv2.PhasePending))))
+	assert.Equal(t, 1.0, testutil.ToFloat64(testsByPhase.WithLabelValues(namespace, string(locustv2.PhaseRunning))))
+	assert.Equal(t, 1.0, testutil.ToFloat64(phaseTransitions.WithLabelValues(
+		namespace, string(locustv2.PhasePending), string(locustv2.PhaseRunning))))
+
+	tracker.forget(client.ObjectKeyFromObject(lt))
+	tracker.forget(client.ObjectKeyFromObject(lt))
+	assert.Zero(t, testutil.ToFloat64(testsByPhase.WithLabelValues(namespace, string(locustv2.PhaseRunning))))
+}
+
+func TestUpdateStatusFromJobs_ObservesTestDuration(t *testing.T) {
+	const namespace = "duration-metrics"
+	lt := newTestLocustTestCR("finished", namespace)
+	lt.Status.Phase = locustv2.PhaseRunning
+	startTime := metav1.NewTime(time.Now().Add(-10 * time.Minute))
+	lt.Status.StartTime = &startTime
+	reconciler, _ := newTestReconciler(lt)
+	for range 2 {
+		err := reconciler.updateStatusFromJobs(context.Background(), lt, completedJob(), nil, healthyPodStatus(), nil)
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, locustv2.PhaseSucceeded, lt.Status.Phase)
+	histogram := histogramSamples(t, testDuration, namespace, string(locustv2.PhaseSucceeded))
+	assert.Equal(t, uint64(1), histogram.GetSampleCount(), "only the transition is recorded")
+	assert.InDelta(t, 600, histogram.GetSampleSum(), 5)
+}
+
+func TestUpdateStatusFromJobs_ObservesWorkersConnected(t *testing.T) {
+	const namespace = "workers-metrics"
+	lt := newTestLocustTestCR("connecting", namespace)
+	lt.Status.Phase = locustv2.PhaseRunning
+	lt.Status.ExpectedWorkers = 3
+	startTime := metav1.NewTime(time.Now().Add(-30 * time.Second))
+	lt.Status.StartTime = &startTime
+	meta.SetStatusCondition(&lt.Status.Conditions, metav1.Condition{
+		Type:               locustv2.ConditionTypeWorkersConnected,
+		Status:             metav1.ConditionFalse,
+		Reason:             locustv2.ReasonWaitingForWorkers,
+		LastTransitionTime: metav1.NewTime(time.Now().Add(-time.Hour)),
+	})
+	reconciler, _ := newTestReconciler(lt)
+	workerJob := &batchv1.Job{Status: batchv1.JobStatus{Active: 3}}
+
+	for range 2 {
+		err := reconciler.updateStatusFromJobs(context.Background(), lt, runningJob(), workerJob, healthyPodStatus(), nil)
+		require.NoError(t, err)
+	}
+
+	histogram := histogramSamples(t, workersConnectedDuration, namespace)
+	assert.Equal(t, uint64(1), histogram.GetSampleCount(), "only the transition is recorded")
+	assert.InDelta(t, 30, histogram.GetSampleSum(), 5, "measured from the start of the test")
+}
+
+func TestCheckPodHealth_CountsFailureOnce(t *testing.T) {
+	const namespace = "pod-failure-metrics"
+	lt := newTestLocustTestCR("failing", namespace)
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "failing-master-abc",
+			Namespace:         namespace,
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-5 * time.Minute)),
+			Labels:            map[string]string{"performance-test-name": "failing"},
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name: "locust",
+				State: corev1.ContainerState{
+					Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff"},
+				},
+			}},
+		},
+	}
+	reconciler, _ := newTestReconciler(lt, pod)
+	counter := podFailures.WithLabelValues(namespace, locustv2.ReasonPodImagePullError)
+
+	status, _ := reconciler.checkPodHealth(context.Background(), lt)
+	require.Equal(t, locustv2.ReasonPodImagePullError, status.Reason)
+	assert.Equal(t, 1.0, testutil.ToFloat64(counter))
+
+	reconciler.setCondition(lt, locustv2.ConditionTypePodsHealthy, metav1.ConditionFalse, status.Reason, status.Message)
+	_, _ = reconciler.checkPodHealth(context.Background(), lt)
+	assert.Equal(t, 1.0, testutil.ToFloat64(counter), "a failure already reported isn't counted again")
+}
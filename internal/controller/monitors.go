@@ -0,0 +1,87 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/resources"
+)
+
+// createMonitors creates the ServiceMonitor and PodMonitor of
+// spec.observability.prometheus, and returns the MonitorsCreated condition to
+// set, or nil if no monitor is configured.
+//
+// Without the Prometheus Operator CRDs, or without the metrics exporter
+// sidecar to scrape, the monitors can't be created; the test still runs and
+// the condition and a Warning event explain why.
+func (r *LocustTestReconciler) createMonitors(ctx context.Context, lt *locustv2.LocustTest) (*metav1.Condition, error) {
+	log := logf.FromContext(ctx)
+
+	if !resources.HasPrometheusMonitors(lt) {
+		return nil, nil
+	}
+	if !resources.HasMetricsExporterSidecar(lt, r.Config) {
+		r.Recorder.Event(lt, corev1.EventTypeWarning, "MonitorsFailed",
+			"Cannot create Prometheus monitors: the master has no metrics exporter sidecar to scrape")
+		return &metav1.Condition{
+			Status:  metav1.ConditionFalse,
+			Reason:  locustv2.ReasonMetricsPortUnavailable,
+			Message: "The master has no metrics exporter sidecar to scrape",
+		}, nil
+	}
+
+	var created []string
+	for _, monitor := range []*unstructured.Unstructured{
+		resources.BuildServiceMonitor(lt, r.Config),
+		resources.BuildPodMonitor(lt, r.Config),
+	} {
+		if monitor == nil {
+			continue
+		}
+		kind := monitor.GetKind()
+		if err := r.createResource(ctx, lt, monitor, kind); err != nil {
+			if !meta.IsNoMatchError(err) {
+				return nil, err
+			}
+			message := fmt.Sprintf("Cannot create %s %s: the Prometheus Operator CRDs are not installed", kind, monitor.GetName())
+			r.Recorder.Event(lt, corev1.EventTypeWarning, "MonitorsFailed", message)
+			return &metav1.Condition{
+				Status:  metav1.ConditionFalse,
+				Reason:  locustv2.ReasonMonitoringCRDsMissing,
+				Message: message,
+			}, nil
+		}
+		log.V(1).Info("Prometheus monitor reconciled", "kind", kind, "name", monitor.GetName())
+		created = append(created, kind)
+	}
+
+	return &metav1.Condition{
+		Status:  metav1.ConditionTrue,
+		Reason:  locustv2.ReasonMonitorsCreated,
+		Message: "Created " + strings.Join(created, " and "),
+	}, nil
+}
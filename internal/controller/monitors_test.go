@@ -0,0 +1,142 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/config"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/resources"
+)
+
+func newTestMonitoredLocustTestCR() *locustv2.LocustTest {
+	lt := newTestLocustTestCR("monitored-test", "default")
+	lt.Spec.Observability = &locustv2.ObservabilityConfig{
+		Prometheus: &locustv2.PrometheusConfig{
+			ServiceMonitor: &locustv2.PrometheusMonitorConfig{Labels: map[string]string{"release": "prometheus"}},
+			PodMonitor:     &locustv2.PrometheusMonitorConfig{},
+		},
+	}
+	return lt
+}
+
+func reconcileMonitoredTest(t *testing.T, reconciler *LocustTestReconciler) *locustv2.LocustTest {
+	t.Helper()
+	key := types.NamespacedName{Name: "monitored-test", Namespace: "default"}
+	_, err := reconciler.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+
+	updated := &locustv2.LocustTest{}
+	require.NoError(t, reconciler.Get(context.Background(), key, updated))
+	return updated
+}
+
+func recordedEvents(recorder *record.FakeRecorder) []string {
+	var events []string
+	for len(recorder.Events) > 0 {
+		events = append(events, <-recorder.Events)
+	}
+	return events
+}
+
+func TestReconcile_WithPrometheusMonitors(t *testing.T) {
+	reconciler, _ := newTestReconciler(newTestMonitoredLocustTestCR())
+
+	updated := reconcileMonitoredTest(t, reconciler)
+
+	for _, gvk := range []schema.GroupVersionKind{resources.ServiceMonitorGVK, resources.PodMonitorGVK} {
+		monitor := &unstructured.Unstructured{}
+		monitor.SetGroupVersionKind(gvk)
+		require.NoError(t, reconciler.Get(context.Background(),
+			types.NamespacedName{Name: "monitored-test-master", Namespace: "default"}, monitor), gvk.Kind)
+		require.Len(t, monitor.GetOwnerReferences(), 1)
+		assert.Equal(t, "monitored-test", monitor.GetOwnerReferences()[0].Name)
+	}
+
+	cond := meta.FindStatusCondition(updated.Status.Conditions, locustv2.ConditionTypeMonitorsCreated)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+	assert.Equal(t, locustv2.ReasonMonitorsCreated, cond.Reason)
+	assert.Equal(t, "Created ServiceMonitor and PodMonitor", cond.Message)
+}
+
+func TestReconcile_WithoutPrometheusMonitors(t *testing.T) {
+	lt := newTestMonitoredLocustTestCR()
+	lt.Spec.Observability = nil
+	reconciler, _ := newTestReconciler(lt)
+
+	updated := reconcileMonitoredTest(t, reconciler)
+	assert.Nil(t, meta.FindStatusCondition(updated.Status.Conditions, locustv2.ConditionTypeMonitorsCreated))
+}
+
+func TestReconcile_WithPrometheusMonitors_NoPrometheusOperator(t *testing.T) {
+	lt := newTestMonitoredLocustTestCR()
+	reconciler, recorder := newTestReconciler()
+	// Reject monitors like a cluster without the Prometheus Operator CRDs
+	reconciler.Client = fake.NewClientBuilder().
+		WithScheme(reconciler.Scheme).
+		WithObjects(lt).
+		WithStatusSubresource(&locustv2.LocustTest{}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				if gvk := obj.GetObjectKind().GroupVersionKind(); gvk.Group == resources.ServiceMonitorGVK.Group {
+					return &meta.NoKindMatchError{GroupKind: gvk.GroupKind()}
+				}
+				return c.Create(ctx, obj, opts...)
+			},
+		}).
+		Build()
+
+	updated := reconcileMonitoredTest(t, reconciler)
+	assert.Equal(t, locustv2.PhaseRunning, updated.Status.Phase, "the test runs without its monitors")
+
+	cond := meta.FindStatusCondition(updated.Status.Conditions, locustv2.ConditionTypeMonitorsCreated)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, locustv2.ReasonMonitoringCRDsMissing, cond.Reason)
+	assert.Contains(t, recordedEvents(recorder),
+		"Warning MonitorsFailed Cannot create ServiceMonitor monitored-test-master: the Prometheus Operator CRDs are not installed")
+}
+
+func TestReconcile_WithPrometheusMonitors_BuiltinExporter(t *testing.T) {
+	reconciler, recorder := newTestReconciler(newTestMonitoredLocustTestCR())
+	reconciler.Config.MetricsExporterMode = config.MetricsExporterModeBuiltin
+
+	updated := reconcileMonitoredTest(t, reconciler)
+
+	cond := meta.FindStatusCondition(updated.Status.Conditions, locustv2.ConditionTypeMonitorsCreated)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, locustv2.ReasonMetricsPortUnavailable, cond.Reason)
+	assert.Contains(t, recordedEvents(recorder),
+		"Warning MonitorsFailed Cannot create Prometheus monitors: the master has no metrics exporter sidecar to scrape")
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/notify"
+)
+
+// notificationTimeout bounds each request to a webhook.
+const notificationTimeout = 10 * time.Second
+
+// notificationBackoff retries a failed delivery four times, 1 to 8 seconds
+// apart, to ride out a webhook restarting.
+var notificationBackoff = wait.Backoff{Steps: 5, Duration: time.Second, Factor: 2}
+
+// notificationEndpoint is a webhook to notify, with the URL read.
+type notificationEndpoint struct {
+	// url the event is POSTed to.
+	url string
+	// description names the endpoint in events without revealing its URL,
+	// which may embed a token.
+	description string
+}
+
+// notifyPhase sends the transition of lt from previous to its current phase
+// to the webhooks of spec.notifications, or to the operator's default
+// webhooks. Only Running and the terminal phases are sent. It must be called
+// once the new phase is persisted.
+//
+// Delivery happens in the background, retried with notificationBackoff, so
+// a webhook that is down doesn't hold up the reconcile; undelivered
+// notifications are reported as Warning events.
+func (r *LocustTestReconciler) notifyPhase(ctx context.Context, lt *locustv2.LocustTest, previous locustv2.Phase) {
+	phase := lt.Status.Phase
+	if phase == previous || (phase != locustv2.PhaseRunning && !phase.IsTerminal()) {
+		return
+	}
+
+	endpoints := r.notificationEndpoints(ctx, lt, phase)
+	if len(endpoints) == 0 {
+		return
+	}
+
+	// The reconcile may change lt and end before delivery does
+	lt = lt.DeepCopy()
+	event := notify.NewPhaseEvent(lt, previous, time.Now())
+	ctx = context.WithoutCancel(ctx)
+	for _, endpoint := range endpoints {
+		go r.deliverNotification(ctx, lt, endpoint, event)
+	}
+}
+
+// notificationEndpoints returns the webhooks to notify of phase. A webhook
+// whose URL Secret can't be read is skipped with a Warning event.
+func (r *LocustTestReconciler) notificationEndpoints(ctx context.Context, lt *locustv2.LocustTest, phase locustv2.Phase) []notificationEndpoint {
+	if lt.Spec.Notifications == nil {
+		endpoints := make([]notificationEndpoint, 0, len(r.Config.NotificationWebhookURLs))
+		for _, u := range r.Config.NotificationWebhookURLs {
+			endpoints = append(endpoints, notificationEndpoint{url: u, description: webhookHost(u)})
+		}
+		return endpoints
+	}
+
+	var endpoints []notificationEndpoint
+	for _, webhook := range lt.Spec.Notifications.Webhooks {
+		if len(webhook.Phases) > 0 && !slices.Contains(webhook.Phases, phase) {
+			continue
+		}
+		if webhook.URLSecretRef == nil {
+			endpoints = append(endpoints, notificationEndpoint{url: webhook.URL, description: webhookHost(webhook.URL)})
+			continue
+		}
+
+		ref := webhook.URLSecretRef
+		description := fmt.Sprintf("the URL in key %s of Secret %s", ref.Key, ref.Name)
+		secret := &corev1.Secret{}
+		if err := r.Get(ctx, client.ObjectKey{Namespace: lt.Namespace, Name: ref.Name}, secret); err != nil {
+			r.Recorder.Event(lt, corev1.EventTypeWarning, "NotificationFailed",
+				fmt.Sprintf("Cannot notify %s of phase %s: %v", description, phase, err))
+			continue
+		}
+		u := string(secret.Data[ref.Key])
+		if u == "" {
+			r.Recorder.Event(lt, corev1.EventTypeWarning, "NotificationFailed",
+				fmt.Sprintf("Cannot notify %s of phase %s: the key is missing or empty", description, phase))
+			continue
+		}
+		endpoints = append(endpoints, notificationEndpoint{url: u, description: description})
+	}
+	return endpoints
+}
+
+// deliverNotification sends event to endpoint, retrying until
+// notificationBackoff is exhausted.
+func (r *LocustTestReconciler) deliverNotification(ctx context.Context, lt *locustv2.LocustTest, endpoint notificationEndpoint, event notify.Event) {
+	log := logf.FromContext(ctx)
+
+	err := retry.OnError(notificationBackoff, func(error) bool { return true }, func() error {
+		return r.Notifier.Send(ctx, endpoint.url, event)
+	})
+	if err != nil {
+		log.Info("Failed to send notification", "endpoint", endpoint.description, "phase", string(event.Data.Phase), "error", err.Error())
+		r.Recorder.Event(lt, corev1.EventTypeWarning, "NotificationFailed",
+			fmt.Sprintf("Cannot notify %s of phase %s: %v", endpoint.description, event.Data.Phase, err))
+		return
+	}
+	log.V(1).Info("Notification sent", "endpoint", endpoint.description, "phase", string(event.Data.Phase))
+}
+
+// webhookHost describes a webhook by its host, leaving out the path and
+// query that may embed a token.
+func webhookHost(u string) string {
+	if parsed, err := url.Parse(u); err == nil && parsed.Host != "" {
+		return parsed.Host
+	}
+	return "a webhook"
+}
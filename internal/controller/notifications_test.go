@@ -0,0 +1,262 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/notify"
+)
+
+// sentNotification is an event received by fakeNotifier.
+type sentNotification struct {
+	url   string
+	event notify.Event
+}
+
+// fakeNotifier records the events it is sent, failing the first failures sends.
+type fakeNotifier struct {
+	mu       sync.Mutex
+	failures int
+	attempts int
+	sent     chan sentNotification
+}
+
+func newFakeNotifier(failures int) *fakeNotifier {
+	return &fakeNotifier{failures: failures, sent: make(chan sentNotification, 10)}
+}
+
+func (f *fakeNotifier) Send(_ context.Context, url string, event notify.Event) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.attempts++
+	if f.attempts <= f.failures {
+		return errors.New("connection refused")
+	}
+	f.sent <- sentNotification{url: url, event: event}
+	return nil
+}
+
+// receive waits for the next event delivered in the background.
+func (f *fakeNotifier) receive(t *testing.T) sentNotification {
+	t.Helper()
+	select {
+	case sent := <-f.sent:
+		return sent
+	case <-time.After(5 * time.Second):
+		t.Fatal("no notification sent")
+		return sentNotification{}
+	}
+}
+
+// fastNotificationBackoff makes retries immediate for the duration of the test.
+func fastNotificationBackoff(t *testing.T) {
+	previous := notificationBackoff
+	notificationBackoff = wait.Backoff{Steps: 3, Duration: time.Millisecond}
+	t.Cleanup(func() { notificationBackoff = previous })
+}
+
+func newTestNotifiedLocustTestCR() *locustv2.LocustTest {
+	lt := newTestLocustTestCR("notified-test", "default")
+	lt.Spec.Notifications = &locustv2.NotificationsConfig{
+		Webhooks: []locustv2.NotificationWebhook{{URL: "https://hooks.example.com/locust"}},
+	}
+	return lt
+}
+
+func TestUpdateStatusFromJobs_NotifiesPhaseTransition(t *testing.T) {
+	lt := newTestNotifiedLocustTestCR()
+	lt.Status.Phase = locustv2.PhaseRunning
+	reconciler, _ := newTestReconciler(lt)
+	notifier := newFakeNotifier(0)
+	reconciler.Notifier = notifier
+	workerJob := &batchv1.Job{Status: batchv1.JobStatus{Active: 3}}
+
+	err := reconciler.updateStatusFromJobs(context.Background(), lt, completedJob(), workerJob, healthyPodStatus(), nil)
+	require.NoError(t, err)
+
+	sent := notifier.receive(t)
+	assert.Equal(t, "https://hooks.example.com/locust", sent.url)
+	assert.Equal(t, "io.locust.locusttest.succeeded", sent.event.Type)
+	assert.Equal(t, locustv2.PhaseSucceeded, sent.event.Data.Phase)
+	assert.Equal(t, locustv2.PhaseRunning, sent.event.Data.PreviousPhase)
+	assert.Equal(t, locustv2.ReasonTestSucceeded, sent.event.Data.Reason)
+	assert.NotNil(t, sent.event.Data.CompletionTime)
+}
+
+func TestReconcile_NotifiesTestStarted(t *testing.T) {
+	reconciler, _ := newTestReconciler(newTestNotifiedLocustTestCR())
+	notifier := newFakeNotifier(0)
+	reconciler.Notifier = notifier
+
+	_, err := reconciler.Reconcile(context.Background(), ctrl.Request{
+		NamespacedName: types.NamespacedName{Name: "notified-test", Namespace: "default"},
+	})
+	require.NoError(t, err)
+
+	sent := notifier.receive(t)
+	assert.Equal(t, locustv2.PhaseRunning, sent.event.Data.Phase)
+	assert.NotNil(t, sent.event.Data.StartTime)
+}
+
+func TestNotifyPhase_RetriesThenReportsFailure(t *testing.T) {
+	fastNotificationBackoff(t)
+
+	t.Run("Recovers", func(t *testing.T) {
+		lt := newTestNotifiedLocustTestCR()
+		lt.Status.Phase = locustv2.PhaseFailed
+		reconciler, _ := newTestReconciler(lt)
+		notifier := newFakeNotifier(2)
+		reconciler.Notifier = notifier
+
+		reconciler.notifyPhase(context.Background(), lt, locustv2.PhaseRunning)
+
+		notifier.receive(t)
+		notifier.mu.Lock()
+		defer notifier.mu.Unlock()
+		assert.Equal(t, 3, notifier.attempts)
+	})
+
+	t.Run("GivesUp", func(t *testing.T) {
+		lt := newTestNotifiedLocustTestCR()
+		lt.Status.Phase = locustv2.PhaseFailed
+		reconciler, recorder := newTestReconciler(lt)
+		reconciler.Notifier = newFakeNotifier(3)
+
+		reconciler.notifyPhase(context.Background(), lt, locustv2.PhaseRunning)
+
+		select {
+		case event := <-recorder.Events:
+			assert.Equal(t, "Warning NotificationFailed Cannot notify hooks.example.com of phase Failed: connection refused", event)
+		case <-time.After(5 * time.Second):
+			t.Fatal("no event recorded")
+		}
+	})
+}
+
+func TestNotificationEndpoints(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "chat-webhook", Namespace: "default"},
+		Data:       map[string][]byte{"url": []byte("https://chat.example.com/hooks/token")},
+	}
+	secretRef := func(name string) *corev1.SecretKeySelector {
+		return &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: name}, Key: "url"}
+	}
+
+	tests := []struct {
+		name          string
+		notifications *locustv2.NotificationsConfig
+		defaults      []string
+		phase         locustv2.Phase
+		want          []notificationEndpoint
+		wantEvents    []string
+	}{
+		{
+			name:     "OperatorDefaults",
+			defaults: []string{"https://alerts.example.com/locust"},
+			phase:    locustv2.PhaseRunning,
+			want:     []notificationEndpoint{{url: "https://alerts.example.com/locust", description: "alerts.example.com"}},
+		},
+		{
+			name:          "SpecReplacesDefaults",
+			notifications: &locustv2.NotificationsConfig{},
+			defaults:      []string{"https://alerts.example.com/locust"},
+			phase:         locustv2.PhaseRunning,
+		},
+		{
+			name: "PhaseFilter",
+			notifications: &locustv2.NotificationsConfig{Webhooks: []locustv2.NotificationWebhook{
+				{URL: "https://hooks.example.com/failures", Phases: []locustv2.Phase{locustv2.PhaseFailed}},
+				{URL: "https://hooks.example.com/all"},
+			}},
+			phase: locustv2.PhaseSucceeded,
+			want:  []notificationEndpoint{{url: "https://hooks.example.com/all", description: "hooks.example.com"}},
+		},
+		{
+			name: "URLFromSecret",
+			notifications: &locustv2.NotificationsConfig{Webhooks: []locustv2.NotificationWebhook{
+				{URLSecretRef: secretRef("chat-webhook")},
+			}},
+			phase: locustv2.PhaseFailed,
+			want: []notificationEndpoint{{
+				url:         "https://chat.example.com/hooks/token",
+				description: "the URL in key url of Secret chat-webhook",
+			}},
+		},
+		{
+			name: "MissingSecret",
+			notifications: &locustv2.NotificationsConfig{Webhooks: []locustv2.NotificationWebhook{
+				{URLSecretRef: secretRef("missing")},
+			}},
+			phase: locustv2.PhaseFailed,
+			wantEvents: []string{
+				`Warning NotificationFailed Cannot notify the URL in key url of Secret missing of phase Failed: secrets "missing" not found`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lt := newTestLocustTestCR("notified-test", "default")
+			lt.Spec.Notifications = tt.notifications
+			reconciler, recorder := newTestReconciler(lt, secret)
+			reconciler.Config.NotificationWebhookURLs = tt.defaults
+
+			endpoints := reconciler.notificationEndpoints(context.Background(), lt, tt.phase)
+			if len(tt.want) == 0 {
+				assert.Empty(t, endpoints)
+			} else {
+				assert.Equal(t, tt.want, endpoints)
+			}
+			assert.Equal(t, tt.wantEvents, recordedEvents(recorder))
+		})
+	}
+}
+
+func TestNotifyPhase_SkipsUnnotifiedPhases(t *testing.T) {
+	for _, phase := range []locustv2.Phase{locustv2.PhasePending, locustv2.PhaseQueued, locustv2.PhaseScheduled} {
+		t.Run(string(phase), func(t *testing.T) {
+			lt := newTestNotifiedLocustTestCR()
+			lt.Status.Phase = phase
+			reconciler, _ := newTestReconciler(lt)
+			notifier := newFakeNotifier(0)
+			reconciler.Notifier = notifier
+
+			reconciler.notifyPhase(context.Background(), lt, "")
+
+			select {
+			case sent := <-notifier.sent:
+				t.Fatalf("unexpected notification of phase %s", sent.event.Data.Phase)
+			case <-time.After(100 * time.Millisecond):
+			}
+		})
+	}
+}
@@ -24,6 +24,8 @@ import (
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
@@ -126,6 +128,12 @@ func (r *LocustTestReconciler) checkPodHealth(ctx context.Context, lt *locustv2.
 	// Categorize and prioritize failures
 	failureType, message := buildFailureMessage(failedPods)
 
+	// Count each failure once, when the PodsHealthy condition is about to change to it
+	if cond := meta.FindStatusCondition(lt.Status.Conditions, locustv2.ConditionTypePodsHealthy); cond == nil ||
+		cond.Status != metav1.ConditionFalse || cond.Reason != failureType {
+		podFailures.WithLabelValues(lt.Namespace, failureType).Inc()
+	}
+
 	return PodHealthStatus{
 		Healthy:    false,
 		Reason:     failureType,
@@ -137,6 +145,12 @@ func (r *LocustTestReconciler) checkPodHealth(ctx context.Context, lt *locustv2.
 // analyzePodFailure examines a single pod and returns failure info if the pod is unhealthy.
 // Returns nil if the pod is healthy.
 func analyzePodFailure(pod *corev1.Pod, lt *locustv2.LocustTest) *PodFailureInfo {
+	// Pods being deleted, e.g. workers removed by scaling down, exit as they
+	// are told to
+	if !pod.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
 	// Check pod conditions for scheduling failures
 	for _, condition := range pod.Status.Conditions {
 		if condition.Type == corev1.PodScheduled && condition.Status == corev1.ConditionFalse {
@@ -192,6 +206,10 @@ func analyzeContainerStatus(podName string, status corev1.ContainerStatus, isIni
 		message := waiting.Message
 
 		switch {
+		case reason == reasonCreateContainerConfigError && waitingForGitCommit(status, lt):
+			// Workers wait for the commit the master clones
+			return nil
+
 		case reason == reasonCreateContainerConfigError:
 			// Extract ConfigMap name if this is a config error
 			enhancedMsg := extractConfigMapError(message, lt)
@@ -224,6 +242,9 @@ func analyzeContainerStatus(podName string, status corev1.ContainerStatus, isIni
 	if !isNativeSidecar && status.State.Terminated != nil {
 		terminated := status.State.Terminated
 		if terminated.ExitCode != 0 {
+			if isInitContainer && status.Name == resources.GitCloneContainerName && resources.HasGitSource(lt) {
+				return gitCloneFailure(podName, terminated, lt)
+			}
 			failureType := locustv2.ReasonPodInitError
 			if !isInitContainer {
 				failureType = locustv2.ReasonPodCrashLoop
@@ -239,6 +260,29 @@ func analyzeContainerStatus(podName string, status corev1.ContainerStatus, isIni
 	return nil
 }
 
+// gitCloneFailure reports a failed clone of testFiles.git. The termination
+// message holds the tail of git's output, which names the problem (unknown
+// revision, authentication failure, missing directory).
+func gitCloneFailure(podName string, terminated *corev1.ContainerStateTerminated, lt *locustv2.LocustTest) *PodFailureInfo {
+	msg := fmt.Sprintf("Failed to clone %s", lt.Spec.TestFiles.Git.URL)
+	if output := strings.TrimSpace(terminated.Message); output != "" {
+		msg += ": " + output
+	}
+	return &PodFailureInfo{
+		Name:         podName,
+		FailureType:  locustv2.ReasonPodGitCloneError,
+		ErrorMessage: msg,
+	}
+}
+
+// waitingForGitCommit reports whether a worker's git clone init container
+// waits for the git commit ConfigMap, which the operator creates once the
+// master's clone finished.
+func waitingForGitCommit(status corev1.ContainerStatus, lt *locustv2.LocustTest) bool {
+	return status.Name == resources.GitCloneContainerName && resources.HasGitCommitPin(lt) &&
+		strings.Contains(status.State.Waiting.Message, `"`+resources.GitCommitConfigMapName(lt.Name)+`"`)
+}
+
 // extractConfigMapError enhances ConfigMap error messages with the expected ConfigMap name from spec.
 func extractConfigMapError(errorMsg string, lt *locustv2.LocustTest) string {
 	// Try to extract ConfigMap name from error message
@@ -248,13 +292,9 @@ func extractConfigMapError(errorMsg string, lt *locustv2.LocustTest) string {
 	configMapRegex := regexp.MustCompile(`[Cc]onfig[Mm]ap\s+"([^"]+)"`)
 	matches := configMapRegex.FindStringSubmatch(errorMsg)
 
-	var expectedConfigMap string
-	if lt.Spec.TestFiles != nil {
-		if lt.Spec.TestFiles.ConfigMapRef != "" {
-			expectedConfigMap = lt.Spec.TestFiles.ConfigMapRef
-		} else if lt.Spec.TestFiles.LibConfigMapRef != "" {
-			expectedConfigMap = lt.Spec.TestFiles.LibConfigMapRef
-		}
+	expectedConfigMap := resources.TestFilesConfigMapName(lt)
+	if expectedConfigMap == "" && lt.Spec.TestFiles != nil {
+		expectedConfigMap = lt.Spec.TestFiles.LibConfigMapRef
 	}
 
 	if len(matches) > 1 {
@@ -292,6 +332,7 @@ func buildFailureMessage(failures []PodFailureInfo) (string, string) {
 		locustv2.ReasonPodConfigError,
 		locustv2.ReasonPodImagePullError,
 		locustv2.ReasonPodSchedulingError,
+		locustv2.ReasonPodGitCloneError,
 		locustv2.ReasonPodCrashLoop,
 		locustv2.ReasonPodInitError,
 	}
@@ -22,6 +22,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
@@ -220,6 +221,35 @@ func TestAnalyzePodFailure(t *testing.T) {
 	}
 }
 
+func TestAnalyzePodFailure_UserSidecarExempt(t *testing.T) {
+	lt := newTestLocustTestCR("sidecar-test", "default")
+	lt.Spec.Master.Sidecars = []locustv2.Container{{Container: corev1.Container{Name: "log-shipper", Image: "fluent/fluent-bit:3.1"}}}
+	job := resources.BuildMasterJob(lt, newTestOperatorConfig(), logr.Discard())
+
+	// The pod ended: Locust completed and the kubelet stopped the sidecar
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "sidecar-test-master-abcde"},
+		Spec:       job.Spec.Template.Spec,
+		Status: corev1.PodStatus{
+			InitContainerStatuses: []corev1.ContainerStatus{{
+				Name: "log-shipper",
+				State: corev1.ContainerState{
+					Terminated: &corev1.ContainerStateTerminated{ExitCode: 143, Reason: "Error"},
+				},
+			}},
+		},
+	}
+	assert.Nil(t, analyzePodFailure(pod, lt))
+
+	// A crash-looping sidecar is still a failure
+	pod.Status.InitContainerStatuses[0].State = corev1.ContainerState{
+		Waiting: &corev1.ContainerStateWaiting{Reason: reasonCrashLoopBackOff},
+	}
+	failure := analyzePodFailure(pod, lt)
+	require.NotNil(t, failure)
+	assert.Equal(t, locustv2.ReasonPodCrashLoop, failure.FailureType)
+}
+
 // --- analyzeContainerStatus tests ---
 
 func TestAnalyzeContainerStatus(t *testing.T) {
@@ -398,6 +428,38 @@ func TestAnalyzeContainerStatus(t *testing.T) {
 	}
 }
 
+func TestAnalyzeContainerStatus_GitCloneFailure(t *testing.T) {
+	lt := &locustv2.LocustTest{
+		Spec: locustv2.LocustTestSpec{
+			TestFiles: &locustv2.TestFilesConfig{
+				Git: &locustv2.GitSource{URL: "https://github.com/example/load-tests.git"},
+			},
+		},
+	}
+	status := corev1.ContainerStatus{
+		Name: resources.GitCloneContainerName,
+		State: corev1.ContainerState{
+			Terminated: &corev1.ContainerStateTerminated{
+				ExitCode: 128,
+				Reason:   "Error",
+				Message:  "fatal: couldn't find remote ref v9.9.9\n",
+			},
+		},
+	}
+
+	result := analyzeContainerStatus("test-pod", status, true, false, lt)
+	require.NotNil(t, result)
+	assert.Equal(t, locustv2.ReasonPodGitCloneError, result.FailureType)
+	assert.Equal(t, "Failed to clone https://github.com/example/load-tests.git: fatal: couldn't find remote ref v9.9.9",
+		result.ErrorMessage)
+
+	// Without testFiles.git, a container of that name is an ordinary init container
+	lt.Spec.TestFiles = nil
+	result = analyzeContainerStatus("test-pod", status, true, false, lt)
+	require.NotNil(t, result)
+	assert.Equal(t, locustv2.ReasonPodInitError, result.FailureType)
+}
+
 // --- extractConfigMapError tests ---
 
 func TestExtractConfigMapError(t *testing.T) {
@@ -461,6 +523,19 @@ func TestExtractConfigMapError(t *testing.T) {
 			},
 			expected: "some config error",
 		},
+		{
+			name:     "generated ConfigMap name used for inline test files",
+			errorMsg: `configmap "inline-test-test-files" not found`,
+			lt: &locustv2.LocustTest{
+				ObjectMeta: metav1.ObjectMeta{Name: "inline-test"},
+				Spec: locustv2.LocustTestSpec{
+					TestFiles: &locustv2.TestFilesConfig{
+						Inline: map[string]string{"locustfile.py": ""},
+					},
+				},
+			},
+			expected: `ConfigMap not found (expected: inline-test-test-files). configmap "inline-test-test-files" not found`,
+		},
 		{
 			name:     "LibConfigMapRef used when ConfigMapRef empty",
 			errorMsg: "some generic config error",
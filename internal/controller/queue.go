@@ -0,0 +1,337 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/resources"
+)
+
+// queueUsage is the capacity taken from a queue by one or more tests.
+type queueUsage struct {
+	tests      int32
+	workerPods int32
+	cpu        resource.Quantity
+	memory     resource.Quantity
+}
+
+func (u *queueUsage) add(other queueUsage) {
+	u.tests += other.tests
+	u.workerPods += other.workerPods
+	u.cpu.Add(other.cpu)
+	u.memory.Add(other.memory)
+}
+
+// exceeded describes the first limit of the queue the usage is over, or
+// returns "" if it is within all of them.
+func (u queueUsage) exceeded(spec *locustv2.LocustTestQueueSpec) string {
+	switch {
+	case spec.MaxConcurrentTests != nil && u.tests > *spec.MaxConcurrentTests:
+		return fmt.Sprintf("%d tests, more than maxConcurrentTests %d", u.tests, *spec.MaxConcurrentTests)
+	case spec.MaxWorkerPods != nil && u.workerPods > *spec.MaxWorkerPods:
+		return fmt.Sprintf("%d worker pods, more than maxWorkerPods %d", u.workerPods, *spec.MaxWorkerPods)
+	case spec.MaxCPU != nil && u.cpu.Cmp(*spec.MaxCPU) > 0:
+		return fmt.Sprintf("%s CPU, more than maxCPU %s", u.cpu.String(), spec.MaxCPU.String())
+	case spec.MaxMemory != nil && u.memory.Cmp(*spec.MaxMemory) > 0:
+		return fmt.Sprintf("%s memory, more than maxMemory %s", u.memory.String(), spec.MaxMemory.String())
+	default:
+		return ""
+	}
+}
+
+// queueDemand returns the capacity a test takes from its queue once admitted.
+func (r *LocustTestReconciler) queueDemand(lt *locustv2.LocustTest) queueUsage {
+	requested := resources.RequestedResources(lt, r.Config)
+	return queueUsage{
+		tests:      1,
+		workerPods: resources.ExpectedWorkers(lt),
+		cpu:        requested[corev1.ResourceCPU],
+		memory:     requested[corev1.ResourceMemory],
+	}
+}
+
+// admission is the outcome of checking a queued test against its queue.
+type admission struct {
+	// admitted is set when the test may create its resources.
+	admitted bool
+	// rejected is set when the test needs more than the queue's limits and
+	// can never be admitted.
+	rejected bool
+	// position is the test's position among the queued tests, from 1.
+	position int32
+	// message describes why the test waits or was rejected.
+	message string
+}
+
+// admit decides whether a queued test is admitted by its queue. Tests are
+// admitted one at a time in queue order: by priority, then creation time.
+// The first test waits until the tests already admitted leave enough
+// capacity for it, and holds back the tests behind it so large tests aren't
+// starved by smaller ones.
+func (r *LocustTestReconciler) admit(ctx context.Context, lt *locustv2.LocustTest) (admission, error) {
+	queue := lt.Spec.Queue
+	spec, err := r.getQueueSpec(ctx, lt)
+	if apierrors.IsNotFound(err) {
+		return admission{message: fmt.Sprintf("%s %s not found", queue.QueueKind(), queue.Name)}, nil
+	}
+	if err != nil {
+		return admission{}, err
+	}
+
+	members, err := r.listQueueMembers(ctx, queue.QueueKind(), queue.Name, lt.Namespace)
+	if err != nil {
+		return admission{}, err
+	}
+
+	// Admitted tests hold their capacity until they end
+	var usage queueUsage
+	queued := []*locustv2.LocustTest{lt}
+	for i := range members {
+		member := &members[i]
+		switch {
+		case member.UID == lt.UID:
+		case member.Status.Phase == locustv2.PhaseQueued || member.Status.Phase == "":
+			// Tests not reconciled yet are queued next
+			queued = append(queued, member)
+		case !member.Status.Phase.IsTerminal():
+			usage.add(r.queueDemand(member))
+		}
+	}
+	sortQueued(queued)
+
+	var position int32
+	for i, member := range queued {
+		if member.UID == lt.UID {
+			position = int32(i + 1)
+		}
+	}
+
+	demand := r.queueDemand(lt)
+	if exceeded := demand.exceeded(spec); exceeded != "" {
+		return admission{rejected: true, message: fmt.Sprintf(
+			"Test can never be admitted by %s %s: it needs %s", queue.QueueKind(), queue.Name, exceeded)}, nil
+	}
+	if position > 1 {
+		return admission{position: position, message: fmt.Sprintf(
+			"Waiting in %s %s at position %d", queue.QueueKind(), queue.Name, position)}, nil
+	}
+	usage.add(demand)
+	if exceeded := usage.exceeded(spec); exceeded != "" {
+		return admission{position: position, message: fmt.Sprintf(
+			"Waiting for capacity in %s %s: admitting the test would use %s", queue.QueueKind(), queue.Name, exceeded)}, nil
+	}
+	return admission{admitted: true}, nil
+}
+
+// sortQueued sorts queued tests in admission order: higher priority first,
+// then oldest first.
+func sortQueued(queued []*locustv2.LocustTest) {
+	sort.SliceStable(queued, func(i, j int) bool {
+		a, b := queued[i], queued[j]
+		if a.Spec.Queue.Priority != b.Spec.Queue.Priority {
+			return a.Spec.Queue.Priority > b.Spec.Queue.Priority
+		}
+		if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+			return a.CreationTimestamp.Before(&b.CreationTimestamp)
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+}
+
+// getQueueSpec fetches the spec of the queue a test references.
+func (r *LocustTestReconciler) getQueueSpec(ctx context.Context, lt *locustv2.LocustTest) (*locustv2.LocustTestQueueSpec, error) {
+	queue := lt.Spec.Queue
+	if queue.QueueKind() == locustv2.QueueKindCluster {
+		clusterQueue := &locustv2.ClusterLocustTestQueue{}
+		if err := r.Get(ctx, client.ObjectKey{Name: queue.Name}, clusterQueue); err != nil {
+			return nil, err
+		}
+		return &clusterQueue.Spec, nil
+	}
+
+	namespacedQueue := &locustv2.LocustTestQueue{}
+	if err := r.Get(ctx, client.ObjectKey{Name: queue.Name, Namespace: lt.Namespace}, namespacedQueue); err != nil {
+		return nil, err
+	}
+	return &namespacedQueue.Spec, nil
+}
+
+// listQueueMembers lists the tests referencing a queue. They are read from
+// the API server rather than the cache, so a test admitted by the previous
+// reconcile is always counted.
+func (r *LocustTestReconciler) listQueueMembers(
+	ctx context.Context,
+	kind locustv2.QueueKind,
+	name, namespace string,
+) ([]locustv2.LocustTest, error) {
+	reader := r.APIReader
+	if reader == nil {
+		reader = r.Client
+	}
+
+	var opts []client.ListOption
+	if kind == locustv2.QueueKindNamespaced {
+		opts = append(opts, client.InNamespace(namespace))
+	}
+	list := &locustv2.LocustTestList{}
+	if err := reader.List(ctx, list, opts...); err != nil {
+		return nil, fmt.Errorf("failed to list LocustTests of %s %s: %w", kind, name, err)
+	}
+
+	var members []locustv2.LocustTest
+	for _, item := range list.Items {
+		if item.Spec.Queue != nil && item.Spec.Queue.QueueKind() == kind && item.Spec.Queue.Name == name {
+			members = append(members, item)
+		}
+	}
+	return members, nil
+}
+
+// reconcileQueued handles a test waiting in spec.queue. Once admitted, the
+// test moves to Pending and its resources are created.
+func (r *LocustTestReconciler) reconcileQueued(ctx context.Context, lt *locustv2.LocustTest) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	// A test stopped or aborted while queued is never created
+	if lt.ControlAction() != "" {
+		return r.endTest(ctx, lt)
+	}
+
+	decision, err := r.admit(ctx, lt)
+	if err != nil {
+		log.Error(err, "Failed to check the queue", "queue", lt.Spec.Queue.Name)
+		return ctrl.Result{}, err
+	}
+
+	switch {
+	case decision.admitted:
+		r.Recorder.Event(lt, corev1.EventTypeNormal, "Admitted",
+			fmt.Sprintf("Admitted by %s %s", lt.Spec.Queue.QueueKind(), lt.Spec.Queue.Name))
+		log.Info("Test admitted by its queue", "locustTest", lt.Name, "queue", lt.Spec.Queue.Name)
+	case decision.rejected:
+		r.Recorder.Event(lt, corev1.EventTypeWarning, locustv2.ReasonQueueCapacityExceeded, decision.message)
+	case !isQueued(lt):
+		r.Recorder.Event(lt, corev1.EventTypeNormal, "Queued", decision.message)
+	}
+
+	var previous locustv2.Phase
+	if err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		if err := r.Get(ctx, client.ObjectKeyFromObject(lt), lt); err != nil {
+			return err
+		}
+		previous = lt.Status.Phase
+		switch {
+		case decision.admitted:
+			lt.Status.Phase = locustv2.PhasePending
+			lt.Status.QueuePosition = 0
+			r.setCondition(lt, locustv2.ConditionTypeTestCompleted,
+				metav1.ConditionFalse, locustv2.ReasonTestInProgress,
+				"Test has not started")
+		case decision.rejected:
+			now := metav1.Now()
+			lt.Status.Phase = locustv2.PhaseFailed
+			lt.Status.QueuePosition = 0
+			lt.Status.CompletionTime = &now
+			r.setCondition(lt, locustv2.ConditionTypeTestCompleted,
+				metav1.ConditionTrue, locustv2.ReasonQueueCapacityExceeded, decision.message)
+			r.setReady(lt, false, locustv2.ReasonResourcesFailed, "Test failed")
+		default:
+			lt.Status.QueuePosition = decision.position
+			r.setCondition(lt, locustv2.ConditionTypeTestCompleted,
+				metav1.ConditionFalse, locustv2.ReasonTestQueued, decision.message)
+		}
+		lt.Status.ObservedGeneration = lt.Generation
+		return r.Status().Update(ctx, lt)
+	}); err != nil {
+		log.Error(err, "Failed to update status of queued test")
+		return ctrl.Result{}, fmt.Errorf("failed to update status of queued test: %w", err)
+	}
+	r.notifyPhase(ctx, lt, previous)
+
+	if decision.admitted {
+		return r.createResources(ctx, lt)
+	}
+	return ctrl.Result{}, nil
+}
+
+// isQueued reports whether the status already reports the test as waiting
+// in its queue.
+func isQueued(lt *locustv2.LocustTest) bool {
+	cond := meta.FindStatusCondition(lt.Status.Conditions, locustv2.ConditionTypeTestCompleted)
+	return cond != nil && cond.Reason == locustv2.ReasonTestQueued
+}
+
+// mapToQueuedTests maps a LocustTest or queue event to the queued tests of
+// the queue, so they are checked again when a test of the queue changes
+// phase or the queue's limits change.
+func (r *LocustTestReconciler) mapToQueuedTests(ctx context.Context, obj client.Object) []reconcile.Request {
+	var kind locustv2.QueueKind
+	var name string
+	switch o := obj.(type) {
+	case *locustv2.LocustTest:
+		if o.Spec.Queue == nil {
+			return nil
+		}
+		kind, name = o.Spec.Queue.QueueKind(), o.Spec.Queue.Name
+	case *locustv2.LocustTestQueue:
+		kind, name = locustv2.QueueKindNamespaced, o.Name
+	case *locustv2.ClusterLocustTestQueue:
+		kind, name = locustv2.QueueKindCluster, o.Name
+	default:
+		return nil
+	}
+
+	var opts []client.ListOption
+	if kind == locustv2.QueueKindNamespaced {
+		opts = append(opts, client.InNamespace(obj.GetNamespace()))
+	}
+	list := &locustv2.LocustTestList{}
+	if err := r.List(ctx, list, opts...); err != nil {
+		logf.FromContext(ctx).V(1).Info("Failed to list queued LocustTests", "queue", name, "error", err.Error())
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, item := range list.Items {
+		if item.Status.Phase == locustv2.PhaseQueued && item.Spec.Queue != nil &&
+			item.Spec.Queue.QueueKind() == kind && item.Spec.Queue.Name == name {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: item.Name, Namespace: item.Namespace},
+			})
+		}
+	}
+	return requests
+}
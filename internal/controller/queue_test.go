@@ -0,0 +1,324 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+)
+
+var queueCreated = time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+
+// newQueuedTest creates a test in the "shared" LocustTestQueue, created
+// minutes after the other tests of the queue.
+func newQueuedTest(name string, minutes int) *locustv2.LocustTest {
+	lt := newTestLocustTestCR(name, "default")
+	lt.UID = types.UID(name + "-uid")
+	lt.CreationTimestamp = metav1.NewTime(queueCreated.Add(time.Duration(minutes) * time.Minute))
+	lt.Spec.Queue = &locustv2.QueueConfig{Name: "shared"}
+	return lt
+}
+
+// withPhase sets the phase of a test, as if it had been reconciled before.
+func withPhase(lt *locustv2.LocustTest, phase locustv2.Phase) *locustv2.LocustTest {
+	lt.Status.Phase = phase
+	return lt
+}
+
+func newTestQueue(spec locustv2.LocustTestQueueSpec) *locustv2.LocustTestQueue {
+	return &locustv2.LocustTestQueue{
+		ObjectMeta: metav1.ObjectMeta{Name: "shared", Namespace: "default"},
+		Spec:       spec,
+	}
+}
+
+func reconcileQueuedTest(t *testing.T, reconciler *LocustTestReconciler, key types.NamespacedName) *locustv2.LocustTest {
+	t.Helper()
+	_, err := reconciler.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+
+	lt := &locustv2.LocustTest{}
+	require.NoError(t, reconciler.Get(context.Background(), key, lt))
+	return lt
+}
+
+func queuedTestKey(name string) types.NamespacedName {
+	return types.NamespacedName{Name: name, Namespace: "default"}
+}
+
+// assertQueued checks a test waits in its queue at the given position,
+// without any Jobs.
+func assertQueued(t *testing.T, reconciler *LocustTestReconciler, lt *locustv2.LocustTest, position int32, message string) {
+	t.Helper()
+	assert.Equal(t, locustv2.PhaseQueued, lt.Status.Phase)
+	assert.Equal(t, position, lt.Status.QueuePosition)
+	cond := findCondition(lt.Status.Conditions, locustv2.ConditionTypeTestCompleted)
+	require.NotNil(t, cond)
+	assert.Equal(t, locustv2.ReasonTestQueued, cond.Reason)
+	assert.Equal(t, message, cond.Message)
+
+	err := reconciler.Get(context.Background(),
+		types.NamespacedName{Name: lt.Name + "-master", Namespace: lt.Namespace}, &batchv1.Job{})
+	assert.True(t, apierrors.IsNotFound(err), "a queued test has no Jobs")
+}
+
+func TestReconcile_QueuedTestAdmitted(t *testing.T) {
+	reconciler, recorder := newTestReconciler(
+		newTestQueue(locustv2.LocustTestQueueSpec{MaxConcurrentTests: ptr.To[int32](1)}),
+		newQueuedTest("first", 0),
+	)
+
+	lt := reconcileQueuedTest(t, reconciler, queuedTestKey("first"))
+	assert.Equal(t, locustv2.PhaseRunning, lt.Status.Phase)
+	assert.Zero(t, lt.Status.QueuePosition)
+	assert.NotNil(t, lt.Status.StartTime)
+	require.NoError(t, reconciler.Get(context.Background(), queuedTestKey("first-master"), &batchv1.Job{}))
+	assert.Equal(t, "Normal Admitted Admitted by LocustTestQueue shared", <-recorder.Events)
+}
+
+func TestReconcile_QueueAdmitsInOrder(t *testing.T) {
+	reconciler, recorder := newTestReconciler(
+		newTestQueue(locustv2.LocustTestQueueSpec{MaxConcurrentTests: ptr.To[int32](1)}),
+		withPhase(newQueuedTest("running", 0), locustv2.PhaseRunning),
+		newQueuedTest("second", 1),
+		newQueuedTest("third", 2),
+	)
+
+	// The newest test is reconciled first but waits behind the older one
+	lt := reconcileQueuedTest(t, reconciler, queuedTestKey("third"))
+	assertQueued(t, reconciler, lt, 2, "Waiting in LocustTestQueue shared at position 2")
+	assert.Equal(t, "Normal Queued Waiting in LocustTestQueue shared at position 2", <-recorder.Events)
+
+	lt = reconcileQueuedTest(t, reconciler, queuedTestKey("second"))
+	assertQueued(t, reconciler, lt, 1, "Waiting for capacity in LocustTestQueue shared: "+
+		"admitting the test would use 2 tests, more than maxConcurrentTests 1")
+	drainEvents(recorder)
+
+	// The position is kept without repeating the Queued event
+	reconcileQueuedTest(t, reconciler, queuedTestKey("second"))
+	assert.Empty(t, recorder.Events)
+
+	// The running test finishes and frees its capacity
+	running := &locustv2.LocustTest{}
+	require.NoError(t, reconciler.Get(context.Background(), queuedTestKey("running"), running))
+	running.Status.Phase = locustv2.PhaseSucceeded
+	require.NoError(t, reconciler.Status().Update(context.Background(), running))
+
+	lt = reconcileQueuedTest(t, reconciler, queuedTestKey("third"))
+	assertQueued(t, reconciler, lt, 2, "Waiting in LocustTestQueue shared at position 2")
+
+	lt = reconcileQueuedTest(t, reconciler, queuedTestKey("second"))
+	assert.Equal(t, locustv2.PhaseRunning, lt.Status.Phase)
+	drainEvents(recorder)
+
+	// The third test moves up but waits for the second one
+	lt = reconcileQueuedTest(t, reconciler, queuedTestKey("third"))
+	assertQueued(t, reconciler, lt, 1, "Waiting for capacity in LocustTestQueue shared: "+
+		"admitting the test would use 2 tests, more than maxConcurrentTests 1")
+}
+
+func TestReconcile_QueuePriority(t *testing.T) {
+	urgent := newQueuedTest("urgent", 5)
+	urgent.Spec.Queue.Priority = 10
+	reconciler, _ := newTestReconciler(
+		newTestQueue(locustv2.LocustTestQueueSpec{MaxConcurrentTests: ptr.To[int32](1)}),
+		withPhase(newQueuedTest("running", 0), locustv2.PhaseRunning),
+		withPhase(newQueuedTest("older", 1), locustv2.PhaseQueued),
+		urgent,
+	)
+
+	lt := reconcileQueuedTest(t, reconciler, queuedTestKey("urgent"))
+	assertQueued(t, reconciler, lt, 1, "Waiting for capacity in LocustTestQueue shared: "+
+		"admitting the test would use 2 tests, more than maxConcurrentTests 1")
+
+	lt = reconcileQueuedTest(t, reconciler, queuedTestKey("older"))
+	assertQueued(t, reconciler, lt, 2, "Waiting in LocustTestQueue shared at position 2")
+}
+
+func TestReconcile_QueueResourceLimits(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    locustv2.LocustTestQueueSpec
+		message string
+	}{
+		{
+			name: "WorkerPods",
+			spec: locustv2.LocustTestQueueSpec{MaxWorkerPods: ptr.To[int32](5)},
+			message: "Waiting for capacity in LocustTestQueue shared: " +
+				"admitting the test would use 6 worker pods, more than maxWorkerPods 5",
+		},
+		{
+			// Each test requests 1250m: the master with its metrics exporter
+			// and three workers at 250m
+			name: "CPU",
+			spec: locustv2.LocustTestQueueSpec{MaxCPU: ptr.To(resource.MustParse("2"))},
+			message: "Waiting for capacity in LocustTestQueue shared: " +
+				"admitting the test would use 2500m CPU, more than maxCPU 2",
+		},
+		{
+			name: "Memory",
+			spec: locustv2.LocustTestQueueSpec{MaxMemory: ptr.To(resource.MustParse("1Gi"))},
+			message: "Waiting for capacity in LocustTestQueue shared: " +
+				"admitting the test would use 1280Mi memory, more than maxMemory 1Gi",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reconciler, _ := newTestReconciler(
+				newTestQueue(tt.spec),
+				withPhase(newQueuedTest("running", 0), locustv2.PhaseRunning),
+				newQueuedTest("waiting", 1),
+			)
+
+			lt := reconcileQueuedTest(t, reconciler, queuedTestKey("waiting"))
+			assertQueued(t, reconciler, lt, 1, tt.message)
+		})
+	}
+}
+
+func TestReconcile_QueueCountsOnlyActiveTests(t *testing.T) {
+	reconciler, _ := newTestReconciler(
+		newTestQueue(locustv2.LocustTestQueueSpec{MaxConcurrentTests: ptr.To[int32](2)}),
+		withPhase(newQueuedTest("suspended", 0), locustv2.PhaseSuspended),
+		withPhase(newQueuedTest("failed", 1), locustv2.PhaseFailed),
+		withPhase(newQueuedTest("aborted", 2), locustv2.PhaseAborted),
+		newQueuedTest("next", 3),
+	)
+
+	lt := reconcileQueuedTest(t, reconciler, queuedTestKey("next"))
+	assert.Equal(t, locustv2.PhaseRunning, lt.Status.Phase, "only the suspended test holds capacity")
+}
+
+func TestReconcile_QueueCapacityExceeded(t *testing.T) {
+	reconciler, recorder := newTestReconciler(
+		newTestQueue(locustv2.LocustTestQueueSpec{MaxWorkerPods: ptr.To[int32](2)}),
+		newQueuedTest("too-big", 0),
+	)
+
+	lt := reconcileQueuedTest(t, reconciler, queuedTestKey("too-big"))
+	assert.Equal(t, locustv2.PhaseFailed, lt.Status.Phase)
+	assert.NotNil(t, lt.Status.CompletionTime)
+	cond := findCondition(lt.Status.Conditions, locustv2.ConditionTypeTestCompleted)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+	assert.Equal(t, locustv2.ReasonQueueCapacityExceeded, cond.Reason)
+	assert.Equal(t, "Test can never be admitted by LocustTestQueue shared: "+
+		"it needs 3 worker pods, more than maxWorkerPods 2", cond.Message)
+	assert.Contains(t, <-recorder.Events, "Warning QueueCapacityExceeded")
+}
+
+func TestReconcile_QueueNotFound(t *testing.T) {
+	reconciler, _ := newTestReconciler(newQueuedTest("orphan", 0))
+
+	lt := reconcileQueuedTest(t, reconciler, queuedTestKey("orphan"))
+	assertQueued(t, reconciler, lt, 0, "LocustTestQueue shared not found")
+}
+
+func TestReconcile_ClusterQueue(t *testing.T) {
+	clusterQueue := &locustv2.ClusterLocustTestQueue{
+		ObjectMeta: metav1.ObjectMeta{Name: "load-pool"},
+		Spec:       locustv2.LocustTestQueueSpec{MaxConcurrentTests: ptr.To[int32](1)},
+	}
+	teamA := withPhase(newQueuedTest("team-a-test", 0), locustv2.PhaseRunning)
+	teamA.Namespace = "team-a"
+	teamB := newQueuedTest("team-b-test", 1)
+	teamB.Namespace = "team-b"
+	for _, lt := range []*locustv2.LocustTest{teamA, teamB} {
+		lt.Spec.Queue = &locustv2.QueueConfig{Name: "load-pool", Kind: locustv2.QueueKindCluster}
+	}
+	reconciler, _ := newTestReconciler(clusterQueue, teamA, teamB)
+
+	lt := reconcileQueuedTest(t, reconciler, types.NamespacedName{Name: "team-b-test", Namespace: "team-b"})
+	assert.Equal(t, locustv2.PhaseQueued, lt.Status.Phase)
+	assert.Equal(t, int32(1), lt.Status.QueuePosition)
+	cond := findCondition(lt.Status.Conditions, locustv2.ConditionTypeTestCompleted)
+	require.NotNil(t, cond)
+	assert.Equal(t, "Waiting for capacity in ClusterLocustTestQueue load-pool: "+
+		"admitting the test would use 2 tests, more than maxConcurrentTests 1", cond.Message)
+}
+
+func TestReconcile_NamespacedQueueIgnoresOtherNamespaces(t *testing.T) {
+	other := withPhase(newQueuedTest("other", 0), locustv2.PhaseRunning)
+	other.Namespace = "team-b"
+	reconciler, _ := newTestReconciler(
+		newTestQueue(locustv2.LocustTestQueueSpec{MaxConcurrentTests: ptr.To[int32](1)}),
+		other,
+		newQueuedTest("mine", 1),
+	)
+
+	lt := reconcileQueuedTest(t, reconciler, queuedTestKey("mine"))
+	assert.Equal(t, locustv2.PhaseRunning, lt.Status.Phase)
+}
+
+func TestReconcile_StopQueuedTest(t *testing.T) {
+	reconciler, _ := newTestReconciler(
+		newTestQueue(locustv2.LocustTestQueueSpec{MaxConcurrentTests: ptr.To[int32](1)}),
+		withPhase(newQueuedTest("running", 0), locustv2.PhaseRunning),
+		newQueuedTest("waiting", 1),
+	)
+
+	lt := reconcileQueuedTest(t, reconciler, queuedTestKey("waiting"))
+	require.Equal(t, locustv2.PhaseQueued, lt.Status.Phase)
+
+	lt.Annotations = map[string]string{locustv2.AnnotationControl: string(locustv2.ControlStop)}
+	require.NoError(t, reconciler.Update(context.Background(), lt))
+	lt = reconcileQueuedTest(t, reconciler, queuedTestKey("waiting"))
+	assert.Equal(t, locustv2.PhaseCancelled, lt.Status.Phase)
+	assert.Zero(t, lt.Status.QueuePosition)
+	assert.Nil(t, lt.Status.StartTime)
+}
+
+func TestMapToQueuedTests(t *testing.T) {
+	otherQueue := newQueuedTest("other-queue", 3)
+	otherQueue.Spec.Queue.Name = "other"
+	reconciler, _ := newTestReconciler(
+		withPhase(newQueuedTest("running", 0), locustv2.PhaseRunning),
+		withPhase(newQueuedTest("queued", 1), locustv2.PhaseQueued),
+		withPhase(newQueuedTest("also-queued", 2), locustv2.PhaseQueued),
+		withPhase(otherQueue, locustv2.PhaseQueued),
+		withPhase(newTestLocustTestCR("no-queue", "default"), locustv2.PhaseRunning),
+	)
+	want := []reconcile.Request{
+		{NamespacedName: queuedTestKey("also-queued")},
+		{NamespacedName: queuedTestKey("queued")},
+	}
+
+	running := &locustv2.LocustTest{}
+	require.NoError(t, reconciler.Get(context.Background(), queuedTestKey("running"), running))
+	assert.ElementsMatch(t, want, reconciler.mapToQueuedTests(context.Background(), running))
+
+	queue := newTestQueue(locustv2.LocustTestQueueSpec{})
+	assert.ElementsMatch(t, want, reconciler.mapToQueuedTests(context.Background(), queue))
+
+	noQueue := newTestLocustTestCR("no-queue", "default")
+	assert.Empty(t, reconciler.mapToQueuedTests(context.Background(), noQueue))
+}
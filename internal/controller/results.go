@@ -0,0 +1,352 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/resources"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/results"
+)
+
+const (
+	// resultsFetchTimeout bounds each request to the results collector.
+	resultsFetchTimeout = 10 * time.Second
+	// resultsRetryInterval is how soon collection is retried while the collector still runs.
+	resultsRetryInterval = 2 * time.Second
+	// resultsSummaryLines is how much of the master's log is kept as the summary.
+	// With --only-summary the log ends with the final statistics table.
+	resultsSummaryLines = 200
+	// maxResultsConfigMapBytes is the most data the API server accepts in a ConfigMap.
+	maxResultsConfigMapBytes = 1024 * 1024
+	// maxResultsUploadBytes bounds each file uploaded to S3, which is held in memory.
+	maxResultsUploadBytes = 64 * 1024 * 1024
+	// resultsUploadTimeout bounds each upload to S3.
+	resultsUploadTimeout = time.Minute
+	// resultsTimestampFormat renders {timestamp} in the S3 key prefix.
+	resultsTimestampFormat = "20060102T150405Z"
+	// defaultS3Region signs requests when spec.results.s3.region is unset.
+	defaultS3Region = "us-east-1"
+)
+
+// Keys of the S3 credentials Secret, named like the AWS environment variables
+// so the same Secret can be used with envFrom.
+const (
+	s3AccessKeyIDKey     = "AWS_ACCESS_KEY_ID"
+	s3SecretAccessKeyKey = "AWS_SECRET_ACCESS_KEY"
+	s3SessionTokenKey    = "AWS_SESSION_TOKEN"
+)
+
+// resultsUploadBackoff retries failed uploads a few times before giving up.
+var resultsUploadBackoff = wait.Backoff{Steps: 3, Duration: 500 * time.Millisecond, Factor: 2}
+
+// collectResults stores the master's results once Locust exited, while the
+// results collector still serves the files. The summary, and the result files
+// with ConfigMap storage, go into the results ConfigMap, and everything is
+// uploaded to spec.results.s3 when set; status.results is set in memory.
+// Returns how soon to retry when the collector couldn't be read, or 0.
+func (r *LocustTestReconciler) collectResults(ctx context.Context, lt *locustv2.LocustTest) time.Duration {
+	log := logf.FromContext(ctx)
+
+	if !resources.HasResults(lt) || lt.Status.Results != nil {
+		return 0
+	}
+
+	pod, err := r.finishedMasterPod(ctx, lt)
+	if err != nil {
+		log.Error(err, "Failed to list master pods for results collection")
+		return resultsRetryInterval
+	}
+	if pod == nil {
+		return 0
+	}
+
+	container := resources.NodeName(lt.Name, resources.Master)
+	data := map[string]string{}
+	var size int
+	var problems []string
+
+	summary, err := r.LogReader.TailLog(ctx, pod.Namespace, pod.Name, container, resultsSummaryLines)
+	if err != nil {
+		log.Info("Failed to read the master's summary", "error", err.Error())
+		problems = append(problems, "summary unavailable: "+err.Error())
+	} else {
+		data[resources.ResultsSummaryFileName] = summary
+		size += len(resources.ResultsSummaryFileName) + len(summary)
+	}
+
+	claim := resources.HasResultsClaim(lt)
+	s3 := lt.Spec.Results.S3
+	var files []string
+	if claim {
+		files = resources.ResultFileNames(lt)
+	}
+
+	var collectorURL string
+	if resources.HasResultsCollector(lt) && resultsCollectorRunning(pod) && pod.Status.PodIP != "" {
+		collectorURL = resources.ResultsCollectorURL(pod.Status.PodIP)
+	}
+
+	// Fetch the files from the collector to store them in the ConfigMap or upload them
+	var fetched []resultFile
+	switch {
+	case claim && s3 == nil:
+		// The files stay on the claim; the collector only serves the final statistics
+	case collectorURL == "":
+		problems = append(problems, "result files unavailable: the results collector had stopped")
+	default:
+		for _, name := range resources.ResultFileNames(lt) {
+			limit := int64(maxResultsConfigMapBytes - size - len(name))
+			if s3 != nil {
+				limit = maxResultsUploadBytes
+			}
+			content, err := r.ResultsFetcher.File(ctx, collectorURL, name, limit)
+			switch {
+			case errors.Is(err, results.ErrNotFound):
+				problems = append(problems, name+" was not written")
+				continue
+			case errors.Is(err, results.ErrTooLarge) && s3 != nil:
+				problems = append(problems, name+" exceeds the upload size limit")
+				continue
+			case errors.Is(err, results.ErrTooLarge):
+				problems = append(problems, name+" exceeds the ConfigMap size limit; use PersistentVolumeClaim storage")
+				continue
+			case err != nil:
+				log.V(1).Info("Results collector unavailable, retrying", "error", err.Error())
+				return resultsRetryInterval
+			}
+
+			fetched = append(fetched, resultFile{name: name, content: content})
+			if claim {
+				continue
+			}
+			if size+len(name)+len(content) > maxResultsConfigMapBytes {
+				problems = append(problems, name+" exceeds the ConfigMap size limit; use PersistentVolumeClaim storage")
+				continue
+			}
+			data[name] = string(content)
+			size += len(name) + len(content)
+			files = append(files, name)
+		}
+	}
+
+	configMap := resources.BuildResultsConfigMap(lt, data)
+	if err := r.createResource(ctx, lt, configMap, "ConfigMap"); err != nil {
+		return resultsRetryInterval
+	}
+
+	var urls []string
+	if s3 != nil {
+		uploads := fetched
+		if summary, ok := data[resources.ResultsSummaryFileName]; ok {
+			uploads = append(uploads, resultFile{name: resources.ResultsSummaryFileName, content: []byte(summary)})
+		}
+		urls = r.exportResults(ctx, lt, uploads)
+	}
+
+	// Judge the thresholds against the final statistics, then let the collector
+	// exit now rather than at the end of its wait
+	if collectorURL != "" {
+		r.checkFinalThresholds(ctx, lt, collectorURL, fetched)
+		if err := r.ResultsFetcher.Done(ctx, collectorURL); err != nil {
+			log.V(1).Info("Failed to release the results collector", "error", err.Error())
+		}
+	}
+
+	now := metav1.Now()
+	lt.Status.Results = &locustv2.ResultsStatus{
+		ConfigMap:      configMap.Name,
+		Files:          files,
+		URLs:           urls,
+		CollectionTime: &now,
+		Message:        strings.Join(problems, "; "),
+	}
+	if resources.HasResultsClaim(lt) {
+		lt.Status.Results.PersistentVolumeClaim = resources.ResultsName(lt.Name)
+	}
+
+	if len(problems) > 0 {
+		r.Recorder.Event(lt, corev1.EventTypeWarning, "ResultsIncomplete",
+			fmt.Sprintf("Collected results into ConfigMap %s: %s", configMap.Name, lt.Status.Results.Message))
+	} else {
+		r.Recorder.Event(lt, corev1.EventTypeNormal, "ResultsCollected",
+			fmt.Sprintf("Collected results into ConfigMap %s", configMap.Name))
+	}
+
+	return 0
+}
+
+// resultFile is a result file read from the results collector.
+type resultFile struct {
+	name    string
+	content []byte
+}
+
+// exportResults uploads the result files to spec.results.s3 and records the
+// outcome in the ResultsExported condition; the phase is left unchanged.
+// Returns the URLs of the uploaded objects.
+func (r *LocustTestReconciler) exportResults(ctx context.Context, lt *locustv2.LocustTest, files []resultFile) []string {
+	s3 := lt.Spec.Results.S3
+
+	target, err := r.resultsS3Target(ctx, lt)
+	if err != nil {
+		r.setResultsExportFailed(lt, err.Error())
+		return nil
+	}
+
+	prefix := resultsObjectPrefix(lt)
+	urls := make([]string, 0, len(files))
+	for _, f := range files {
+		var url string
+		err := retry.OnError(resultsUploadBackoff, func(error) bool { return true }, func() error {
+			var err error
+			url, err = r.ResultsUploader.Upload(ctx, target, path.Join(prefix, f.name), f.content, resultsContentType(f.name))
+			return err
+		})
+		if err != nil {
+			r.setResultsExportFailed(lt, err.Error())
+			return urls
+		}
+		urls = append(urls, url)
+	}
+
+	msg := fmt.Sprintf("Uploaded %d objects to s3://%s/%s", len(urls), s3.Bucket, prefix)
+	r.setCondition(lt, locustv2.ConditionTypeResultsExported,
+		metav1.ConditionTrue, locustv2.ReasonResultsExported, msg)
+	r.Recorder.Event(lt, corev1.EventTypeNormal, locustv2.ReasonResultsExported, msg)
+	return urls
+}
+
+// setResultsExportFailed records a failed upload.
+func (r *LocustTestReconciler) setResultsExportFailed(lt *locustv2.LocustTest, reason string) {
+	msg := "Failed to upload results: " + reason
+	r.setCondition(lt, locustv2.ConditionTypeResultsExported,
+		metav1.ConditionFalse, locustv2.ReasonResultsExportFailed, msg)
+	r.Recorder.Event(lt, corev1.EventTypeWarning, locustv2.ReasonResultsExportFailed, msg)
+}
+
+// resultsS3Target reads the S3 credentials of spec.results.s3 from their Secret.
+func (r *LocustTestReconciler) resultsS3Target(ctx context.Context, lt *locustv2.LocustTest) (results.S3Target, error) {
+	s3 := lt.Spec.Results.S3
+
+	secret := &corev1.Secret{}
+	key := client.ObjectKey{Namespace: lt.Namespace, Name: s3.CredentialsSecretRef.Name}
+	if err := r.Get(ctx, key, secret); err != nil {
+		return results.S3Target{}, fmt.Errorf("failed to read credentials Secret %s: %w", key.Name, err)
+	}
+	accessKeyID := string(secret.Data[s3AccessKeyIDKey])
+	secretAccessKey := string(secret.Data[s3SecretAccessKeyKey])
+	if accessKeyID == "" || secretAccessKey == "" {
+		return results.S3Target{}, fmt.Errorf("credentials Secret %s must set %s and %s",
+			key.Name, s3AccessKeyIDKey, s3SecretAccessKeyKey)
+	}
+
+	region := s3.Region
+	if region == "" {
+		region = defaultS3Region
+	}
+
+	return results.S3Target{
+		Endpoint:        s3.Endpoint,
+		Region:          region,
+		Bucket:          s3.Bucket,
+		ForcePathStyle:  s3.ForcePathStyle,
+		AccessKeyID:     accessKeyID,
+		SecretAccessKey: secretAccessKey,
+		SessionToken:    string(secret.Data[s3SessionTokenKey]),
+	}, nil
+}
+
+// resultsObjectPrefix renders the key prefix of spec.results.s3. The run
+// timestamp is the test's start time, so all objects of a run share a prefix.
+func resultsObjectPrefix(lt *locustv2.LocustTest) string {
+	prefix := lt.Spec.Results.S3.Prefix
+	if prefix == "" {
+		prefix = locustv2.DefaultResultsS3Prefix
+	}
+
+	runTime := time.Now()
+	if lt.Status.StartTime != nil {
+		runTime = lt.Status.StartTime.Time
+	}
+
+	return strings.Trim(strings.NewReplacer(
+		"{namespace}", lt.Namespace,
+		"{name}", lt.Name,
+		"{timestamp}", runTime.UTC().Format(resultsTimestampFormat),
+	).Replace(prefix), "/")
+}
+
+// resultsContentType returns the Content-Type of a result file.
+func resultsContentType(name string) string {
+	switch path.Ext(name) {
+	case ".csv":
+		return "text/csv"
+	case ".html":
+		return "text/html"
+	case ".json":
+		return "application/json"
+	default:
+		return "text/plain"
+	}
+}
+
+// finishedMasterPod returns the master pod whose Locust container exited,
+// or nil while Locust still runs.
+func (r *LocustTestReconciler) finishedMasterPod(ctx context.Context, lt *locustv2.LocustTest) (*corev1.Pod, error) {
+	masterName := resources.NodeName(lt.Name, resources.Master)
+
+	podList := &corev1.PodList{}
+	if err := r.List(ctx, podList,
+		client.InNamespace(lt.Namespace),
+		client.MatchingLabels{resources.LabelPodName: masterName},
+	); err != nil {
+		return nil, err
+	}
+
+	for i := range podList.Items {
+		for _, cs := range podList.Items[i].Status.ContainerStatuses {
+			if cs.Name == masterName && cs.State.Terminated != nil {
+				return &podList.Items[i], nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// resultsCollectorRunning reports whether the pod's results collector still serves the files.
+func resultsCollectorRunning(pod *corev1.Pod) bool {
+	for _, cs := range pod.Status.InitContainerStatuses {
+		if cs.Name == resources.ResultsCollectorContainerName {
+			return cs.State.Running != nil
+		}
+	}
+	return false
+}
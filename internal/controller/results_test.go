@@ -0,0 +1,541 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/results"
+)
+
+// fakeResultsFetcher serves result files from memory.
+type fakeResultsFetcher struct {
+	files   map[string]string
+	err     error
+	baseURL string
+	done    bool
+}
+
+func (f *fakeResultsFetcher) File(_ context.Context, baseURL, name string, limit int64) ([]byte, error) {
+	f.baseURL = baseURL
+	if f.err != nil {
+		return nil, f.err
+	}
+	content, ok := f.files[name]
+	if !ok {
+		return nil, results.ErrNotFound
+	}
+	if int64(len(content)) > limit {
+		return nil, results.ErrTooLarge
+	}
+	return []byte(content), nil
+}
+
+func (f *fakeResultsFetcher) Done(_ context.Context, _ string) error {
+	f.done = true
+	return nil
+}
+
+// fakeLogReader returns a fixed log.
+type fakeLogReader struct {
+	log string
+	err error
+}
+
+func (r *fakeLogReader) TailLog(_ context.Context, _, _, _ string, _ int64) (string, error) {
+	return r.log, r.err
+}
+
+const testSummary = `Type     Name  # reqs      # fails |    Avg     Min     Max    Med |   req/s  failures/s
+--------|-----|-------|-------------|-------|-------|-------|-------|--------|-----------
+GET      /        900     9(1.00%) |     51      12     812     42 |   90.50        0.90
+`
+
+func newTestResultsLocustTest(storage locustv2.ResultsStorage) *locustv2.LocustTest {
+	lt := newTestLocustTestCR("results-test", "default")
+	lt.Status.Phase = locustv2.PhaseRunning
+	lt.Spec.Results = &locustv2.ResultsConfig{
+		Formats: []locustv2.ResultsFormat{locustv2.ResultsFormatCSV, locustv2.ResultsFormatHTML},
+		Storage: storage,
+	}
+	return lt
+}
+
+// newTestMasterPod returns the master pod of results-test.
+func newTestMasterPod(locustExited, collectorRunning bool) *corev1.Pod {
+	locustState := corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}
+	if locustExited {
+		locustState = corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 0}}
+	}
+	collectorState := corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 0}}
+	if collectorRunning {
+		collectorState = corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}
+	}
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "results-test-master-abcde",
+			Namespace: "default",
+			Labels: map[string]string{
+				"performance-test-name":     "results-test",
+				"performance-test-pod-name": "results-test-master",
+			},
+		},
+		Status: corev1.PodStatus{
+			PodIP: "10.0.0.7",
+			InitContainerStatuses: []corev1.ContainerStatus{
+				{Name: "locust-results-collector", State: collectorState},
+			},
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "results-test-master", State: locustState},
+			},
+		},
+	}
+}
+
+func newTestResultFiles() map[string]string {
+	return map[string]string{
+		"locust_stats.csv":         "Type,Name,Request Count\nGET,/,900\n",
+		"locust_failures.csv":      "Method,Name,Error,Occurrences\n",
+		"locust_exceptions.csv":    "Count,Message,Traceback,Nodes\n",
+		"locust_stats_history.csv": "Timestamp,User Count\n1760616000,10\n",
+		"report.html":              "<html></html>",
+	}
+}
+
+func TestCollectResults_ConfigMap(t *testing.T) {
+	lt := newTestResultsLocustTest(locustv2.ResultsStorageConfigMap)
+	reconciler, recorder := newTestReconciler(lt, newTestMasterPod(true, true))
+	fetcher := &fakeResultsFetcher{files: newTestResultFiles()}
+	reconciler.ResultsFetcher = fetcher
+	reconciler.LogReader = &fakeLogReader{log: testSummary}
+	ctx := context.Background()
+
+	assert.Zero(t, reconciler.collectResults(ctx, lt))
+	assert.Equal(t, "http://10.0.0.7:8090", fetcher.baseURL)
+	assert.True(t, fetcher.done, "the collector is released once the files are stored")
+
+	cm := &corev1.ConfigMap{}
+	require.NoError(t, reconciler.Get(ctx, types.NamespacedName{Name: "results-test-results", Namespace: "default"}, cm))
+	require.Len(t, cm.OwnerReferences, 1)
+	assert.Equal(t, "results-test", cm.OwnerReferences[0].Name)
+	assert.Equal(t, testSummary, cm.Data["summary.txt"])
+	assert.Equal(t, "<html></html>", cm.Data["report.html"])
+	assert.Len(t, cm.Data, 6)
+
+	require.NotNil(t, lt.Status.Results)
+	assert.Equal(t, "results-test-results", lt.Status.Results.ConfigMap)
+	assert.Empty(t, lt.Status.Results.PersistentVolumeClaim)
+	assert.Equal(t, []string{
+		"locust_stats.csv", "locust_failures.csv", "locust_exceptions.csv", "locust_stats_history.csv", "report.html",
+	}, lt.Status.Results.Files)
+	assert.NotNil(t, lt.Status.Results.CollectionTime)
+	assert.Empty(t, lt.Status.Results.Message)
+
+	events := []string{<-recorder.Events, <-recorder.Events}
+	assert.Equal(t, "Normal Created Created ConfigMap results-test-results", events[0])
+	assert.Equal(t, "Normal ResultsCollected Collected results into ConfigMap results-test-results", events[1])
+}
+
+func TestCollectResults_JudgesThresholdsOnFinalStats(t *testing.T) {
+	lt := newTestResultsLocustTest(locustv2.ResultsStorageConfigMap)
+	lt.Spec.Thresholds = &locustv2.ThresholdsConfig{
+		ThresholdLimits: locustv2.ThresholdLimits{MaxFailureRatio: ptr.To(resource.MustParse("0.01"))},
+	}
+	reconciler, _ := newTestReconciler(lt, newTestMasterPod(true, true))
+	files := newTestResultFiles()
+	files["locust_stats.csv"] = testFinalStatsCSV
+	reconciler.ResultsFetcher = &fakeResultsFetcher{files: files}
+	reconciler.LogReader = &fakeLogReader{log: testSummary}
+	reconciler.setCondition(lt, locustv2.ConditionTypeThresholdsMet,
+		metav1.ConditionTrue, locustv2.ReasonThresholdsMet, "All thresholds met")
+
+	assert.Zero(t, reconciler.collectResults(context.Background(), lt))
+
+	cond := findCondition(lt.Status.Conditions, locustv2.ConditionTypeThresholdsMet)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status, "requests after the last poll breached the thresholds")
+	assert.Equal(t, "Thresholds breached: failure ratio 0.049 > 0.01 (final statistics)", cond.Message)
+}
+
+func TestCollectResults_SkipsFilesThatDontFit(t *testing.T) {
+	lt := newTestResultsLocustTest(locustv2.ResultsStorageConfigMap)
+	reconciler, recorder := newTestReconciler(lt, newTestMasterPod(true, true))
+	files := newTestResultFiles()
+	files["report.html"] = strings.Repeat("x", maxResultsConfigMapBytes)
+	delete(files, "locust_exceptions.csv")
+	reconciler.ResultsFetcher = &fakeResultsFetcher{files: files}
+	reconciler.LogReader = &fakeLogReader{log: testSummary}
+
+	assert.Zero(t, reconciler.collectResults(context.Background(), lt))
+
+	require.NotNil(t, lt.Status.Results)
+	assert.NotContains(t, lt.Status.Results.Files, "report.html")
+	assert.Equal(t, "locust_exceptions.csv was not written; "+
+		"report.html exceeds the ConfigMap size limit; use PersistentVolumeClaim storage",
+		lt.Status.Results.Message)
+	<-recorder.Events // Created
+	assert.Contains(t, <-recorder.Events, "Warning ResultsIncomplete")
+}
+
+func TestCollectResults_WaitsForLocustToExit(t *testing.T) {
+	lt := newTestResultsLocustTest(locustv2.ResultsStorageConfigMap)
+	reconciler, _ := newTestReconciler(lt, newTestMasterPod(false, true))
+	fetcher := &fakeResultsFetcher{files: newTestResultFiles()}
+	reconciler.ResultsFetcher = fetcher
+	reconciler.LogReader = &fakeLogReader{log: testSummary}
+	ctx := context.Background()
+
+	assert.Zero(t, reconciler.collectResults(ctx, lt))
+	assert.Nil(t, lt.Status.Results)
+	assert.Empty(t, fetcher.baseURL)
+
+	err := reconciler.Get(ctx, types.NamespacedName{Name: "results-test-results", Namespace: "default"}, &corev1.ConfigMap{})
+	assert.True(t, apierrors.IsNotFound(err))
+}
+
+func TestCollectResults_RetriesWhileCollectorRuns(t *testing.T) {
+	lt := newTestResultsLocustTest(locustv2.ResultsStorageConfigMap)
+	reconciler, _ := newTestReconciler(lt, newTestMasterPod(true, true))
+	reconciler.ResultsFetcher = &fakeResultsFetcher{err: errors.New("connection refused")}
+	reconciler.LogReader = &fakeLogReader{log: testSummary}
+
+	assert.Equal(t, resultsRetryInterval, reconciler.collectResults(context.Background(), lt))
+	assert.Nil(t, lt.Status.Results)
+}
+
+func TestCollectResults_CollectorStopped(t *testing.T) {
+	lt := newTestResultsLocustTest(locustv2.ResultsStorageConfigMap)
+	reconciler, _ := newTestReconciler(lt, newTestMasterPod(true, false))
+	fetcher := &fakeResultsFetcher{files: newTestResultFiles()}
+	reconciler.ResultsFetcher = fetcher
+	reconciler.LogReader = &fakeLogReader{log: testSummary}
+	ctx := context.Background()
+
+	assert.Zero(t, reconciler.collectResults(ctx, lt))
+	assert.Empty(t, fetcher.baseURL)
+
+	cm := &corev1.ConfigMap{}
+	require.NoError(t, reconciler.Get(ctx, types.NamespacedName{Name: "results-test-results", Namespace: "default"}, cm))
+	assert.Equal(t, map[string]string{"summary.txt": testSummary}, cm.Data, "the summary is still kept")
+	require.NotNil(t, lt.Status.Results)
+	assert.Empty(t, lt.Status.Results.Files)
+	assert.Contains(t, lt.Status.Results.Message, "the results collector had stopped")
+}
+
+func TestCollectResults_Claim(t *testing.T) {
+	lt := newTestResultsLocustTest(locustv2.ResultsStoragePersistentVolumeClaim)
+	reconciler, _ := newTestReconciler(lt, newTestMasterPod(true, false))
+	fetcher := &fakeResultsFetcher{}
+	reconciler.ResultsFetcher = fetcher
+	reconciler.LogReader = &fakeLogReader{err: errors.New("pods \"results-test-master-abcde\" not found")}
+
+	assert.Zero(t, reconciler.collectResults(context.Background(), lt))
+	assert.Empty(t, fetcher.baseURL, "files stay on the claim")
+	assert.False(t, fetcher.done)
+
+	require.NotNil(t, lt.Status.Results)
+	assert.Equal(t, "results-test-results", lt.Status.Results.ConfigMap)
+	assert.Equal(t, "results-test-results", lt.Status.Results.PersistentVolumeClaim)
+	assert.Len(t, lt.Status.Results.Files, 5)
+	assert.Contains(t, lt.Status.Results.Message, "summary unavailable")
+}
+
+func TestCollectResults_ClaimJudgesThresholdsOnFinalStats(t *testing.T) {
+	lt := newTestResultsLocustTest(locustv2.ResultsStoragePersistentVolumeClaim)
+	lt.Spec.Thresholds = &locustv2.ThresholdsConfig{
+		ThresholdLimits: locustv2.ThresholdLimits{MaxFailureRatio: ptr.To(resource.MustParse("0.01"))},
+	}
+	reconciler, _ := newTestReconciler(lt, newTestMasterPod(true, true))
+	fetcher := &fakeResultsFetcher{files: map[string]string{"locust_stats.csv": testFinalStatsCSV}}
+	reconciler.ResultsFetcher = fetcher
+	reconciler.LogReader = &fakeLogReader{log: testSummary}
+	reconciler.setCondition(lt, locustv2.ConditionTypeThresholdsMet,
+		metav1.ConditionTrue, locustv2.ReasonThresholdsMet, "All thresholds met")
+
+	assert.Zero(t, reconciler.collectResults(context.Background(), lt))
+	assert.True(t, fetcher.done, "the collector is released once the final statistics are read")
+
+	cond := findCondition(lt.Status.Conditions, locustv2.ConditionTypeThresholdsMet)
+	require.NotNil(t, cond)
+	assert.Equal(t, "Thresholds breached: failure ratio 0.049 > 0.01 (final statistics)", cond.Message,
+		"the verdict doesn't depend on where the results are stored")
+	require.NotNil(t, lt.Status.Results)
+	assert.Len(t, lt.Status.Results.Files, 5, "the files stay on the claim")
+	assert.Empty(t, lt.Status.Results.Message)
+}
+
+func TestCollectResults_CollectsOnce(t *testing.T) {
+	lt := newTestResultsLocustTest(locustv2.ResultsStorageConfigMap)
+	collected := &locustv2.ResultsStatus{ConfigMap: "results-test-results"}
+	lt.Status.Results = collected
+	reconciler, _ := newTestReconciler(lt, newTestMasterPod(true, true))
+	fetcher := &fakeResultsFetcher{files: newTestResultFiles()}
+	reconciler.ResultsFetcher = fetcher
+	reconciler.LogReader = &fakeLogReader{log: testSummary}
+
+	assert.Zero(t, reconciler.collectResults(context.Background(), lt))
+	assert.Empty(t, fetcher.baseURL)
+	assert.Same(t, collected, lt.Status.Results)
+}
+
+// fakeUploader keeps uploaded objects in memory.
+type fakeUploader struct {
+	objects      map[string]string
+	contentTypes map[string]string
+	target       results.S3Target
+	err          error
+}
+
+func (u *fakeUploader) Upload(_ context.Context, target results.S3Target, key string, body []byte, contentType string) (string, error) {
+	u.target = target
+	if u.err != nil {
+		return "", u.err
+	}
+	if u.objects == nil {
+		u.objects = map[string]string{}
+		u.contentTypes = map[string]string{}
+	}
+	u.objects[key] = string(body)
+	u.contentTypes[key] = contentType
+	return target.ObjectURL(key)
+}
+
+// newTestS3LocustTest returns results-test uploading to a MinIO bucket.
+func newTestS3LocustTest(storage locustv2.ResultsStorage) (*locustv2.LocustTest, *corev1.Secret) {
+	lt := newTestResultsLocustTest(storage)
+	lt.Status.StartTime = &metav1.Time{Time: time.Date(2026, 10, 16, 2, 0, 0, 0, time.UTC)}
+	lt.Spec.Results.S3 = &locustv2.ResultsS3Config{
+		Endpoint:             "http://minio.minio.svc:9000",
+		Region:               "us-east-1",
+		Bucket:               "load-tests",
+		Prefix:               locustv2.DefaultResultsS3Prefix,
+		ForcePathStyle:       true,
+		CredentialsSecretRef: corev1.LocalObjectReference{Name: "minio-credentials"},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "minio-credentials", Namespace: "default"},
+		Data: map[string][]byte{
+			"AWS_ACCESS_KEY_ID":     []byte("minioadmin"),
+			"AWS_SECRET_ACCESS_KEY": []byte("minioadmin"),
+		},
+	}
+	return lt, secret
+}
+
+func TestCollectResults_UploadsToS3(t *testing.T) {
+	lt, secret := newTestS3LocustTest(locustv2.ResultsStorageConfigMap)
+	reconciler, recorder := newTestReconciler(lt, secret, newTestMasterPod(true, true))
+	reconciler.ResultsFetcher = &fakeResultsFetcher{files: newTestResultFiles()}
+	reconciler.LogReader = &fakeLogReader{log: testSummary}
+	uploader := &fakeUploader{}
+	reconciler.ResultsUploader = uploader
+
+	assert.Zero(t, reconciler.collectResults(context.Background(), lt))
+
+	assert.Equal(t, "minioadmin", uploader.target.AccessKeyID)
+	assert.Len(t, uploader.objects, 6)
+	assert.Equal(t, testSummary, uploader.objects["default/results-test/20261016T020000Z/summary.txt"])
+	assert.Equal(t, "text/html", uploader.contentTypes["default/results-test/20261016T020000Z/report.html"])
+	assert.Equal(t, "text/csv", uploader.contentTypes["default/results-test/20261016T020000Z/locust_stats.csv"])
+
+	require.NotNil(t, lt.Status.Results)
+	assert.Len(t, lt.Status.Results.Files, 5, "the ConfigMap still holds the files")
+	require.Len(t, lt.Status.Results.URLs, 6)
+	assert.Equal(t, "http://minio.minio.svc:9000/load-tests/default/results-test/20261016T020000Z/locust_stats.csv",
+		lt.Status.Results.URLs[0])
+
+	cond := meta.FindStatusCondition(lt.Status.Conditions, locustv2.ConditionTypeResultsExported)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+	assert.Equal(t, locustv2.ReasonResultsExported, cond.Reason)
+	assert.Equal(t, "Uploaded 6 objects to s3://load-tests/default/results-test/20261016T020000Z", cond.Message)
+
+	<-recorder.Events // Created
+	assert.Equal(t, "Normal ResultsExported "+cond.Message, <-recorder.Events)
+}
+
+func TestCollectResults_UploadsClaimFilesToS3(t *testing.T) {
+	lt, secret := newTestS3LocustTest(locustv2.ResultsStoragePersistentVolumeClaim)
+	reconciler, _ := newTestReconciler(lt, secret, newTestMasterPod(true, true))
+	files := newTestResultFiles()
+	files["report.html"] = strings.Repeat("x", maxResultsConfigMapBytes)
+	fetcher := &fakeResultsFetcher{files: files}
+	reconciler.ResultsFetcher = fetcher
+	reconciler.LogReader = &fakeLogReader{log: testSummary}
+	uploader := &fakeUploader{}
+	reconciler.ResultsUploader = uploader
+
+	assert.Zero(t, reconciler.collectResults(context.Background(), lt))
+	assert.True(t, fetcher.done)
+
+	assert.Len(t, uploader.objects["default/results-test/20261016T020000Z/report.html"], maxResultsConfigMapBytes,
+		"uploads aren't bound by the ConfigMap size")
+	require.NotNil(t, lt.Status.Results)
+	assert.Equal(t, "results-test-results", lt.Status.Results.PersistentVolumeClaim)
+	assert.Len(t, lt.Status.Results.URLs, 6)
+	assert.Empty(t, lt.Status.Results.Message)
+}
+
+func TestCollectResults_S3Failures(t *testing.T) {
+	defer func(backoff wait.Backoff) { resultsUploadBackoff = backoff }(resultsUploadBackoff)
+	resultsUploadBackoff = wait.Backoff{Steps: 2}
+
+	tests := []struct {
+		name       string
+		noSecret   bool
+		secretData map[string][]byte
+		uploader   *fakeUploader
+		errMsg     string
+	}{
+		{
+			name:     "MissingSecret",
+			noSecret: true,
+			uploader: &fakeUploader{},
+			errMsg:   "Failed to upload results: failed to read credentials Secret minio-credentials",
+		},
+		{
+			name:       "IncompleteSecret",
+			secretData: map[string][]byte{"AWS_ACCESS_KEY_ID": []byte("minioadmin")},
+			uploader:   &fakeUploader{},
+			errMsg:     "credentials Secret minio-credentials must set AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY",
+		},
+		{
+			name:     "UploadError",
+			uploader: &fakeUploader{err: errors.New("unexpected status 404 Not Found: NoSuchBucket")},
+			errMsg:   "Failed to upload results: unexpected status 404 Not Found: NoSuchBucket",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lt, secret := newTestS3LocustTest(locustv2.ResultsStorageConfigMap)
+			if tt.secretData != nil {
+				secret.Data = tt.secretData
+			}
+			objs := []client.Object{lt, newTestMasterPod(true, true)}
+			if !tt.noSecret {
+				objs = append(objs, secret)
+			}
+			reconciler, recorder := newTestReconciler(objs...)
+			fetcher := &fakeResultsFetcher{files: newTestResultFiles()}
+			reconciler.ResultsFetcher = fetcher
+			reconciler.LogReader = &fakeLogReader{log: testSummary}
+			reconciler.ResultsUploader = tt.uploader
+
+			assert.Zero(t, reconciler.collectResults(context.Background(), lt))
+			assert.True(t, fetcher.done)
+			assert.Equal(t, locustv2.PhaseRunning, lt.Status.Phase, "export failures don't change the phase")
+
+			require.NotNil(t, lt.Status.Results, "results are still stored")
+			assert.Len(t, lt.Status.Results.Files, 5)
+			assert.Empty(t, lt.Status.Results.URLs)
+
+			cond := meta.FindStatusCondition(lt.Status.Conditions, locustv2.ConditionTypeResultsExported)
+			require.NotNil(t, cond)
+			assert.Equal(t, metav1.ConditionFalse, cond.Status)
+			assert.Equal(t, locustv2.ReasonResultsExportFailed, cond.Reason)
+			assert.Contains(t, cond.Message, tt.errMsg)
+
+			<-recorder.Events // Created
+			assert.Contains(t, <-recorder.Events, "Warning ResultsExportFailed")
+		})
+	}
+}
+
+// TestCollectResults_MinIO uploads through the S3 client to a stand-in for
+// MinIO that checks each request is signed with the Secret's credentials.
+func TestCollectResults_MinIO(t *testing.T) {
+	objects := map[string]string{}
+	minio := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut ||
+			!strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=minioadmin/20") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		objects[r.URL.Path] = string(body)
+	}))
+	defer minio.Close()
+
+	lt, secret := newTestS3LocustTest(locustv2.ResultsStorageConfigMap)
+	lt.Spec.Results.S3.Endpoint = minio.URL
+	lt.Spec.Results.S3.Prefix = "runs/{name}-{timestamp}"
+	reconciler, _ := newTestReconciler(lt, secret, newTestMasterPod(true, true))
+	reconciler.ResultsFetcher = &fakeResultsFetcher{files: newTestResultFiles()}
+	reconciler.LogReader = &fakeLogReader{log: testSummary}
+	reconciler.ResultsUploader = results.NewS3Uploader(time.Second)
+
+	assert.Zero(t, reconciler.collectResults(context.Background(), lt))
+
+	assert.Len(t, objects, 6)
+	assert.Equal(t, "<html></html>", objects["/load-tests/runs/results-test-20261016T020000Z/report.html"])
+	require.NotNil(t, lt.Status.Results)
+	assert.Contains(t, lt.Status.Results.URLs, minio.URL+"/load-tests/runs/results-test-20261016T020000Z/summary.txt")
+	assert.True(t, meta.IsStatusConditionTrue(lt.Status.Conditions, locustv2.ConditionTypeResultsExported))
+}
+
+func TestResultsObjectPrefix(t *testing.T) {
+	lt, _ := newTestS3LocustTest(locustv2.ResultsStorageConfigMap)
+	lt.Namespace = "team-a"
+	assert.Equal(t, "team-a/results-test/20261016T020000Z", resultsObjectPrefix(lt))
+
+	lt.Spec.Results.S3.Prefix = "/load/{name}/"
+	assert.Equal(t, "load/results-test", resultsObjectPrefix(lt), "surrounding slashes are trimmed")
+
+	lt.Spec.Results.S3.Prefix = ""
+	lt.Status.StartTime = &metav1.Time{Time: time.Date(2026, 10, 16, 4, 30, 0, 0, time.FixedZone("CEST", 2*3600))}
+	assert.Equal(t, "team-a/results-test/20261016T023000Z", resultsObjectPrefix(lt), "the timestamp is in UTC")
+}
+
+func TestReconcile_CreatesResultsClaim(t *testing.T) {
+	lt := newTestResultsLocustTest(locustv2.ResultsStoragePersistentVolumeClaim)
+	lt.Status = locustv2.LocustTestStatus{}
+	reconciler, _ := newTestReconciler(lt)
+	ctx := context.Background()
+
+	_, err := reconciler.Reconcile(ctx, ctrl.Request{
+		NamespacedName: types.NamespacedName{Name: "results-test", Namespace: "default"},
+	})
+	require.NoError(t, err)
+
+	claim := &corev1.PersistentVolumeClaim{}
+	require.NoError(t, reconciler.Get(ctx, types.NamespacedName{Name: "results-test-results", Namespace: "default"}, claim))
+	require.Len(t, claim.OwnerReferences, 1)
+	assert.Equal(t, "results-test", claim.OwnerReferences[0].Name)
+}
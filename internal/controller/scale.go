@@ -0,0 +1,112 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/resources"
+)
+
+// Bounds of spec.worker.replicas in the CRD schema.
+const (
+	minWorkerReplicas = 1
+	maxWorkerReplicas = 500
+)
+
+// scaleWorkers sets the worker Job's parallelism to spec.worker.replicas, or
+// to the autoscaler's decision, when it changed, so workers are added to or
+// removed from the running test. The master rebalances the users over the
+// workers (--enable-rebalancing). Scaling down raises the Job's backoffLimit
+// by the number of workers removed. The status is changed in memory only.
+func (r *LocustTestReconciler) scaleWorkers(ctx context.Context, lt *locustv2.LocustTest, workerJob *batchv1.Job) error {
+	current := ptr.Deref(workerJob.Spec.Parallelism, 0)
+	desired := r.boundWorkerReplicas(lt, workerReplicas(lt))
+	if current == desired {
+		return nil
+	}
+
+	patch := client.MergeFrom(workerJob.DeepCopy())
+	workerJob.Spec.Parallelism = ptr.To(desired)
+	// The Job controller counts the pods it deletes to scale down as failed:
+	// tolerate them, or the Job fails and takes the remaining workers with it
+	if desired < current {
+		backoffLimit := ptr.Deref(workerJob.Spec.BackoffLimit, int32(resources.BackoffLimit))
+		workerJob.Spec.BackoffLimit = ptr.To(backoffLimit + current - desired)
+	}
+	if err := r.Patch(ctx, workerJob, patch); err != nil {
+		return fmt.Errorf("failed to scale Job %s: %w", workerJob.Name, err)
+	}
+
+	// The workers of the worker groups, and the new number of workers of spec.worker
+	lt.Status.ExpectedWorkers = resources.ExpectedWorkers(lt) - lt.Spec.Worker.Replicas + desired
+
+	// The autoscaler records its own decisions
+	if lt.Spec.Worker.Autoscaling == nil {
+		r.Recorder.Event(lt, corev1.EventTypeNormal, "Scaled",
+			fmt.Sprintf("Scaled workers from %d to %d", current, desired))
+	}
+	return nil
+}
+
+// workerReplicasBounds returns the fewest and most workers of spec.worker:
+// the autoscaling bounds, or those of the CRD schema.
+func workerReplicasBounds(lt *locustv2.LocustTest) (int32, int32) {
+	if autoscaling := lt.Spec.Worker.Autoscaling; autoscaling != nil {
+		minReplicas := max(autoscaling.MinReplicas, minWorkerReplicas)
+		return minReplicas, max(autoscaling.MaxReplicas, minReplicas)
+	}
+	return minWorkerReplicas, maxWorkerReplicas
+}
+
+// boundWorkerReplicas clamps replicas to workerReplicasBounds. Writes to the
+// scale subresource skip the validating webhook, so spec.worker.replicas may
+// lie out of bounds; the WorkerReplicasInBounds condition and a Warning event
+// report it until it is back in bounds. The status is changed in memory only.
+func (r *LocustTestReconciler) boundWorkerReplicas(lt *locustv2.LocustTest, replicas int32) int32 {
+	minReplicas, maxReplicas := workerReplicasBounds(lt)
+	bounded := min(max(replicas, minReplicas), maxReplicas)
+	cond := meta.FindStatusCondition(lt.Status.Conditions, locustv2.ConditionTypeWorkerReplicasInBounds)
+
+	if bounded == replicas {
+		// Only a test that was out of bounds reports being back in them
+		if cond != nil && cond.Status != metav1.ConditionTrue {
+			r.setCondition(lt, locustv2.ConditionTypeWorkerReplicasInBounds,
+				metav1.ConditionTrue, locustv2.ReasonWorkerReplicasInBounds,
+				fmt.Sprintf("worker.replicas %d is between %d and %d", replicas, minReplicas, maxReplicas))
+		}
+		return replicas
+	}
+
+	message := fmt.Sprintf("worker.replicas %d must be between %d and %d; running %d workers",
+		replicas, minReplicas, maxReplicas, bounded)
+	if cond == nil || cond.Message != message {
+		r.Recorder.Event(lt, corev1.EventTypeWarning, locustv2.ReasonWorkerReplicasOutOfBounds, message)
+	}
+	r.setCondition(lt, locustv2.ConditionTypeWorkerReplicasInBounds,
+		metav1.ConditionFalse, locustv2.ReasonWorkerReplicasOutOfBounds, message)
+	return bounded
+}
@@ -0,0 +1,298 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/resources"
+)
+
+var scaleTestKey = types.NamespacedName{Name: "scale-test", Namespace: "default"}
+
+func reconcileScaleTest(t *testing.T, reconciler *LocustTestReconciler) *locustv2.LocustTest {
+	t.Helper()
+	_, err := reconciler.Reconcile(context.Background(), ctrl.Request{NamespacedName: scaleTestKey})
+	require.NoError(t, err)
+
+	lt := &locustv2.LocustTest{}
+	require.NoError(t, reconciler.Get(context.Background(), scaleTestKey, lt))
+	return lt
+}
+
+func workerParallelism(t *testing.T, reconciler *LocustTestReconciler) int32 {
+	t.Helper()
+	job := &batchv1.Job{}
+	require.NoError(t, reconciler.Get(context.Background(),
+		types.NamespacedName{Name: "scale-test-worker", Namespace: "default"}, job))
+	return ptr.Deref(job.Spec.Parallelism, 0)
+}
+
+func TestReconcile_ScalesWorkers(t *testing.T) {
+	reconciler, recorder := newTestReconciler(newTestLocustTestCR(scaleTestKey.Name, scaleTestKey.Namespace))
+	lt := reconcileScaleTest(t, reconciler)
+	require.Equal(t, locustv2.PhaseRunning, lt.Status.Phase)
+	assert.Equal(t, "performance-test-pod-name=scale-test-worker,!performance-test-worker-group", lt.Status.WorkerSelector)
+	drainEvents(recorder)
+
+	// The master runs
+	masterJob := &batchv1.Job{}
+	require.NoError(t, reconciler.Get(context.Background(),
+		types.NamespacedName{Name: "scale-test-master", Namespace: "default"}, masterJob))
+	masterJob.Status.Active = 1
+	require.NoError(t, reconciler.Status().Update(context.Background(), masterJob))
+
+	lt.Spec.Worker.Replicas = 6
+	lt.Generation = 2
+	require.NoError(t, reconciler.Update(context.Background(), lt))
+
+	lt = reconcileScaleTest(t, reconciler)
+	assert.Equal(t, int32(6), workerParallelism(t, reconciler))
+	assert.Equal(t, int32(6), lt.Status.ExpectedWorkers)
+	assert.Equal(t, locustv2.PhaseRunning, lt.Status.Phase)
+	assert.Nil(t, findCondition(lt.Status.Conditions, locustv2.ConditionTypeSpecDrifted),
+		"scaling isn't a spec drift")
+	assert.Equal(t, "Normal Scaled Scaled workers from 3 to 6", <-recorder.Events)
+
+	// Nothing changes once the Job follows the spec
+	reconcileScaleTest(t, reconciler)
+	assert.Empty(t, recorder.Events)
+}
+
+func TestReconcile_ScaleDownKeepsWorkerJob(t *testing.T) {
+	reconciler, _ := newTestReconciler(newTestLocustTestCR(scaleTestKey.Name, scaleTestKey.Namespace))
+	lt := reconcileScaleTest(t, reconciler)
+	ctx := context.Background()
+
+	for _, name := range []string{"scale-test-master", "scale-test-worker"} {
+		job := &batchv1.Job{}
+		require.NoError(t, reconciler.Get(ctx, types.NamespacedName{Name: name, Namespace: "default"}, job))
+		job.Status.Active = 3
+		require.NoError(t, reconciler.Status().Update(ctx, job))
+	}
+
+	lt.Spec.Worker.Replicas = 1
+	require.NoError(t, reconciler.Update(ctx, lt))
+	reconcileScaleTest(t, reconciler)
+
+	workerJob := &batchv1.Job{}
+	require.NoError(t, reconciler.Get(ctx, types.NamespacedName{Name: "scale-test-worker", Namespace: "default"}, workerJob))
+	assert.Equal(t, int32(1), ptr.Deref(workerJob.Spec.Parallelism, 0))
+	assert.Equal(t, int32(2), ptr.Deref(workerJob.Spec.BackoffLimit, 0), "the removed workers are tolerated")
+
+	// The Job controller deletes two workers and counts them as failed
+	workerJob.Status.Active = 1
+	workerJob.Status.Failed = 2
+	require.NoError(t, reconciler.Status().Update(ctx, workerJob))
+	assert.LessOrEqual(t, workerJob.Status.Failed, *workerJob.Spec.BackoffLimit, "the worker Job doesn't fail")
+	removed := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "scale-test-worker-removed",
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-5 * time.Minute)),
+			Labels:            map[string]string{"performance-test-name": "scale-test"},
+			Finalizers:        []string{batchv1.JobTrackingFinalizer},
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:  "scale-test-worker",
+				State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 143}},
+			}},
+		},
+	}
+	require.NoError(t, reconciler.Create(ctx, removed))
+	require.NoError(t, reconciler.Delete(ctx, removed))
+
+	lt = reconcileScaleTest(t, reconciler)
+	assert.Equal(t, locustv2.PhaseRunning, lt.Status.Phase)
+	healthy := findCondition(lt.Status.Conditions, locustv2.ConditionTypePodsHealthy)
+	require.NotNil(t, healthy)
+	assert.Equal(t, metav1.ConditionTrue, healthy.Status, "removed workers aren't failures: %s", healthy.Message)
+}
+
+func TestReconcile_ClampsOutOfBoundsReplicas(t *testing.T) {
+	reconciler, recorder := newTestReconciler(newTestLocustTestCR(scaleTestKey.Name, scaleTestKey.Namespace))
+	lt := reconcileScaleTest(t, reconciler)
+	drainEvents(recorder)
+
+	// The master runs
+	masterJob := &batchv1.Job{}
+	require.NoError(t, reconciler.Get(context.Background(),
+		types.NamespacedName{Name: "scale-test-master", Namespace: "default"}, masterJob))
+	masterJob.Status.Active = 1
+	require.NoError(t, reconciler.Status().Update(context.Background(), masterJob))
+
+	// Written through the scale subresource, which skips the webhook
+	lt.Spec.Worker.Replicas = 700
+	require.NoError(t, reconciler.Update(context.Background(), lt))
+
+	lt = reconcileScaleTest(t, reconciler)
+	assert.Equal(t, int32(500), workerParallelism(t, reconciler))
+	assert.Equal(t, int32(500), lt.Status.ExpectedWorkers)
+	cond := findCondition(lt.Status.Conditions, locustv2.ConditionTypeWorkerReplicasInBounds)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, locustv2.ReasonWorkerReplicasOutOfBounds, cond.Reason)
+	assert.Equal(t, "worker.replicas 700 must be between 1 and 500; running 500 workers", cond.Message)
+	assert.Equal(t, "Warning WorkerReplicasOutOfBounds worker.replicas 700 must be between 1 and 500; running 500 workers",
+		<-recorder.Events)
+	assert.Equal(t, "Normal Scaled Scaled workers from 3 to 500", <-recorder.Events)
+
+	// Reported once
+	lt = reconcileScaleTest(t, reconciler)
+	assert.Empty(t, recorder.Events)
+
+	lt.Spec.Worker.Replicas = 4
+	require.NoError(t, reconciler.Update(context.Background(), lt))
+
+	lt = reconcileScaleTest(t, reconciler)
+	assert.Equal(t, int32(4), workerParallelism(t, reconciler))
+	cond = findCondition(lt.Status.Conditions, locustv2.ConditionTypeWorkerReplicasInBounds)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+}
+
+func TestBoundWorkerReplicas(t *testing.T) {
+	tests := []struct {
+		name        string
+		autoscaling *locustv2.WorkerAutoscaling
+		replicas    int32
+		want        int32
+	}{
+		{name: "InBounds", replicas: 3, want: 3},
+		{name: "Zero", replicas: 0, want: 1},
+		{name: "AboveSchemaMaximum", replicas: 501, want: 500},
+		{
+			name:        "BelowAutoscalingMinimum",
+			autoscaling: &locustv2.WorkerAutoscaling{MinReplicas: 2, MaxReplicas: 10},
+			replicas:    1,
+			want:        2,
+		},
+		{
+			name:        "AboveAutoscalingMaximum",
+			autoscaling: &locustv2.WorkerAutoscaling{MinReplicas: 2, MaxReplicas: 10},
+			replicas:    20,
+			want:        10,
+		},
+		{
+			name:        "WithinAutoscaling",
+			autoscaling: &locustv2.WorkerAutoscaling{MaxReplicas: 10},
+			replicas:    10,
+			want:        10,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lt := newTestLocustTestCR(scaleTestKey.Name, scaleTestKey.Namespace)
+			lt.Spec.Worker.Autoscaling = tt.autoscaling
+			reconciler, recorder := newTestReconciler(lt)
+
+			assert.Equal(t, tt.want, reconciler.boundWorkerReplicas(lt, tt.replicas))
+			cond := findCondition(lt.Status.Conditions, locustv2.ConditionTypeWorkerReplicasInBounds)
+			if tt.want == tt.replicas {
+				assert.Nil(t, cond, "only out of bounds replicas are reported")
+				assert.Empty(t, recorder.Events)
+			} else {
+				require.NotNil(t, cond)
+				assert.Equal(t, metav1.ConditionFalse, cond.Status)
+				assert.Contains(t, <-recorder.Events, "Warning WorkerReplicasOutOfBounds")
+			}
+		})
+	}
+}
+
+func TestReconcile_DoesNotScaleFinishedTest(t *testing.T) {
+	lt := newTestLocustTestCR(scaleTestKey.Name, scaleTestKey.Namespace)
+	reconciler, _ := newTestReconciler(lt)
+	lt = reconcileScaleTest(t, reconciler)
+
+	lt.Status.Phase = locustv2.PhaseSucceeded
+	require.NoError(t, reconciler.Status().Update(context.Background(), lt))
+	lt.Spec.Worker.Replicas = 6
+	require.NoError(t, reconciler.Update(context.Background(), lt))
+
+	reconcileScaleTest(t, reconciler)
+	assert.Equal(t, int32(3), workerParallelism(t, reconciler))
+}
+
+func TestSpecDrifted(t *testing.T) {
+	built := newTestLocustTestCR("drift-test", "default")
+	masterJob := resources.BuildMasterJob(built, newTestOperatorConfig(), logr.Discard())
+
+	tests := []struct {
+		name      string
+		mutate    func(lt *locustv2.LocustTest)
+		masterJob *batchv1.Job
+		want      bool
+	}{
+		{
+			name:      "Unchanged",
+			mutate:    func(lt *locustv2.LocustTest) {},
+			masterJob: masterJob,
+		},
+		{
+			name:      "Scaled",
+			mutate:    func(lt *locustv2.LocustTest) { lt.Spec.Worker.Replicas = 10 },
+			masterJob: masterJob,
+		},
+		{
+			name:      "ImageChanged",
+			mutate:    func(lt *locustv2.LocustTest) { lt.Spec.Image = "locustio/locust:other" },
+			masterJob: masterJob,
+			want:      true,
+		},
+		{
+			name:      "ScaledAndImageChanged",
+			mutate:    func(lt *locustv2.LocustTest) { lt.Spec.Worker.Replicas = 10; lt.Spec.Image = "other" },
+			masterJob: masterJob,
+			want:      true,
+		},
+		{
+			name:      "JobWithoutHash",
+			mutate:    func(lt *locustv2.LocustTest) { lt.Spec.Worker.Replicas = 10 },
+			masterJob: &batchv1.Job{},
+			want:      true,
+		},
+		{
+			name:   "NoMasterJob",
+			mutate: func(lt *locustv2.LocustTest) { lt.Spec.Image = "other" },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lt := built.DeepCopy()
+			lt.Generation = 2
+			tt.mutate(lt)
+			assert.Equal(t, tt.want, specDrifted(lt, tt.masterJob))
+		})
+	}
+}
@@ -19,6 +19,7 @@ package controller
 import (
 	"context"
 	"fmt"
+	"time"
 
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -29,13 +30,20 @@ import (
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/resources"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/stats"
 )
 
 // initializeStatus sets initial status values for a new LocustTest.
 func (r *LocustTestReconciler) initializeStatus(lt *locustv2.LocustTest) {
 	lt.Status.Phase = locustv2.PhasePending
-	lt.Status.ExpectedWorkers = lt.Spec.Worker.Replicas
+	if lt.Spec.Queue != nil {
+		lt.Status.Phase = locustv2.PhaseQueued
+	}
+	lt.Status.ExpectedWorkers = resources.ExpectedWorkers(lt)
+	lt.Status.WorkerSelector = resources.WorkerSelector(lt)
 	lt.Status.ConnectedWorkers = 0
+	lt.Status.LoadProfile = resources.DescribeLoadProfile(lt.Spec.Load)
 
 	r.setReady(lt, false, locustv2.ReasonResourcesCreating, "Creating resources")
 	r.setCondition(lt, locustv2.ConditionTypeWorkersConnected,
@@ -47,6 +55,16 @@ func (r *LocustTestReconciler) initializeStatus(lt *locustv2.LocustTest) {
 	r.setCondition(lt, locustv2.ConditionTypePodsHealthy,
 		metav1.ConditionTrue, locustv2.ReasonPodsStarting,
 		"Waiting for pods to start")
+	if lt.Spec.Thresholds != nil {
+		r.setCondition(lt, locustv2.ConditionTypeThresholdsMet,
+			metav1.ConditionUnknown, locustv2.ReasonThresholdsPending,
+			"Waiting for statistics from the master")
+	}
+	if lt.Spec.Results != nil && lt.Spec.Results.S3 != nil {
+		r.setCondition(lt, locustv2.ConditionTypeResultsExported,
+			metav1.ConditionUnknown, locustv2.ReasonResultsExportPending,
+			"Results are uploaded when the test finishes")
+	}
 }
 
 // setCondition sets a condition on the LocustTest status.
@@ -77,13 +95,27 @@ func (r *LocustTestReconciler) setReady(lt *locustv2.LocustTest, ready bool, rea
 	r.setCondition(lt, locustv2.ConditionTypeReady, status, reason, message)
 }
 
+// setWorkersConnected sets the WorkersConnected condition from ConnectedWorkers.
+func (r *LocustTestReconciler) setWorkersConnected(lt *locustv2.LocustTest, verb string) {
+	msg := fmt.Sprintf("%d/%d workers %s", lt.Status.ConnectedWorkers, lt.Status.ExpectedWorkers, verb)
+	if lt.Status.ConnectedWorkers >= lt.Status.ExpectedWorkers {
+		r.setCondition(lt, locustv2.ConditionTypeWorkersConnected,
+			metav1.ConditionTrue, locustv2.ReasonAllWorkersConnected, msg)
+	} else {
+		r.setCondition(lt, locustv2.ConditionTypeWorkersConnected,
+			metav1.ConditionFalse, locustv2.ReasonWorkersMissing, msg)
+	}
+}
+
 // updateStatusFromJobs derives status from the current state of owned Jobs.
+// report is the master's latest report, or nil if it wasn't read this reconcile.
 func (r *LocustTestReconciler) updateStatusFromJobs(
 	ctx context.Context,
 	lt *locustv2.LocustTest,
 	masterJob *batchv1.Job,
 	workerJob *batchv1.Job,
 	podHealth PodHealthStatus,
+	report *stats.Report,
 ) error {
 	log := logf.FromContext(ctx)
 
@@ -98,9 +130,24 @@ func (r *LocustTestReconciler) updateStatusFromJobs(
 		}
 	}
 
-	// Update phase if changed and emit events
+	// A test that ran past its maxDuration fails, unless Locust already exited cleanly
+	timedOut := !lt.Status.Phase.IsTerminal() && newPhase != locustv2.PhaseSucceeded && r.timedOut(lt, masterJob)
+	if timedOut {
+		newPhase = locustv2.PhaseFailed
+	}
+
+	// A stopped or aborted test ends Cancelled or Aborted
+	newPhase = controlledPhase(lt, newPhase)
+
+	// A test that breached its thresholds fails even if Locust exited cleanly
 	if lt.Status.Phase != newPhase {
-		oldPhase := lt.Status.Phase
+		newPhase = r.applyThresholdsVerdict(lt, newPhase)
+	}
+
+	// Update phase if changed and emit events
+	oldPhase := lt.Status.Phase
+	completed := oldPhase != newPhase && newPhase.IsTerminal()
+	if oldPhase != newPhase {
 		lt.Status.Phase = newPhase
 
 		// Emit event for significant transitions (CORE-26)
@@ -110,7 +157,16 @@ func (r *LocustTestReconciler) updateStatusFromJobs(
 		case locustv2.PhaseSucceeded:
 			r.Recorder.Event(lt, corev1.EventTypeNormal, "TestCompleted", "Load test completed successfully")
 		case locustv2.PhaseFailed:
-			r.Recorder.Event(lt, corev1.EventTypeWarning, "TestFailed", "Load test execution failed")
+			if timedOut {
+				r.Recorder.Event(lt, corev1.EventTypeWarning, "TimedOut",
+					fmt.Sprintf("Load test exceeded its maximum duration of %s", resources.MaxDuration(lt, r.Config)))
+			} else {
+				r.Recorder.Event(lt, corev1.EventTypeWarning, "TestFailed", "Load test execution failed")
+			}
+		case locustv2.PhaseCancelled:
+			r.Recorder.Event(lt, corev1.EventTypeNormal, "TestCancelled", "Load test stopped before completion")
+		case locustv2.PhaseAborted:
+			r.Recorder.Event(lt, corev1.EventTypeWarning, "TestAborted", "Load test aborted, its pods were deleted")
 		case locustv2.PhasePending:
 			// No event for Pending - it's the initial state or recovery state
 		}
@@ -121,41 +177,70 @@ func (r *LocustTestReconciler) updateStatusFromJobs(
 			lt.Status.StartTime = &now
 		}
 
-		if newPhase == locustv2.PhaseSucceeded || newPhase == locustv2.PhaseFailed {
+		if newPhase.IsTerminal() {
 			now := metav1.Now()
 			lt.Status.CompletionTime = &now
 
 			// Update TestCompleted condition
-			if newPhase == locustv2.PhaseSucceeded {
+			switch {
+			case newPhase == locustv2.PhaseSucceeded:
 				r.setCondition(lt, locustv2.ConditionTypeTestCompleted,
 					metav1.ConditionTrue, locustv2.ReasonTestSucceeded,
 					"Test completed successfully")
-			} else {
+			case newPhase == locustv2.PhaseCancelled:
+				r.setCondition(lt, locustv2.ConditionTypeTestCompleted,
+					metav1.ConditionTrue, locustv2.ReasonTestCancelled,
+					"Test stopped before completion")
+			case newPhase == locustv2.PhaseAborted:
+				r.setCondition(lt, locustv2.ConditionTypeTestCompleted,
+					metav1.ConditionTrue, locustv2.ReasonTestAborted,
+					"Test aborted")
+			case newPhase == locustv2.PhaseFailed && timedOut:
+				r.setCondition(lt, locustv2.ConditionTypeTestCompleted,
+					metav1.ConditionTrue, locustv2.ReasonTimedOut,
+					fmt.Sprintf("Test exceeded its maximum duration of %s", resources.MaxDuration(lt, r.Config)))
+				r.setReady(lt, false, locustv2.ReasonResourcesFailed, "Test timed out")
+			case thresholdsBreached(lt):
+				r.setCondition(lt, locustv2.ConditionTypeTestCompleted,
+					metav1.ConditionTrue, locustv2.ReasonTestFailed,
+					"Test failed: thresholds breached")
+				r.setReady(lt, false, locustv2.ReasonResourcesFailed, "Test failed")
+			default:
 				r.setCondition(lt, locustv2.ConditionTypeTestCompleted,
 					metav1.ConditionTrue, locustv2.ReasonTestFailed,
 					"Test failed")
 				r.setReady(lt, false, locustv2.ReasonResourcesFailed, "Test failed")
 			}
+
+			// Close the Grafana annotation region of the run
+			r.endGrafanaAnnotation(ctx, lt)
 		}
 
 		log.Info("Phase transition", "from", string(oldPhase), "to", string(newPhase), "locustTest", lt.Name)
 	}
 
-	// Update worker connection status (approximation from worker Job)
+	// Note since when workers are missing, to time how long they take to connect
+	var workersWaitingSince *metav1.Time
+	if cond := meta.FindStatusCondition(lt.Status.Conditions, locustv2.ConditionTypeWorkersConnected); cond != nil &&
+		cond.Status != metav1.ConditionTrue {
+		workersWaitingSince = cond.LastTransitionTime.DeepCopy()
+	}
+
+	// Update worker connection status: the master's worker list when it was
+	// read, otherwise the worker Jobs' active pods as an approximation
+	switch {
+	case report != nil && report.Workers != nil:
+		lt.Status.ConnectedWorkers = report.ConnectedWorkers()
+		lt.Status.Workers = buildWorkerStatuses(report.Workers)
+		recordWorkerGroups(lt, report)
+		r.setWorkersConnected(lt, "registered with the master")
+	case workerJob != nil:
+		lt.Status.ConnectedWorkers = r.activeWorkers(ctx, lt, workerJob)
+		r.setWorkersConnected(lt, "connected")
+	}
 	if workerJob != nil {
-		lt.Status.ConnectedWorkers = workerJob.Status.Active
-
-		if lt.Status.ConnectedWorkers >= lt.Status.ExpectedWorkers {
-			r.setCondition(lt, locustv2.ConditionTypeWorkersConnected,
-				metav1.ConditionTrue, locustv2.ReasonAllWorkersConnected,
-				fmt.Sprintf("%d/%d workers connected",
-					lt.Status.ConnectedWorkers, lt.Status.ExpectedWorkers))
-		} else {
-			r.setCondition(lt, locustv2.ConditionTypeWorkersConnected,
-				metav1.ConditionFalse, locustv2.ReasonWorkersMissing,
-				fmt.Sprintf("%d/%d workers connected",
-					lt.Status.ConnectedWorkers, lt.Status.ExpectedWorkers))
-		}
+		lt.Status.WorkerReplicas = workerJob.Status.Active
+		lt.Status.WorkerSelector = resources.WorkerSelector(lt)
 	}
 
 	// Update PodsHealthy condition
@@ -178,8 +263,8 @@ func (r *LocustTestReconciler) updateStatusFromJobs(
 	// Update ObservedGeneration (CORE-25)
 	lt.Status.ObservedGeneration = lt.Generation
 
-	// Set SpecDrifted condition when spec was modified on an immutable test (STAB-03)
-	if lt.Generation > 1 && lt.Status.Phase != locustv2.PhasePending {
+	// Set SpecDrifted condition when spec was modified on an immutable test (STAB-03).
+	if lt.Status.Phase != locustv2.PhasePending && specDrifted(lt, masterJob) {
 		r.setCondition(lt, locustv2.ConditionTypeSpecDrifted,
 			metav1.ConditionTrue, locustv2.ReasonSpecChangeIgnored,
 			"Spec changes after creation are ignored. Delete and recreate the CR to apply changes.")
@@ -198,9 +283,49 @@ func (r *LocustTestReconciler) updateStatusFromJobs(
 	if err != nil {
 		return fmt.Errorf("failed to update status from Jobs: %w", err)
 	}
+
+	// Record the lifecycle metrics once the status they describe is persisted
+	r.exportLocustMetrics(lt, report)
+	if completed {
+		observeCompletion(lt)
+	}
+	if workersWaitingSince != nil && meta.IsStatusConditionTrue(lt.Status.Conditions, locustv2.ConditionTypeWorkersConnected) {
+		observeWorkersConnected(lt, workersWaitingSince.Time, time.Now())
+	}
+
+	r.notifyPhase(ctx, lt, oldPhase)
 	return nil
 }
 
+// exportLocustMetrics hands the master's report of a running test to the
+// built-in metrics exporter, and removes the series of a test that stopped
+// running. Without a new report the previous series are kept, like
+// status.stats.
+func (r *LocustTestReconciler) exportLocustMetrics(lt *locustv2.LocustTest, report *stats.Report) {
+	key := client.ObjectKeyFromObject(lt)
+	switch {
+	case lt.Status.Phase != locustv2.PhaseRunning || !resources.HasBuiltinMetrics(lt, r.Config):
+		r.LocustMetrics.Delete(key)
+	case report != nil:
+		r.LocustMetrics.Set(key, report)
+	}
+}
+
+// specDrifted reports whether the spec changed in a way the test's Jobs
+// can't follow. The master Job records the SpecHash of the spec it was built
+// from, so scaling spec.worker.replicas, clearing spec.suspend or moving
+// spec.startAt doesn't count. Jobs built before the hash was recorded fall
+// back to the generation, exempting tests created suspended.
+func specDrifted(lt *locustv2.LocustTest, masterJob *batchv1.Job) bool {
+	if masterJob == nil || lt.Generation <= 1 {
+		return false
+	}
+	if hash, ok := masterJob.Annotations[resources.AnnotationSpecHash]; ok {
+		return hash != resources.SpecHash(lt)
+	}
+	return masterJob.Spec.Suspend == nil
+}
+
 // derivePhaseFromJob determines the LocustTest phase from Job status.
 func derivePhaseFromJob(job *batchv1.Job) locustv2.Phase {
 	if job == nil {
@@ -368,7 +368,7 @@ func TestUpdateStatusFromJobs_FullStateMachine(t *testing.T) {
 			ctx := context.Background()
 
 			// Call updateStatusFromJobs
-			err := reconciler.updateStatusFromJobs(ctx, lt, tt.masterJob, tt.workerJob, healthyPodStatus())
+			err := reconciler.updateStatusFromJobs(ctx, lt, tt.masterJob, tt.workerJob, healthyPodStatus(), nil)
 			require.NoError(t, err)
 
 			// Verify phase
@@ -474,7 +474,7 @@ func TestUpdateStatusFromJobs_PhaseTransitionEvents(t *testing.T) {
 			ctx := context.Background()
 
 			// Call updateStatusFromJobs
-			err := reconciler.updateStatusFromJobs(ctx, lt, tt.masterJob, nil, healthyPodStatus())
+			err := reconciler.updateStatusFromJobs(ctx, lt, tt.masterJob, nil, healthyPodStatus(), nil)
 			require.NoError(t, err)
 
 			// Verify event was emitted
@@ -517,7 +517,7 @@ func TestUpdateStatusFromJobs_NoEventOnSamePhase(t *testing.T) {
 	ctx := context.Background()
 
 	// Call updateStatusFromJobs
-	err := reconciler.updateStatusFromJobs(ctx, lt, masterJob, nil, healthyPodStatus())
+	err := reconciler.updateStatusFromJobs(ctx, lt, masterJob, nil, healthyPodStatus(), nil)
 	require.NoError(t, err)
 
 	// Verify no event was emitted
@@ -562,7 +562,7 @@ func TestUpdateStatusFromJobs_ObservedGeneration(t *testing.T) {
 	ctx := context.Background()
 
 	// Call updateStatusFromJobs
-	err := reconciler.updateStatusFromJobs(ctx, lt, masterJob, nil, healthyPodStatus())
+	err := reconciler.updateStatusFromJobs(ctx, lt, masterJob, nil, healthyPodStatus(), nil)
 	require.NoError(t, err)
 
 	// Verify ObservedGeneration was updated to match Generation
@@ -604,7 +604,7 @@ func TestUpdateStatusFromJobs_WorkersConnectedCondition(t *testing.T) {
 	ctx := context.Background()
 
 	// Call updateStatusFromJobs
-	err := reconciler.updateStatusFromJobs(ctx, lt, masterJob, workerJob, healthyPodStatus())
+	err := reconciler.updateStatusFromJobs(ctx, lt, masterJob, workerJob, healthyPodStatus(), nil)
 	require.NoError(t, err)
 
 	// Verify WorkersConnected condition
@@ -645,7 +645,7 @@ func TestUpdateStatusFromJobs_SpecDriftedCondition(t *testing.T) {
 	ctx := context.Background()
 
 	// Call updateStatusFromJobs
-	err := reconciler.updateStatusFromJobs(ctx, lt, masterJob, nil, healthyPodStatus())
+	err := reconciler.updateStatusFromJobs(ctx, lt, masterJob, nil, healthyPodStatus(), nil)
 	require.NoError(t, err)
 
 	// Verify SpecDrifted condition exists with ConditionTrue
@@ -686,7 +686,7 @@ func TestUpdateStatusFromJobs_NoSpecDriftedOnGeneration1(t *testing.T) {
 	ctx := context.Background()
 
 	// Call updateStatusFromJobs
-	err := reconciler.updateStatusFromJobs(ctx, lt, masterJob, nil, healthyPodStatus())
+	err := reconciler.updateStatusFromJobs(ctx, lt, masterJob, nil, healthyPodStatus(), nil)
 	require.NoError(t, err)
 
 	// Verify SpecDrifted condition does NOT exist
@@ -738,7 +738,7 @@ func TestUpdateStatusFromJobs_RetryOnConflict(t *testing.T) {
 	}
 
 	ctx := context.Background()
-	err := reconciler.updateStatusFromJobs(ctx, lt, masterJob, nil, healthyPodStatus())
+	err := reconciler.updateStatusFromJobs(ctx, lt, masterJob, nil, healthyPodStatus(), nil)
 	require.NoError(t, err)
 
 	assert.Equal(t, locustv2.PhaseRunning, lt.Status.Phase)
@@ -777,7 +777,7 @@ func TestUpdateStatusFromJobs_PodHealthUnhealthy_TransitionsToFailed(t *testing.
 	reconciler, recorder := newTestReconciler(lt)
 	ctx := context.Background()
 
-	err := reconciler.updateStatusFromJobs(ctx, lt, masterJob, nil, unhealthyStatus)
+	err := reconciler.updateStatusFromJobs(ctx, lt, masterJob, nil, unhealthyStatus, nil)
 	require.NoError(t, err)
 
 	// Phase should transition to Failed
@@ -857,7 +857,7 @@ func TestUpdateStatusFromJobs_PodHealthUnhealthy_DoesNotOverrideTerminalState(t
 	reconciler, _ := newTestReconciler(lt)
 	ctx := context.Background()
 
-	err := reconciler.updateStatusFromJobs(ctx, lt, masterJob, nil, unhealthyStatus)
+	err := reconciler.updateStatusFromJobs(ctx, lt, masterJob, nil, unhealthyStatus, nil)
 	require.NoError(t, err)
 
 	// Phase should be Succeeded (not Failed), as terminal state takes precedence
@@ -903,7 +903,7 @@ func TestUpdateStatusFromJobs_PodHealthImagePullError(t *testing.T) {
 	reconciler, _ := newTestReconciler(lt)
 	ctx := context.Background()
 
-	err := reconciler.updateStatusFromJobs(ctx, lt, masterJob, nil, unhealthyStatus)
+	err := reconciler.updateStatusFromJobs(ctx, lt, masterJob, nil, unhealthyStatus, nil)
 	require.NoError(t, err)
 
 	// Phase should transition to Failed
@@ -950,7 +950,7 @@ func TestUpdateStatusFromJobs_PodHealthCrashLoop(t *testing.T) {
 	reconciler, _ := newTestReconciler(lt)
 	ctx := context.Background()
 
-	err := reconciler.updateStatusFromJobs(ctx, lt, masterJob, nil, unhealthyStatus)
+	err := reconciler.updateStatusFromJobs(ctx, lt, masterJob, nil, unhealthyStatus, nil)
 	require.NoError(t, err)
 
 	// Phase should transition to Failed
@@ -995,7 +995,7 @@ func TestUpdateStatusFromJobs_PodHealthInGracePeriod(t *testing.T) {
 	reconciler, _ := newTestReconciler(lt)
 	ctx := context.Background()
 
-	err := reconciler.updateStatusFromJobs(ctx, lt, masterJob, nil, gracePeriodStatus)
+	err := reconciler.updateStatusFromJobs(ctx, lt, masterJob, nil, gracePeriodStatus, nil)
 	require.NoError(t, err)
 
 	// Phase should stay Running
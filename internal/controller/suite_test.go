@@ -18,6 +18,9 @@ package controller
 
 import (
 	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"testing"
@@ -40,6 +43,7 @@ import (
 	locustv1 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v1"
 	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
 	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/config"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/stats"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -52,6 +56,9 @@ var (
 	testEnv   *envtest.Environment
 	cfg       *rest.Config
 	k8sClient client.Client
+
+	// fakeMaster stands in for the Locust master of every test.
+	fakeMaster *httptest.Server
 )
 
 const (
@@ -112,13 +119,18 @@ var _ = BeforeSuite(func() {
 	// Setup reconciler with manager
 	operatorConfig, err := config.LoadConfig()
 	Expect(err).NotTo(HaveOccurred())
+	operatorConfig.StatsPollInterval = time.Second
+	fakeMaster = newFakeLocustMaster()
+	masterFetcher := newFakeMasterFetcher(fakeMaster)
 	err = (&LocustTestReconciler{
 		Client: k8sManager.GetClient(),
 		Scheme: k8sManager.GetScheme(),
 		Config: operatorConfig,
 		// See cmd/main.go: GetEventRecorder is not a drop-in replacement.
 		//nolint:staticcheck // SA1019: deliberate, see cmd/main.go
-		Recorder: k8sManager.GetEventRecorderFor("locust-controller"),
+		Recorder:      k8sManager.GetEventRecorderFor("locust-controller"),
+		StatsFetcher:  masterFetcher,
+		MasterStopper: masterFetcher,
 	}).SetupWithManager(k8sManager)
 	Expect(err).NotTo(HaveOccurred())
 
@@ -138,6 +150,9 @@ var _ = BeforeSuite(func() {
 var _ = AfterSuite(func() {
 	By("tearing down the test environment")
 	cancel()
+	if fakeMaster != nil {
+		fakeMaster.Close()
+	}
 	err := testEnv.Stop()
 	Expect(err).NotTo(HaveOccurred())
 })
@@ -164,3 +179,49 @@ func getFirstFoundEnvTestBinaryDir() string {
 	}
 	return ""
 }
+
+// fakeMasterReport is served by the fake Locust master on /stats/requests.
+const fakeMasterReport = `{"state": "running", "user_count": 30, "total_rps": 42.5, "fail_ratio": 0.01,
+  "current_response_time_percentiles": {"response_time_percentile_0.5": 20, "response_time_percentile_0.95": 80},
+  "workers": [
+    {"id": "worker-0_a1", "state": "running", "user_count": 10, "cpu_usage": 12.5},
+    {"id": "worker-1_b2", "state": "running", "user_count": 10, "cpu_usage": 11},
+    {"id": "worker-2_c3", "state": "missing", "user_count": 10, "cpu_usage": 0}
+  ]}`
+
+// fakeMasterRequestsCSV is served by the fake Locust master on /stats/requests/csv.
+const fakeMasterRequestsCSV = `Type,Name,Request Count,Failure Count,Requests/s,95%,99%
+GET,/,100,1,42.5,80,120
+,Aggregated,100,1,42.5,80,120
+`
+
+// newFakeLocustMaster starts an HTTP server answering the statistics
+// endpoints of a Locust master's web UI.
+func newFakeLocustMaster() *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc(stats.RequestsPath, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(fakeMasterReport))
+	})
+	mux.HandleFunc(stats.RequestsCSVPath, func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(fakeMasterRequestsCSV))
+	})
+	mux.HandleFunc(stats.StopPath, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success": true, "message": "Test stopped"}`))
+	})
+	return httptest.NewServer(mux)
+}
+
+// newFakeMasterFetcher returns a stats.HTTPFetcher that sends every request to
+// server, whatever master Service host the URL names. envtest runs no cluster
+// DNS, so the Service names can't be resolved.
+func newFakeMasterFetcher(server *httptest.Server) *stats.HTTPFetcher {
+	dialer := &net.Dialer{}
+	transport := &http.Transport{
+		DialContext: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			return dialer.DialContext(ctx, network, server.Listener.Addr().String())
+		},
+	}
+	return &stats.HTTPFetcher{Client: &http.Client{Transport: transport, Timeout: 5 * time.Second}}
+}
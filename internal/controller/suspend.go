@@ -0,0 +1,159 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/resources"
+)
+
+// waitingPhase returns the phase of a test waiting to start: Suspended while
+// spec.suspend is set, Scheduled until spec.startAt, or "" once it may start.
+func waitingPhase(lt *locustv2.LocustTest, now time.Time) locustv2.Phase {
+	switch {
+	case lt.Spec.Suspend:
+		return locustv2.PhaseSuspended
+	case lt.Spec.StartAt != nil && now.Before(lt.Spec.StartAt.Time):
+		return locustv2.PhaseScheduled
+	default:
+		return ""
+	}
+}
+
+// setWaiting moves the status to a waiting phase. The status is changed in
+// memory only.
+func (r *LocustTestReconciler) setWaiting(lt *locustv2.LocustTest, phase locustv2.Phase) {
+	lt.Status.Phase = phase
+	if phase == locustv2.PhaseScheduled {
+		r.setCondition(lt, locustv2.ConditionTypeTestCompleted,
+			metav1.ConditionFalse, locustv2.ReasonTestScheduled,
+			fmt.Sprintf("Test starts at %s", lt.Spec.StartAt.UTC().Format(time.RFC3339)))
+	} else {
+		r.setCondition(lt, locustv2.ConditionTypeTestCompleted,
+			metav1.ConditionFalse, locustv2.ReasonTestSuspended,
+			"Test is suspended: set spec.suspend to false to start it")
+	}
+}
+
+// setStarted moves the status of a test whose Jobs were just started to
+// Running. The status is changed in memory only.
+func (r *LocustTestReconciler) setStarted(lt *locustv2.LocustTest) {
+	lt.Status.Phase = locustv2.PhaseRunning
+	if lt.Status.StartTime == nil {
+		now := metav1.Now()
+		lt.Status.StartTime = &now
+	}
+	r.setCondition(lt, locustv2.ConditionTypeTestCompleted,
+		metav1.ConditionFalse, locustv2.ReasonTestInProgress,
+		"Test is running")
+}
+
+// reconcileWaiting handles a test whose Jobs are suspended until spec.suspend
+// is cleared or spec.startAt passes, then unsuspends the Jobs.
+func (r *LocustTestReconciler) reconcileWaiting(ctx context.Context, lt *locustv2.LocustTest) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	// Recreate externally deleted resources like for a running test
+	_, _, shouldRequeue, requeueAfter, err := r.checkResourcesExist(ctx, lt)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if shouldRequeue {
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	// A test stopped or aborted before it started never runs
+	if lt.ControlAction() != "" {
+		return r.endTest(ctx, lt)
+	}
+
+	phase := waitingPhase(lt, time.Now())
+	if phase == "" {
+		if err := r.startJobs(ctx, lt); err != nil {
+			return ctrl.Result{}, err
+		}
+		r.Recorder.Event(lt, corev1.EventTypeNormal, "Started", startedMessage(lt))
+		log.Info("Started waiting test", "locustTest", lt.Name, "phase", string(lt.Status.Phase))
+	}
+
+	var previous locustv2.Phase
+	if err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		if err := r.Get(ctx, client.ObjectKeyFromObject(lt), lt); err != nil {
+			return err
+		}
+		previous = lt.Status.Phase
+		if phase == "" {
+			r.setStarted(lt)
+		} else {
+			r.setWaiting(lt, phase)
+		}
+		lt.Status.ObservedGeneration = lt.Generation
+		return r.Status().Update(ctx, lt)
+	}); err != nil {
+		log.Error(err, "Failed to update status of waiting test")
+		return ctrl.Result{}, fmt.Errorf("failed to update status of waiting test: %w", err)
+	}
+	r.notifyPhase(ctx, lt, previous)
+
+	// Wake up at spec.startAt
+	if phase == locustv2.PhaseScheduled {
+		return ctrl.Result{RequeueAfter: time.Until(lt.Spec.StartAt.Time)}, nil
+	}
+	return ctrl.Result{}, nil
+}
+
+// startJobs unsuspends the master and worker Jobs of a test.
+func (r *LocustTestReconciler) startJobs(ctx context.Context, lt *locustv2.LocustTest) error {
+	for _, name := range resources.JobNames(lt) {
+		job := &batchv1.Job{}
+		key := client.ObjectKey{Name: name, Namespace: lt.Namespace}
+		if err := r.Get(ctx, key, job); err != nil {
+			return fmt.Errorf("failed to get Job %s: %w", key.Name, err)
+		}
+		if !ptr.Deref(job.Spec.Suspend, false) {
+			continue
+		}
+
+		patch := client.MergeFrom(job.DeepCopy())
+		job.Spec.Suspend = ptr.To(false)
+		if err := r.Patch(ctx, job, patch); err != nil {
+			return fmt.Errorf("failed to unsuspend Job %s: %w", key.Name, err)
+		}
+	}
+	return nil
+}
+
+// startedMessage describes why a waiting test started.
+func startedMessage(lt *locustv2.LocustTest) string {
+	if lt.Spec.StartAt != nil {
+		return fmt.Sprintf("Started the test scheduled at %s", lt.Spec.StartAt.UTC().Format(time.RFC3339))
+	}
+	return "Started the suspended test"
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+)
+
+var waitingTestKey = types.NamespacedName{Name: "waiting-test", Namespace: "default"}
+
+func reconcileWaitingTest(t *testing.T, reconciler *LocustTestReconciler) (ctrl.Result, *locustv2.LocustTest) {
+	t.Helper()
+	result, err := reconciler.Reconcile(context.Background(), ctrl.Request{NamespacedName: waitingTestKey})
+	require.NoError(t, err)
+
+	lt := &locustv2.LocustTest{}
+	require.NoError(t, reconciler.Get(context.Background(), waitingTestKey, lt))
+	return result, lt
+}
+
+// assertJobsSuspended checks the Suspend field of the master and worker Jobs.
+func assertJobsSuspended(t *testing.T, reconciler *LocustTestReconciler, suspended bool) {
+	t.Helper()
+	for _, jobName := range []string{"waiting-test-master", "waiting-test-worker"} {
+		job := &batchv1.Job{}
+		require.NoError(t, reconciler.Get(context.Background(),
+			types.NamespacedName{Name: jobName, Namespace: "default"}, job))
+		assert.Equal(t, suspended, ptr.Deref(job.Spec.Suspend, false), "Job %s", jobName)
+	}
+}
+
+// updateWaitingTestSpec changes the spec of the test, like a user would.
+func updateWaitingTestSpec(t *testing.T, reconciler *LocustTestReconciler, mutate func(lt *locustv2.LocustTest)) {
+	t.Helper()
+	lt := &locustv2.LocustTest{}
+	require.NoError(t, reconciler.Get(context.Background(), waitingTestKey, lt))
+	mutate(lt)
+	require.NoError(t, reconciler.Update(context.Background(), lt))
+}
+
+func TestReconcile_SuspendedTest(t *testing.T) {
+	lt := newTestLocustTestCR("waiting-test", "default")
+	lt.Spec.Suspend = true
+	reconciler, recorder := newTestReconciler(lt)
+
+	_, updated := reconcileWaitingTest(t, reconciler)
+	assert.Equal(t, locustv2.PhaseSuspended, updated.Status.Phase)
+	assert.Nil(t, updated.Status.StartTime)
+	cond := findCondition(updated.Status.Conditions, locustv2.ConditionTypeTestCompleted)
+	require.NotNil(t, cond)
+	assert.Equal(t, locustv2.ReasonTestSuspended, cond.Reason)
+	assertJobsSuspended(t, reconciler, true)
+
+	// Nothing happens while the test stays suspended
+	_, updated = reconcileWaitingTest(t, reconciler)
+	assert.Equal(t, locustv2.PhaseSuspended, updated.Status.Phase)
+	assertJobsSuspended(t, reconciler, true)
+	drainEvents(recorder)
+
+	updateWaitingTestSpec(t, reconciler, func(lt *locustv2.LocustTest) { lt.Spec.Suspend = false })
+	_, updated = reconcileWaitingTest(t, reconciler)
+	assert.Equal(t, locustv2.PhaseRunning, updated.Status.Phase)
+	assert.NotNil(t, updated.Status.StartTime)
+	assertJobsSuspended(t, reconciler, false)
+	assert.Equal(t, "Normal Started Started the suspended test", <-recorder.Events)
+}
+
+func TestReconcile_ScheduledTest(t *testing.T) {
+	lt := newTestLocustTestCR("waiting-test", "default")
+	lt.Spec.StartAt = &metav1.Time{Time: time.Now().Add(time.Hour)}
+	reconciler, _ := newTestReconciler(lt)
+
+	result, updated := reconcileWaitingTest(t, reconciler)
+	assert.Equal(t, locustv2.PhaseScheduled, updated.Status.Phase)
+	assert.Greater(t, result.RequeueAfter, 59*time.Minute, "the controller wakes up at startAt")
+	assert.LessOrEqual(t, result.RequeueAfter, time.Hour)
+	cond := findCondition(updated.Status.Conditions, locustv2.ConditionTypeTestCompleted)
+	require.NotNil(t, cond)
+	assert.Equal(t, locustv2.ReasonTestScheduled, cond.Reason)
+	assertJobsSuspended(t, reconciler, true)
+
+	// The start time passes
+	updateWaitingTestSpec(t, reconciler, func(lt *locustv2.LocustTest) {
+		lt.Spec.StartAt = &metav1.Time{Time: time.Now().Add(-time.Second)}
+	})
+	_, updated = reconcileWaitingTest(t, reconciler)
+	assert.Equal(t, locustv2.PhaseRunning, updated.Status.Phase)
+	assertJobsSuspended(t, reconciler, false)
+}
+
+func TestReconcile_ScheduledAndSuspendedTest(t *testing.T) {
+	lt := newTestLocustTestCR("waiting-test", "default")
+	lt.Spec.Suspend = true
+	lt.Spec.StartAt = &metav1.Time{Time: time.Now().Add(time.Hour)}
+	reconciler, _ := newTestReconciler(lt)
+
+	_, updated := reconcileWaitingTest(t, reconciler)
+	assert.Equal(t, locustv2.PhaseSuspended, updated.Status.Phase)
+
+	// Clearing suspend leaves the test waiting for startAt
+	updateWaitingTestSpec(t, reconciler, func(lt *locustv2.LocustTest) { lt.Spec.Suspend = false })
+	_, updated = reconcileWaitingTest(t, reconciler)
+	assert.Equal(t, locustv2.PhaseScheduled, updated.Status.Phase)
+	assertJobsSuspended(t, reconciler, true)
+}
+
+func TestReconcile_StartAtInThePast(t *testing.T) {
+	lt := newTestLocustTestCR("waiting-test", "default")
+	lt.Spec.StartAt = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+	reconciler, _ := newTestReconciler(lt)
+
+	result, updated := reconcileWaitingTest(t, reconciler)
+	assert.Equal(t, locustv2.PhaseRunning, updated.Status.Phase)
+	assert.Zero(t, result.RequeueAfter)
+	assertJobsSuspended(t, reconciler, false)
+}
+
+func TestReconcile_StopSuspendedTest(t *testing.T) {
+	lt := newTestLocustTestCR("waiting-test", "default")
+	lt.Spec.Suspend = true
+	reconciler, _ := newTestReconciler(lt)
+	reconcileWaitingTest(t, reconciler)
+
+	updateWaitingTestSpec(t, reconciler, func(lt *locustv2.LocustTest) {
+		lt.Annotations = map[string]string{locustv2.AnnotationControl: string(locustv2.ControlStop)}
+	})
+	_, updated := reconcileWaitingTest(t, reconciler)
+	assert.Equal(t, locustv2.PhaseCancelled, updated.Status.Phase, "a test stopped before it started is cancelled")
+	assert.Nil(t, updated.Status.StartTime)
+}
+
+func TestWaitingPhase(t *testing.T) {
+	now := time.Date(2026, 10, 20, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		suspend bool
+		startAt *metav1.Time
+		want    locustv2.Phase
+	}{
+		{name: "NoDeferredStart", want: ""},
+		{name: "Suspended", suspend: true, want: locustv2.PhaseSuspended},
+		{name: "SuspendedBeforeStartAt", suspend: true, startAt: &metav1.Time{Time: now.Add(time.Hour)}, want: locustv2.PhaseSuspended},
+		{name: "BeforeStartAt", startAt: &metav1.Time{Time: now.Add(time.Hour)}, want: locustv2.PhaseScheduled},
+		{name: "AtStartAt", startAt: &metav1.Time{Time: now}, want: ""},
+		{name: "AfterStartAt", startAt: &metav1.Time{Time: now.Add(-time.Hour)}, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lt := newTestLocustTestCR("waiting-test", "default")
+			lt.Spec.Suspend = tt.suspend
+			lt.Spec.StartAt = tt.startAt
+
+			assert.Equal(t, tt.want, waitingPhase(lt, now))
+		})
+	}
+}
+
+func TestUpdateStatusFromJobs_NoSpecDriftedForSuspendedJobs(t *testing.T) {
+	lt := newTestLocustTestCR("waiting-test", "default")
+	lt.Generation = 2 // spec.suspend was cleared
+	lt.Status.Phase = locustv2.PhaseRunning
+	reconciler, _ := newTestReconciler(lt)
+	masterJob := &batchv1.Job{
+		Spec:   batchv1.JobSpec{Suspend: ptr.To(false)},
+		Status: batchv1.JobStatus{Active: 1},
+	}
+
+	err := reconciler.updateStatusFromJobs(context.Background(), lt, masterJob, nil, healthyPodStatus(), nil)
+	require.NoError(t, err)
+	assert.Nil(t, findCondition(lt.Status.Conditions, locustv2.ConditionTypeSpecDrifted))
+}
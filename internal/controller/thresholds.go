@@ -0,0 +1,223 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/resources"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/stats"
+)
+
+// Sources of the statistics a threshold verdict is based on, quoted in the
+// ThresholdsMet condition.
+const (
+	// thresholdsSourcePoll is the last poll of the master, which misses the
+	// requests made until the master exits, up to a StatsPollInterval.
+	thresholdsSourcePoll = "last statistics read from the master; later requests are not counted"
+	// thresholdsSourceFinal is the stats CSV Locust writes when it exits.
+	thresholdsSourceFinal = "final statistics"
+)
+
+// checkThresholds reads the master's cumulative statistics and updates the
+// ThresholdsMet condition in memory. Failing to reach the master keeps the
+// previous verdict, so the condition reflects the last statistics seen before
+// the master exits, unless checkFinalThresholds replaces it. A verdict based
+// on the final statistics is never replaced.
+func (r *LocustTestReconciler) checkThresholds(ctx context.Context, lt *locustv2.LocustTest) {
+	log := logf.FromContext(ctx)
+
+	if lt.Spec.Thresholds == nil || judgedOnFinalStats(lt) {
+		return
+	}
+
+	rows, err := r.StatsFetcher.RequestStats(ctx, resources.MasterWebUIURL(lt))
+	if err != nil {
+		log.V(1).Info("Master statistics unavailable, keeping previous threshold verdict", "error", err.Error())
+		return
+	}
+
+	r.setThresholdsVerdict(lt, rows, thresholdsSourcePoll)
+}
+
+// checkFinalThresholds judges the thresholds against the stats CSV Locust
+// wrote when it exited, read from the results collector, so the requests made
+// after the last poll count too. The CSV is taken from files when already
+// fetched. Without it, the verdict of the last poll is kept.
+func (r *LocustTestReconciler) checkFinalThresholds(ctx context.Context, lt *locustv2.LocustTest, collectorURL string, files []resultFile) {
+	log := logf.FromContext(ctx)
+
+	if !resources.JudgesFinalStats(lt) {
+		return
+	}
+
+	var content []byte
+	for _, file := range files {
+		if file.name == resources.ResultsStatsFileName {
+			content = file.content
+		}
+	}
+	if content == nil {
+		var err error
+		content, err = r.ResultsFetcher.File(ctx, collectorURL, resources.ResultsStatsFileName, maxResultsUploadBytes)
+		if err != nil {
+			log.Info("Final statistics unavailable, keeping the threshold verdict of the last poll", "error", err.Error())
+			return
+		}
+	}
+
+	rows, err := stats.ParseRequestsCSV(bytes.NewReader(content))
+	if err != nil {
+		log.Info("Invalid final statistics, keeping the threshold verdict of the last poll", "error", err.Error())
+		return
+	}
+	r.setThresholdsVerdict(lt, rows, thresholdsSourceFinal)
+}
+
+// judgedOnFinalStats reports whether the ThresholdsMet condition holds the
+// verdict of checkFinalThresholds.
+func judgedOnFinalStats(lt *locustv2.LocustTest) bool {
+	cond := meta.FindStatusCondition(lt.Status.Conditions, locustv2.ConditionTypeThresholdsMet)
+	return cond != nil && strings.HasSuffix(cond.Message, "("+thresholdsSourceFinal+")")
+}
+
+// setThresholdsVerdict sets the ThresholdsMet condition from rows, naming
+// source in its message. Nothing is judged until the first requests were made.
+func (r *LocustTestReconciler) setThresholdsVerdict(lt *locustv2.LocustTest, rows []stats.RequestStats, source string) {
+	if total := stats.Aggregated(rows); total == nil || total.Requests == 0 {
+		return
+	}
+
+	if breaches := evaluateThresholds(lt.Spec.Thresholds, rows); len(breaches) > 0 {
+		r.setCondition(lt, locustv2.ConditionTypeThresholdsMet,
+			metav1.ConditionFalse, locustv2.ReasonThresholdsBreached,
+			fmt.Sprintf("Thresholds breached: %s (%s)", strings.Join(breaches, "; "), source))
+	} else {
+		r.setCondition(lt, locustv2.ConditionTypeThresholdsMet,
+			metav1.ConditionTrue, locustv2.ReasonThresholdsMet,
+			fmt.Sprintf("All thresholds met (%s)", source))
+	}
+}
+
+// applyThresholdsVerdict decides the terminal phase of a test with thresholds.
+// A test that finished cleanly but breached its thresholds is Failed. A test
+// whose thresholds were never evaluated keeps its phase, with the condition
+// set to Unknown so pipelines can tell "met" from "not checked".
+func (r *LocustTestReconciler) applyThresholdsVerdict(lt *locustv2.LocustTest, phase locustv2.Phase) locustv2.Phase {
+	if lt.Spec.Thresholds == nil || (phase != locustv2.PhaseSucceeded && phase != locustv2.PhaseFailed) {
+		return phase
+	}
+
+	cond := meta.FindStatusCondition(lt.Status.Conditions, locustv2.ConditionTypeThresholdsMet)
+	switch {
+	case cond != nil && cond.Status == metav1.ConditionFalse:
+		r.Recorder.Event(lt, corev1.EventTypeWarning, locustv2.ReasonThresholdsBreached, cond.Message)
+		return locustv2.PhaseFailed
+	case cond == nil || cond.Status == metav1.ConditionUnknown:
+		msg := "Thresholds were not evaluated: no statistics were read from the master before the test finished"
+		r.setCondition(lt, locustv2.ConditionTypeThresholdsMet,
+			metav1.ConditionUnknown, locustv2.ReasonStatisticsUnavailable, msg)
+		r.Recorder.Event(lt, corev1.EventTypeWarning, locustv2.ReasonStatisticsUnavailable, msg)
+	}
+
+	return phase
+}
+
+// thresholdsBreached reports whether the test failed because of its thresholds.
+func thresholdsBreached(lt *locustv2.LocustTest) bool {
+	return lt.Spec.Thresholds != nil &&
+		meta.IsStatusConditionFalse(lt.Status.Conditions, locustv2.ConditionTypeThresholdsMet)
+}
+
+// evaluateThresholds checks the statistics against the thresholds and
+// returns a description of every breached limit.
+func evaluateThresholds(th *locustv2.ThresholdsConfig, rows []stats.RequestStats) []string {
+	var breaches []string
+
+	if total := stats.Aggregated(rows); total != nil {
+		breaches = append(breaches, checkLimits(th.ThresholdLimits, *total, "")...)
+	}
+
+	for _, ep := range th.Endpoints {
+		label := strings.TrimSpace(ep.Method + " " + ep.Name)
+		breaches = append(breaches, checkLimits(ep.ThresholdLimits, endpointStats(rows, ep), " ("+label+")")...)
+	}
+
+	return breaches
+}
+
+// endpointStats combines the rows matching an endpoint. With no method set,
+// an endpoint called with several methods has several rows; percentiles can't
+// be merged exactly, so the highest one is used.
+func endpointStats(rows []stats.RequestStats, ep locustv2.EndpointThresholds) stats.RequestStats {
+	var combined stats.RequestStats
+	for _, row := range rows {
+		if row.Name != ep.Name || (row.Name == stats.AggregatedName && row.Method == "") {
+			continue
+		}
+		if ep.Method != "" && !strings.EqualFold(row.Method, ep.Method) {
+			continue
+		}
+		combined.Requests += row.Requests
+		combined.Failures += row.Failures
+		combined.RPS += row.RPS
+		combined.P95 = max(combined.P95, row.P95)
+		combined.P99 = max(combined.P99, row.P99)
+	}
+	return combined
+}
+
+// checkLimits returns the breached limits of one group of requests.
+func checkLimits(limits locustv2.ThresholdLimits, s stats.RequestStats, suffix string) []string {
+	var breaches []string
+
+	if limits.MaxFailureRatio != nil {
+		if maxRatio := limits.MaxFailureRatio.AsApproximateFloat64(); s.FailureRatio() > maxRatio {
+			breaches = append(breaches, fmt.Sprintf("failure ratio %s > %s%s",
+				formatFloat(s.FailureRatio()), formatFloat(maxRatio), suffix))
+		}
+	}
+	if limits.MaxP95ResponseTime != nil && s.P95 > limits.MaxP95ResponseTime.Duration {
+		breaches = append(breaches, fmt.Sprintf("p95 %s > %s%s", s.P95, limits.MaxP95ResponseTime.Duration, suffix))
+	}
+	if limits.MaxP99ResponseTime != nil && s.P99 > limits.MaxP99ResponseTime.Duration {
+		breaches = append(breaches, fmt.Sprintf("p99 %s > %s%s", s.P99, limits.MaxP99ResponseTime.Duration, suffix))
+	}
+	if limits.MinRPS != nil {
+		if minRPS := limits.MinRPS.AsApproximateFloat64(); s.RPS < minRPS {
+			breaches = append(breaches, fmt.Sprintf("rps %s < %s%s", formatFloat(s.RPS), formatFloat(minRPS), suffix))
+		}
+	}
+
+	return breaches
+}
+
+// formatFloat renders a ratio or rate with at most four decimals.
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(math.Round(f*1e4)/1e4, 'f', -1, 64)
+}
@@ -0,0 +1,383 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/stats"
+)
+
+// fakeStatsFetcher is a stats.Fetcher returning canned statistics.
+type fakeStatsFetcher struct {
+	rows    []stats.RequestStats
+	report  *stats.Report
+	err     error
+	baseURL string
+	calls   int
+}
+
+func (f *fakeStatsFetcher) RequestStats(_ context.Context, baseURL string) ([]stats.RequestStats, error) {
+	f.calls++
+	f.baseURL = baseURL
+	return f.rows, f.err
+}
+
+func (f *fakeStatsFetcher) Report(_ context.Context, baseURL string) (*stats.Report, error) {
+	f.calls++
+	f.baseURL = baseURL
+	if f.err != nil {
+		return nil, f.err
+	}
+	if f.report == nil {
+		return &stats.Report{}, nil
+	}
+	return f.report, nil
+}
+
+// newTestStatsRows returns statistics for two endpoints and the aggregated row.
+func newTestStatsRows() []stats.RequestStats {
+	return []stats.RequestStats{
+		{Method: "GET", Name: "/", Requests: 900, Failures: 9, RPS: 90, P95: 120 * time.Millisecond, P99: 300 * time.Millisecond},
+		{Method: "POST", Name: "/checkout", Requests: 100, Failures: 40, RPS: 10, P95: 900 * time.Millisecond, P99: 2 * time.Second},
+		{Method: "", Name: stats.AggregatedName, Requests: 1000, Failures: 49, RPS: 100, P95: 400 * time.Millisecond, P99: 1500 * time.Millisecond},
+	}
+}
+
+func TestEvaluateThresholds_Met(t *testing.T) {
+	th := &locustv2.ThresholdsConfig{
+		ThresholdLimits: locustv2.ThresholdLimits{
+			MaxFailureRatio:    ptr.To(resource.MustParse("0.05")),
+			MaxP95ResponseTime: &metav1.Duration{Duration: 500 * time.Millisecond},
+			MaxP99ResponseTime: &metav1.Duration{Duration: 2 * time.Second},
+			MinRPS:             ptr.To(resource.MustParse("50")),
+		},
+		Endpoints: []locustv2.EndpointThresholds{
+			{Name: "/", Method: "get", ThresholdLimits: locustv2.ThresholdLimits{
+				MaxFailureRatio: ptr.To(resource.MustParse("0.01")),
+			}},
+		},
+	}
+
+	assert.Empty(t, evaluateThresholds(th, newTestStatsRows()))
+}
+
+func TestEvaluateThresholds_Breached(t *testing.T) {
+	th := &locustv2.ThresholdsConfig{
+		ThresholdLimits: locustv2.ThresholdLimits{
+			MaxFailureRatio:    ptr.To(resource.MustParse("0.01")),
+			MaxP95ResponseTime: &metav1.Duration{Duration: 200 * time.Millisecond},
+			MinRPS:             ptr.To(resource.MustParse("150")),
+		},
+		Endpoints: []locustv2.EndpointThresholds{
+			{Name: "/checkout", ThresholdLimits: locustv2.ThresholdLimits{
+				MaxP99ResponseTime: &metav1.Duration{Duration: time.Second},
+			}},
+			{Name: "/missing", ThresholdLimits: locustv2.ThresholdLimits{
+				MinRPS: ptr.To(resource.MustParse("1")),
+			}},
+		},
+	}
+
+	assert.Equal(t, []string{
+		"failure ratio 0.049 > 0.01",
+		"p95 400ms > 200ms",
+		"rps 100 < 150",
+		"p99 2s > 1s (/checkout)",
+		"rps 0 < 1 (/missing)",
+	}, evaluateThresholds(th, newTestStatsRows()))
+}
+
+func TestEvaluateThresholds_EndpointCombinesMethods(t *testing.T) {
+	rows := []stats.RequestStats{
+		{Method: "GET", Name: "/items", Requests: 10, Failures: 0, RPS: 1, P95: 100 * time.Millisecond},
+		{Method: "POST", Name: "/items", Requests: 10, Failures: 10, RPS: 1, P95: 300 * time.Millisecond},
+	}
+	th := &locustv2.ThresholdsConfig{
+		Endpoints: []locustv2.EndpointThresholds{
+			{Name: "/items", ThresholdLimits: locustv2.ThresholdLimits{
+				MaxFailureRatio:    ptr.To(resource.MustParse("0.4")),
+				MaxP95ResponseTime: &metav1.Duration{Duration: 200 * time.Millisecond},
+			}},
+		},
+	}
+
+	assert.Equal(t, []string{
+		"failure ratio 0.5 > 0.4 (/items)",
+		"p95 300ms > 200ms (/items)",
+	}, evaluateThresholds(th, rows))
+}
+
+// newTestThresholdsLocustTest returns a Running test with a failure ratio threshold.
+func newTestThresholdsLocustTest() *locustv2.LocustTest {
+	lt := newTestLocustTestCR("slo-test", "default")
+	lt.Spec.Thresholds = &locustv2.ThresholdsConfig{
+		ThresholdLimits: locustv2.ThresholdLimits{
+			MaxFailureRatio: ptr.To(resource.MustParse("0.01")),
+		},
+	}
+	lt.Status.Phase = locustv2.PhaseRunning
+	lt.Status.ExpectedWorkers = 3
+	return lt
+}
+
+func completedJob() *batchv1.Job {
+	return &batchv1.Job{
+		Status: batchv1.JobStatus{
+			Conditions: []batchv1.JobCondition{
+				{Type: batchv1.JobComplete, Status: corev1.ConditionTrue},
+			},
+		},
+	}
+}
+
+func TestCheckThresholds_Breached(t *testing.T) {
+	lt := newTestThresholdsLocustTest()
+	reconciler, _ := newTestReconciler(lt)
+	fetcher := &fakeStatsFetcher{rows: newTestStatsRows()}
+	reconciler.StatsFetcher = fetcher
+
+	reconciler.checkThresholds(context.Background(), lt)
+	assert.Equal(t, "http://slo-test-master.default.svc:8089", fetcher.baseURL)
+
+	cond := findCondition(lt.Status.Conditions, locustv2.ConditionTypeThresholdsMet)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, locustv2.ReasonThresholdsBreached, cond.Reason)
+	assert.Equal(t, "Thresholds breached: failure ratio 0.049 > 0.01 "+
+		"(last statistics read from the master; later requests are not counted)", cond.Message)
+}
+
+func TestCheckThresholds_NoRequestsYet(t *testing.T) {
+	lt := newTestThresholdsLocustTest()
+	reconciler, _ := newTestReconciler(lt)
+	reconciler.StatsFetcher = &fakeStatsFetcher{rows: []stats.RequestStats{{Name: stats.AggregatedName}}}
+
+	reconciler.checkThresholds(context.Background(), lt)
+	assert.Nil(t, findCondition(lt.Status.Conditions, locustv2.ConditionTypeThresholdsMet),
+		"no verdict before the first request")
+}
+
+func TestCheckThresholds_MasterUnreachableKeepsVerdict(t *testing.T) {
+	lt := newTestThresholdsLocustTest()
+	reconciler, _ := newTestReconciler(lt)
+	reconciler.setCondition(lt, locustv2.ConditionTypeThresholdsMet,
+		metav1.ConditionTrue, locustv2.ReasonThresholdsMet, "All thresholds met")
+	reconciler.StatsFetcher = &fakeStatsFetcher{err: errors.New("connection refused")}
+
+	reconciler.checkThresholds(context.Background(), lt)
+	cond := findCondition(lt.Status.Conditions, locustv2.ConditionTypeThresholdsMet)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+}
+
+func TestCheckThresholds_SkippedWithoutThresholds(t *testing.T) {
+	lt := newTestThresholdsLocustTest()
+	lt.Spec.Thresholds = nil
+	reconciler, _ := newTestReconciler(lt)
+	fetcher := &fakeStatsFetcher{rows: newTestStatsRows()}
+	reconciler.StatsFetcher = fetcher
+
+	reconciler.checkThresholds(context.Background(), lt)
+	assert.Zero(t, fetcher.calls)
+}
+
+// testFinalStatsCSV is a locust_stats.csv with a failure ratio of 0.049.
+const testFinalStatsCSV = `Type,Name,Request Count,Failure Count,Requests/s,95%,99%
+GET,/,900,9,90,120,300
+POST,/checkout,100,40,10,900,2000
+,Aggregated,1000,49,100,400,1500
+`
+
+// newTestFinalThresholdsLocustTest returns a test whose poll found the
+// thresholds met, collecting its results as CSV.
+func newTestFinalThresholdsLocustTest(reconciler *LocustTestReconciler) *locustv2.LocustTest {
+	lt := newTestThresholdsLocustTest()
+	lt.Spec.Results = &locustv2.ResultsConfig{Formats: []locustv2.ResultsFormat{locustv2.ResultsFormatCSV}}
+	reconciler.setCondition(lt, locustv2.ConditionTypeThresholdsMet,
+		metav1.ConditionTrue, locustv2.ReasonThresholdsMet, "All thresholds met")
+	return lt
+}
+
+func TestCheckFinalThresholds_OverridesPollVerdict(t *testing.T) {
+	reconciler, _ := newTestReconciler()
+	lt := newTestFinalThresholdsLocustTest(reconciler)
+	fetcher := &fakeResultsFetcher{}
+	reconciler.ResultsFetcher = fetcher
+
+	files := []resultFile{{name: "locust_stats.csv", content: []byte(testFinalStatsCSV)}}
+	reconciler.checkFinalThresholds(context.Background(), lt, "http://10.0.0.7:8090", files)
+	assert.Empty(t, fetcher.baseURL, "the fetched CSV is reused")
+
+	cond := findCondition(lt.Status.Conditions, locustv2.ConditionTypeThresholdsMet)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, "Thresholds breached: failure ratio 0.049 > 0.01 (final statistics)", cond.Message)
+}
+
+func TestCheckThresholds_KeepsFinalVerdict(t *testing.T) {
+	reconciler, _ := newTestReconciler()
+	lt := newTestFinalThresholdsLocustTest(reconciler)
+	reconciler.ResultsFetcher = &fakeResultsFetcher{files: map[string]string{"locust_stats.csv": testFinalStatsCSV}}
+	// The last poll saw the thresholds met, the final statistics breach them
+	reconciler.StatsFetcher = &fakeStatsFetcher{rows: []stats.RequestStats{
+		{Method: "GET", Name: "/", Requests: 900, RPS: 90},
+		{Name: stats.AggregatedName, Requests: 900, RPS: 90},
+	}}
+	ctx := context.Background()
+
+	reconciler.checkFinalThresholds(ctx, lt, "http://10.0.0.7:8090", nil)
+	reconciler.checkThresholds(ctx, lt)
+
+	cond := findCondition(lt.Status.Conditions, locustv2.ConditionTypeThresholdsMet)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status, "a later poll doesn't replace the final verdict")
+	assert.Equal(t, "Thresholds breached: failure ratio 0.049 > 0.01 (final statistics)", cond.Message)
+	assert.Equal(t, locustv2.PhaseFailed, reconciler.applyThresholdsVerdict(lt, locustv2.PhaseSucceeded))
+}
+
+func TestCheckFinalThresholds_FetchesStats(t *testing.T) {
+	reconciler, _ := newTestReconciler()
+	lt := newTestFinalThresholdsLocustTest(reconciler)
+	fetcher := &fakeResultsFetcher{files: map[string]string{"locust_stats.csv": testFinalStatsCSV}}
+	reconciler.ResultsFetcher = fetcher
+
+	reconciler.checkFinalThresholds(context.Background(), lt, "http://10.0.0.7:8090", nil)
+	assert.Equal(t, "http://10.0.0.7:8090", fetcher.baseURL)
+
+	cond := findCondition(lt.Status.Conditions, locustv2.ConditionTypeThresholdsMet)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+}
+
+func TestCheckFinalThresholds_KeepsPollVerdict(t *testing.T) {
+	tests := []struct {
+		name    string
+		formats []locustv2.ResultsFormat
+		fetcher *fakeResultsFetcher
+	}{
+		{
+			name:    "NoCSV",
+			formats: []locustv2.ResultsFormat{locustv2.ResultsFormatHTML},
+			fetcher: &fakeResultsFetcher{files: map[string]string{"locust_stats.csv": testFinalStatsCSV}},
+		},
+		{
+			name:    "NotWritten",
+			formats: []locustv2.ResultsFormat{locustv2.ResultsFormatCSV},
+			fetcher: &fakeResultsFetcher{},
+		},
+		{
+			name:    "CollectorUnreachable",
+			formats: []locustv2.ResultsFormat{locustv2.ResultsFormatCSV},
+			fetcher: &fakeResultsFetcher{err: errors.New("connection refused")},
+		},
+		{
+			name:    "Invalid",
+			formats: []locustv2.ResultsFormat{locustv2.ResultsFormatCSV},
+			fetcher: &fakeResultsFetcher{files: map[string]string{"locust_stats.csv": "Type,Name\n"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reconciler, _ := newTestReconciler()
+			lt := newTestFinalThresholdsLocustTest(reconciler)
+			lt.Spec.Results.Formats = tt.formats
+			reconciler.ResultsFetcher = tt.fetcher
+
+			reconciler.checkFinalThresholds(context.Background(), lt, "http://10.0.0.7:8090", nil)
+			cond := findCondition(lt.Status.Conditions, locustv2.ConditionTypeThresholdsMet)
+			require.NotNil(t, cond)
+			assert.Equal(t, metav1.ConditionTrue, cond.Status)
+			assert.Equal(t, "All thresholds met", cond.Message)
+		})
+	}
+}
+
+func TestUpdateStatusFromJobs_ThresholdsBreached_MarksFailed(t *testing.T) {
+	lt := newTestThresholdsLocustTest()
+	reconciler, recorder := newTestReconciler(lt)
+	reconciler.setCondition(lt, locustv2.ConditionTypeThresholdsMet,
+		metav1.ConditionFalse, locustv2.ReasonThresholdsBreached, "Thresholds breached: failure ratio 0.4 > 0.01")
+
+	err := reconciler.updateStatusFromJobs(context.Background(), lt, completedJob(), nil, healthyPodStatus(), nil)
+	require.NoError(t, err)
+
+	updated := &locustv2.LocustTest{}
+	require.NoError(t, reconciler.Get(context.Background(), client.ObjectKeyFromObject(lt), updated))
+	assert.Equal(t, locustv2.PhaseFailed, updated.Status.Phase, "a clean exit with breached thresholds fails")
+	completed := findCondition(updated.Status.Conditions, locustv2.ConditionTypeTestCompleted)
+	require.NotNil(t, completed)
+	assert.Equal(t, "Test failed: thresholds breached", completed.Message)
+
+	events := []string{<-recorder.Events, <-recorder.Events}
+	assert.Contains(t, events[0], "Warning ThresholdsBreached Thresholds breached: failure ratio 0.4 > 0.01")
+	assert.Contains(t, events[1], "TestFailed")
+}
+
+func TestUpdateStatusFromJobs_ThresholdsMet_Succeeds(t *testing.T) {
+	lt := newTestThresholdsLocustTest()
+	reconciler, _ := newTestReconciler(lt)
+	reconciler.setCondition(lt, locustv2.ConditionTypeThresholdsMet,
+		metav1.ConditionTrue, locustv2.ReasonThresholdsMet, "All thresholds met")
+
+	err := reconciler.updateStatusFromJobs(context.Background(), lt, completedJob(), nil, healthyPodStatus(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, locustv2.PhaseSucceeded, lt.Status.Phase)
+}
+
+func TestUpdateStatusFromJobs_ThresholdsNotEvaluated(t *testing.T) {
+	lt := newTestThresholdsLocustTest()
+	reconciler, recorder := newTestReconciler(lt)
+	reconciler.initializeStatus(lt)
+	lt.Status.Phase = locustv2.PhaseRunning
+
+	err := reconciler.updateStatusFromJobs(context.Background(), lt, completedJob(), nil, healthyPodStatus(), nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, locustv2.PhaseSucceeded, lt.Status.Phase, "phase follows the Job when nothing was evaluated")
+	cond := findCondition(lt.Status.Conditions, locustv2.ConditionTypeThresholdsMet)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionUnknown, cond.Status)
+	assert.Equal(t, locustv2.ReasonStatisticsUnavailable, cond.Reason)
+	assert.Contains(t, <-recorder.Events, "Warning StatisticsUnavailable")
+}
+
+func TestInitializeStatus_ThresholdsPending(t *testing.T) {
+	lt := newTestThresholdsLocustTest()
+	reconciler, _ := newTestReconciler(lt)
+
+	reconciler.initializeStatus(lt)
+
+	cond := findCondition(lt.Status.Conditions, locustv2.ConditionTypeThresholdsMet)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionUnknown, cond.Status)
+	assert.Equal(t, locustv2.ReasonThresholdsPending, cond.Reason)
+}
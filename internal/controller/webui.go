@@ -0,0 +1,95 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/resources"
+)
+
+// createWebUI creates the web UI Service and its Ingress or HTTPRoute, and
+// returns the URL to publish in status.webUIURL. Returns "" unless the web UI
+// is enabled.
+//
+// Without the Gateway API CRDs the HTTPRoute can't be created; the test still
+// runs, a Warning event explains why, and the Service URL is published instead.
+func (r *LocustTestReconciler) createWebUI(ctx context.Context, lt *locustv2.LocustTest) (string, error) {
+	log := logf.FromContext(ctx)
+
+	service := resources.BuildWebUIService(lt)
+	if service == nil {
+		return "", nil
+	}
+	if err := r.createResource(ctx, lt, service, "Service"); err != nil {
+		return "", err
+	}
+	log.V(1).Info("Web UI Service reconciled", "name", service.Name)
+
+	if ingress := resources.BuildWebUIIngress(lt); ingress != nil {
+		if err := r.createResource(ctx, lt, ingress, "Ingress"); err != nil {
+			return "", err
+		}
+		log.V(1).Info("Web UI Ingress reconciled", "name", ingress.Name)
+	}
+
+	if route := resources.BuildWebUIHTTPRoute(lt); route != nil {
+		if err := r.createResource(ctx, lt, route, "HTTPRoute"); err != nil {
+			if !meta.IsNoMatchError(err) {
+				return "", err
+			}
+			r.Recorder.Event(lt, corev1.EventTypeWarning, "WebUIRouteFailed",
+				fmt.Sprintf("Cannot create HTTPRoute %s: the Gateway API CRDs are not installed", route.GetName()))
+			return resources.WebUIServiceURL(lt), nil
+		}
+		log.V(1).Info("Web UI HTTPRoute reconciled", "name", route.GetName())
+	}
+
+	return resources.WebUIURL(lt, nil), nil
+}
+
+// recordWebUIURL publishes the external address of a LoadBalancer web UI
+// Service in status.webUIURL once the load balancer is provisioned. Ingress
+// and HTTPRoute URLs are known up front and set when the test is created.
+// The status is changed in memory only.
+func (r *LocustTestReconciler) recordWebUIURL(ctx context.Context, lt *locustv2.LocustTest) {
+	if !resources.IsWebUIEnabled(lt) {
+		return
+	}
+	webUI := lt.Spec.WebUI
+	if webUI.ServiceType != corev1.ServiceTypeLoadBalancer || webUI.Ingress != nil || webUI.HTTPRoute != nil {
+		return
+	}
+
+	service := &corev1.Service{}
+	if err := r.Get(ctx, client.ObjectKey{Name: resources.WebUIName(lt.Name), Namespace: lt.Namespace}, service); err != nil {
+		logf.FromContext(ctx).V(1).Info("Failed to get the web UI Service", "error", err.Error())
+		return
+	}
+
+	if url := resources.WebUIURL(lt, service); url != lt.Status.WebUIURL {
+		lt.Status.WebUIURL = url
+		r.Recorder.Event(lt, corev1.EventTypeNormal, "WebUIReady", "Web UI available at "+url)
+	}
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/resources"
+)
+
+func newTestWebUILocustTest(webUI *locustv2.WebUIConfig) *locustv2.LocustTest {
+	lt := newTestLocustTestCR("webui-test", "default")
+	lt.Spec.WebUI = webUI
+	return lt
+}
+
+func reconcileWebUITest(t *testing.T, reconciler *LocustTestReconciler) *locustv2.LocustTest {
+	t.Helper()
+	key := types.NamespacedName{Name: "webui-test", Namespace: "default"}
+	_, err := reconciler.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+
+	updated := &locustv2.LocustTest{}
+	require.NoError(t, reconciler.Get(context.Background(), key, updated))
+	return updated
+}
+
+func TestReconcile_WithWebUIIngress(t *testing.T) {
+	lt := newTestWebUILocustTest(&locustv2.WebUIConfig{
+		Enabled: true,
+		Ingress: &locustv2.WebUIIngressConfig{Host: "load.example.com", TLSSecretName: "load-tls"},
+	})
+	reconciler, _ := newTestReconciler(lt)
+
+	updated := reconcileWebUITest(t, reconciler)
+	assert.Equal(t, "https://load.example.com/", updated.Status.WebUIURL)
+
+	service := &corev1.Service{}
+	require.NoError(t, reconciler.Get(context.Background(),
+		types.NamespacedName{Name: "webui-test-webui", Namespace: "default"}, service))
+	require.Len(t, service.OwnerReferences, 1)
+	assert.Equal(t, "webui-test", service.OwnerReferences[0].Name)
+
+	ingress := &networkingv1.Ingress{}
+	require.NoError(t, reconciler.Get(context.Background(),
+		types.NamespacedName{Name: "webui-test-webui", Namespace: "default"}, ingress))
+	require.Len(t, ingress.OwnerReferences, 1)
+	assert.Equal(t, "webui-test", ingress.OwnerReferences[0].Name)
+}
+
+func TestReconcile_WithoutWebUI(t *testing.T) {
+	reconciler, _ := newTestReconciler(newTestWebUILocustTest(nil))
+
+	updated := reconcileWebUITest(t, reconciler)
+	assert.Empty(t, updated.Status.WebUIURL)
+
+	err := reconciler.Get(context.Background(),
+		types.NamespacedName{Name: "webui-test-webui", Namespace: "default"}, &corev1.Service{})
+	assert.True(t, apierrors.IsNotFound(err), "no web UI Service without webUI")
+}
+
+func TestReconcile_WithWebUIHTTPRoute(t *testing.T) {
+	lt := newTestWebUILocustTest(&locustv2.WebUIConfig{
+		Enabled: true,
+		HTTPRoute: &locustv2.WebUIHTTPRouteConfig{
+			ParentRefs: []locustv2.GatewayParentReference{{Name: "public", Namespace: "gateways"}},
+			Hostnames:  []string{"load.example.com"},
+		},
+	})
+	reconciler, _ := newTestReconciler(lt)
+
+	updated := reconcileWebUITest(t, reconciler)
+	assert.Equal(t, "http://load.example.com/", updated.Status.WebUIURL)
+
+	route := &unstructured.Unstructured{}
+	route.SetGroupVersionKind(resources.HTTPRouteGVK)
+	require.NoError(t, reconciler.Get(context.Background(),
+		types.NamespacedName{Name: "webui-test-webui", Namespace: "default"}, route))
+	require.Len(t, route.GetOwnerReferences(), 1)
+	assert.Equal(t, "webui-test", route.GetOwnerReferences()[0].Name)
+}
+
+func TestReconcile_WithWebUIHTTPRoute_NoGatewayAPI(t *testing.T) {
+	lt := newTestWebUILocustTest(&locustv2.WebUIConfig{
+		Enabled: true,
+		HTTPRoute: &locustv2.WebUIHTTPRouteConfig{
+			ParentRefs: []locustv2.GatewayParentReference{{Name: "public"}},
+			Hostnames:  []string{"load.example.com"},
+		},
+	})
+	reconciler, recorder := newTestReconciler()
+	// Reject HTTPRoutes like a cluster without the Gateway API CRDs
+	reconciler.Client = fake.NewClientBuilder().
+		WithScheme(reconciler.Scheme).
+		WithObjects(lt).
+		WithStatusSubresource(&locustv2.LocustTest{}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				if obj.GetObjectKind().GroupVersionKind() == resources.HTTPRouteGVK {
+					return &meta.NoKindMatchError{GroupKind: resources.HTTPRouteGVK.GroupKind()}
+				}
+				return c.Create(ctx, obj, opts...)
+			},
+		}).
+		Build()
+
+	updated := reconcileWebUITest(t, reconciler)
+	assert.Equal(t, "http://webui-test-webui.default.svc:8089/", updated.Status.WebUIURL)
+	var events []string
+	for len(recorder.Events) > 0 {
+		events = append(events, <-recorder.Events)
+	}
+	assert.Contains(t, events,
+		"Warning WebUIRouteFailed Cannot create HTTPRoute webui-test-webui: the Gateway API CRDs are not installed")
+}
+
+func TestRecordWebUIURL_LoadBalancer(t *testing.T) {
+	lt := newTestWebUILocustTest(&locustv2.WebUIConfig{Enabled: true, ServiceType: corev1.ServiceTypeLoadBalancer})
+	lt.Status.WebUIURL = resources.WebUIServiceURL(lt)
+	service := resources.BuildWebUIService(lt)
+
+	// Not provisioned yet
+	reconciler, recorder := newTestReconciler(lt, service)
+	reconciler.recordWebUIURL(context.Background(), lt)
+	assert.Equal(t, "http://webui-test-webui.default.svc:8089/", lt.Status.WebUIURL)
+	assert.Empty(t, recorder.Events)
+
+	service.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{{IP: "203.0.113.10"}}
+	reconciler, recorder = newTestReconciler(lt, service)
+	reconciler.recordWebUIURL(context.Background(), lt)
+	assert.Equal(t, "http://203.0.113.10:8089/", lt.Status.WebUIURL)
+	assert.Equal(t, "Normal WebUIReady Web UI available at http://203.0.113.10:8089/", <-recorder.Events)
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/resources"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/stats"
+)
+
+// newWorkerGroupStatuses returns the per-group status of a test with
+// spec.workerGroups, no workers connected yet. The workers of spec.worker
+// come first, as the "default" group.
+func newWorkerGroupStatuses(lt *locustv2.LocustTest) []locustv2.WorkerGroupStatus {
+	groups := []locustv2.WorkerGroupStatus{{
+		Name:     locustv2.DefaultWorkerGroup,
+		Expected: lt.Spec.Worker.Replicas,
+	}}
+	for _, group := range lt.Spec.WorkerGroups {
+		groups = append(groups, locustv2.WorkerGroupStatus{Name: group.Name, Expected: group.Replicas})
+	}
+	return groups
+}
+
+// recordWorkerGroups counts the workers registered with the master per
+// worker group, and sets the group of every listed worker. A worker's group
+// is told from its ID, which starts with its pod's name: "<job>-<suffix>".
+// The status is changed in memory only.
+func recordWorkerGroups(lt *locustv2.LocustTest, report *stats.Report) {
+	if len(lt.Spec.WorkerGroups) == 0 {
+		return
+	}
+
+	groupByJob := map[string]string{
+		resources.NodeName(lt.Name, resources.Worker): locustv2.DefaultWorkerGroup,
+	}
+	for _, group := range lt.Spec.WorkerGroups {
+		groupByJob[resources.WorkerGroupNodeName(lt.Name, group.Name)] = group.Name
+	}
+
+	groups := newWorkerGroupStatuses(lt)
+	for i := range lt.Status.Workers {
+		lt.Status.Workers[i].Group = groupByJob[workerJobName(lt.Status.Workers[i].ID)]
+	}
+	for _, worker := range report.Workers {
+		if worker.State == stats.WorkerStateMissing {
+			continue
+		}
+		name := groupByJob[workerJobName(worker.ID)]
+		for i := range groups {
+			if groups[i].Name == name {
+				groups[i].Connected++
+			}
+		}
+	}
+	lt.Status.WorkerGroups = groups
+}
+
+// workerJobName returns the name of the Job that ran a worker, read from the
+// worker ID "<job>-<suffix>_<random hex>". Returns "" for other IDs.
+func workerJobName(id string) string {
+	hostname := id[:max(strings.LastIndex(id, "_"), 0)]
+	return hostname[:max(strings.LastIndex(hostname, "-"), 0)]
+}
+
+// activeWorkers returns the active pods of the worker Jobs, used when the
+// master's worker list can't be read. With spec.workerGroups it also counts
+// them per group. The status is changed in memory only.
+func (r *LocustTestReconciler) activeWorkers(ctx context.Context, lt *locustv2.LocustTest, workerJob *batchv1.Job) int32 {
+	if len(lt.Spec.WorkerGroups) == 0 {
+		return workerJob.Status.Active
+	}
+
+	groups := newWorkerGroupStatuses(lt)
+	groups[0].Connected = workerJob.Status.Active
+	active := workerJob.Status.Active
+	for i := 1; i < len(groups); i++ {
+		job := &batchv1.Job{}
+		key := client.ObjectKey{Name: resources.WorkerGroupNodeName(lt.Name, groups[i].Name), Namespace: lt.Namespace}
+		if err := r.Get(ctx, key, job); err != nil {
+			logf.FromContext(ctx).V(1).Info("Failed to get worker group Job", "name", key.Name, "error", err.Error())
+			continue
+		}
+		groups[i].Connected = job.Status.Active
+		active += job.Status.Active
+	}
+	lt.Status.WorkerGroups = groups
+	return active
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/stats"
+)
+
+var groupsTestKey = types.NamespacedName{Name: "groups-test", Namespace: "default"}
+
+func newWorkerGroupsTest() *locustv2.LocustTest {
+	lt := newTestLocustTestCR(groupsTestKey.Name, groupsTestKey.Namespace)
+	lt.Spec.WorkerGroups = []locustv2.WorkerGroup{
+		{Name: "browse", Replicas: 2, ExtraArgs: []string{"BrowsingUser"}},
+		{Name: "checkout", Replicas: 1, ExtraArgs: []string{"CheckoutUser"}},
+	}
+	return lt
+}
+
+func reconcileGroupsTest(t *testing.T, reconciler *LocustTestReconciler) *locustv2.LocustTest {
+	t.Helper()
+	_, err := reconciler.Reconcile(context.Background(), ctrl.Request{NamespacedName: groupsTestKey})
+	require.NoError(t, err)
+
+	lt := &locustv2.LocustTest{}
+	require.NoError(t, reconciler.Get(context.Background(), groupsTestKey, lt))
+	return lt
+}
+
+func TestReconcile_CreatesWorkerGroupJobs(t *testing.T) {
+	reconciler, _ := newTestReconciler(newWorkerGroupsTest())
+
+	lt := reconcileGroupsTest(t, reconciler)
+	assert.Equal(t, locustv2.PhaseRunning, lt.Status.Phase)
+	assert.Equal(t, int32(6), lt.Status.ExpectedWorkers, "spec.worker and both groups")
+
+	for name, replicas := range map[string]int32{
+		"groups-test-worker":          3,
+		"groups-test-worker-browse":   2,
+		"groups-test-worker-checkout": 1,
+	} {
+		job := &batchv1.Job{}
+		require.NoError(t, reconciler.Get(context.Background(),
+			types.NamespacedName{Name: name, Namespace: "default"}, job), "Job %s", name)
+		assert.Equal(t, replicas, *job.Spec.Parallelism, "Job %s", name)
+		require.Len(t, job.OwnerReferences, 1)
+		assert.Equal(t, "groups-test", job.OwnerReferences[0].Name)
+	}
+
+	master := &batchv1.Job{}
+	require.NoError(t, reconciler.Get(context.Background(),
+		types.NamespacedName{Name: "groups-test-master", Namespace: "default"}, master))
+	assert.Contains(t, master.Spec.Template.Spec.Containers[0].Args, "--expect-workers=6")
+}
+
+func TestReconcile_RecreatesDeletedWorkerGroupJob(t *testing.T) {
+	reconciler, _ := newTestReconciler(newWorkerGroupsTest())
+	reconcileGroupsTest(t, reconciler)
+
+	groupJob := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "groups-test-worker-checkout", Namespace: "default"}}
+	require.NoError(t, reconciler.Delete(context.Background(), groupJob))
+
+	lt := reconcileGroupsTest(t, reconciler)
+	assert.Equal(t, locustv2.PhasePending, lt.Status.Phase)
+
+	lt = reconcileGroupsTest(t, reconciler)
+	assert.Equal(t, locustv2.PhaseRunning, lt.Status.Phase)
+	require.NoError(t, reconciler.Get(context.Background(), client.ObjectKeyFromObject(groupJob), &batchv1.Job{}))
+}
+
+func TestReconcile_AbortDeletesWorkerGroupJobs(t *testing.T) {
+	lt := newWorkerGroupsTest()
+	reconciler, _ := newTestReconciler(lt)
+	reconcileGroupsTest(t, reconciler)
+
+	require.NoError(t, reconciler.Get(context.Background(), groupsTestKey, lt))
+	lt.Annotations = map[string]string{locustv2.AnnotationControl: string(locustv2.ControlAbort)}
+	require.NoError(t, reconciler.Update(context.Background(), lt))
+
+	lt = reconcileGroupsTest(t, reconciler)
+	assert.Equal(t, locustv2.PhaseAborted, lt.Status.Phase)
+	for _, name := range []string{"groups-test-worker-browse", "groups-test-worker-checkout"} {
+		err := reconciler.Get(context.Background(), types.NamespacedName{Name: name, Namespace: "default"}, &batchv1.Job{})
+		assert.True(t, apierrors.IsNotFound(err), "Job %s must be deleted", name)
+	}
+}
+
+func TestUpdateStatusFromJobs_WorkerGroupsFromMaster(t *testing.T) {
+	lt := newWorkerGroupsTest()
+	lt.Status.Phase = locustv2.PhaseRunning
+	lt.Status.ExpectedWorkers = 6
+	reconciler, _ := newTestReconciler(lt)
+
+	report := &stats.Report{Workers: []stats.WorkerReport{
+		{ID: "groups-test-worker-x7k2p_9b1c", State: "running"},
+		{ID: "groups-test-worker-browse-q2w3e_77d0", State: "running"},
+		{ID: "groups-test-worker-browse-r4t5y_3f2a", State: "missing"},
+		{ID: "groups-test-worker-checkout-u6i7o_1a2b", State: "running"},
+		{ID: "unrelated_5e6f", State: "running"},
+	}}
+	workerJob := &batchv1.Job{Status: batchv1.JobStatus{Active: 3}}
+
+	err := reconciler.updateStatusFromJobs(context.Background(), lt, runningJob(), workerJob, healthyPodStatus(), report)
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(4), lt.Status.ConnectedWorkers)
+	assert.Equal(t, []locustv2.WorkerGroupStatus{
+		{Name: "default", Expected: 3, Connected: 1},
+		{Name: "browse", Expected: 2, Connected: 1},
+		{Name: "checkout", Expected: 1, Connected: 1},
+	}, lt.Status.WorkerGroups)
+
+	groups := map[string]string{}
+	for _, w := range lt.Status.Workers {
+		groups[w.ID] = w.Group
+	}
+	assert.Equal(t, map[string]string{
+		"groups-test-worker-x7k2p_9b1c":          "default",
+		"groups-test-worker-browse-q2w3e_77d0":   "browse",
+		"groups-test-worker-browse-r4t5y_3f2a":   "browse",
+		"groups-test-worker-checkout-u6i7o_1a2b": "checkout",
+		"unrelated_5e6f":                         "",
+	}, groups)
+}
+
+func TestUpdateStatusFromJobs_WorkerGroupsFallBackToJobs(t *testing.T) {
+	lt := newWorkerGroupsTest()
+	lt.Status.Phase = locustv2.PhaseRunning
+	lt.Status.ExpectedWorkers = 6
+	browseJob := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "groups-test-worker-browse", Namespace: "default"},
+		Status:     batchv1.JobStatus{Active: 2},
+	}
+	reconciler, _ := newTestReconciler(lt, browseJob)
+	workerJob := &batchv1.Job{Status: batchv1.JobStatus{Active: 3}}
+
+	// The checkout group's Job is gone: its workers count as not connected
+	err := reconciler.updateStatusFromJobs(context.Background(), lt, runningJob(), workerJob, healthyPodStatus(), nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(5), lt.Status.ConnectedWorkers)
+	assert.Equal(t, []locustv2.WorkerGroupStatus{
+		{Name: "default", Expected: 3, Connected: 3},
+		{Name: "browse", Expected: 2, Connected: 2},
+		{Name: "checkout", Expected: 1},
+	}, lt.Status.WorkerGroups)
+	cond := findCondition(lt.Status.Conditions, locustv2.ConditionTypeWorkersConnected)
+	require.NotNil(t, cond)
+	assert.Equal(t, "5/6 workers connected", cond.Message)
+}
+
+func TestWorkerJobName(t *testing.T) {
+	tests := map[string]string{
+		"load-test-worker-x7k2p_9b1c":        "load-test-worker",
+		"load-test-worker-browse-x7k2p_9b1c": "load-test-worker-browse",
+		"no-underscore":                      "",
+		"nodash_9b1c":                        "",
+		"":                                   "",
+	}
+	for id, want := range tests {
+		assert.Equal(t, want, workerJobName(id), "worker ID %q", id)
+	}
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package grafana writes annotations through the Grafana HTTP API.
+package grafana
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// annotationsPath is the annotations endpoint of the Grafana HTTP API.
+const annotationsPath = "/api/annotations"
+
+// Target is a Grafana instance and the token to write to it.
+type Target struct {
+	// URL of Grafana, e.g. "https://grafana.example.com".
+	URL string
+	// Token is a service account token, or empty for an unauthenticated Grafana.
+	Token string
+}
+
+// Annotation is an annotation created in Grafana. Times are in milliseconds
+// since the epoch; an annotation with an end time is a region.
+type Annotation struct {
+	DashboardUID string   `json:"dashboardUID,omitempty"`
+	Time         int64    `json:"time"`
+	TimeEnd      int64    `json:"timeEnd,omitempty"`
+	Tags         []string `json:"tags,omitempty"`
+	Text         string   `json:"text"`
+}
+
+// Annotator writes annotations to Grafana. The controller depends on this
+// interface rather than on HTTP so tests can substitute a fake Grafana.
+type Annotator interface {
+	// Create adds annotation and returns its ID.
+	Create(ctx context.Context, target Target, annotation Annotation) (int64, error)
+	// End sets the end time and text of the annotation id, making it a region.
+	End(ctx context.Context, target Target, id int64, end time.Time, text string) error
+}
+
+// HTTPAnnotator is the Annotator talking to the Grafana HTTP API.
+type HTTPAnnotator struct {
+	Client *http.Client
+}
+
+// NewHTTPAnnotator returns an HTTPAnnotator whose requests time out after timeout.
+func NewHTTPAnnotator(timeout time.Duration) *HTTPAnnotator {
+	return &HTTPAnnotator{Client: &http.Client{Timeout: timeout}}
+}
+
+// Create implements Annotator with a single request.
+func (a *HTTPAnnotator) Create(ctx context.Context, target Target, annotation Annotation) (int64, error) {
+	var created struct {
+		ID int64 `json:"id"`
+	}
+	if err := a.do(ctx, target, http.MethodPost, annotationsPath, annotation, &created); err != nil {
+		return 0, fmt.Errorf("failed to create annotation: %w", err)
+	}
+	if created.ID == 0 {
+		return 0, errors.New("failed to create annotation: Grafana returned no ID")
+	}
+	return created.ID, nil
+}
+
+// End implements Annotator with a single request.
+func (a *HTTPAnnotator) End(ctx context.Context, target Target, id int64, end time.Time, text string) error {
+	patch := map[string]any{
+		"timeEnd": end.UnixMilli(),
+		"text":    text,
+	}
+	if err := a.do(ctx, target, http.MethodPatch, annotationsPath+"/"+strconv.FormatInt(id, 10), patch, nil); err != nil {
+		return fmt.Errorf("failed to end annotation %d: %w", id, err)
+	}
+	return nil
+}
+
+// do sends body as JSON to path and decodes the response into out, if not nil.
+func (a *HTTPAnnotator) do(ctx context.Context, target Target, method, path string, body, out any) error {
+	base, err := url.Parse(strings.TrimSuffix(target.URL, "/"))
+	if err != nil || (base.Scheme != "http" && base.Scheme != "https") || base.Host == "" {
+		return fmt.Errorf("invalid Grafana URL %q: must be an http or https URL", target.URL)
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, base.String()+path, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	if target.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+target.Token)
+	}
+
+	resp, err := a.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("invalid response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grafana
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPAnnotator_Create(t *testing.T) {
+	var received map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/grafana/api/annotations", r.URL.Path)
+		assert.Equal(t, "Bearer glsa_token", r.Header.Get("Authorization"))
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		_, _ = w.Write([]byte(`{"message":"Annotation added","id":42}`))
+	}))
+	defer server.Close()
+
+	id, err := NewHTTPAnnotator(time.Second).Create(context.Background(),
+		Target{URL: server.URL + "/grafana/", Token: "glsa_token"},
+		Annotation{DashboardUID: "abc", Time: 1760000000000, Tags: []string{"locust"}, Text: "Load test started"})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), id)
+	assert.Equal(t, map[string]any{
+		"dashboardUID": "abc",
+		"time":         float64(1760000000000),
+		"tags":         []any{"locust"},
+		"text":         "Load test started",
+	}, received)
+}
+
+func TestHTTPAnnotator_CreateWithoutToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`{"id":1}`))
+	}))
+	defer server.Close()
+
+	_, err := NewHTTPAnnotator(time.Second).Create(context.Background(), Target{URL: server.URL}, Annotation{})
+	require.NoError(t, err)
+}
+
+func TestHTTPAnnotator_CreateErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		wantErr string
+	}{
+		{name: "Unauthorized", status: http.StatusUnauthorized, body: `{"message":"invalid API key"}`, wantErr: "unexpected status 401 Unauthorized"},
+		{name: "NoID", status: http.StatusOK, body: `{"message":"Annotation added"}`, wantErr: "Grafana returned no ID"},
+		{name: "InvalidResponse", status: http.StatusOK, body: `<html>`, wantErr: "invalid response"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			_, err := NewHTTPAnnotator(time.Second).Create(context.Background(), Target{URL: server.URL}, Annotation{})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestHTTPAnnotator_CreateInvalidURL(t *testing.T) {
+	_, err := NewHTTPAnnotator(time.Second).Create(context.Background(), Target{URL: "grafana.example.com"}, Annotation{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `invalid Grafana URL "grafana.example.com"`)
+}
+
+func TestHTTPAnnotator_End(t *testing.T) {
+	var received map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		assert.Equal(t, "/api/annotations/42", r.URL.Path)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		_, _ = w.Write([]byte(`{"message":"Annotation patched"}`))
+	}))
+	defer server.Close()
+
+	end := time.UnixMilli(1760001800000)
+	err := NewHTTPAnnotator(time.Second).End(context.Background(), Target{URL: server.URL}, 42, end, "Load test succeeded")
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"timeEnd": float64(1760001800000),
+		"text":    "Load test succeeded",
+	}, received)
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package metrics exposes Prometheus metrics on the operator's metrics endpoint.
+package metrics
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/stats"
+)
+
+// Labels identifying the test a series belongs to.
+var testLabels = []string{"namespace", "test"}
+
+// Descriptions of the Locust metrics. Names and units follow the
+// containersol/locust_exporter sidecar, so its dashboards and queries keep
+// working once the test labels are accounted for.
+var (
+	usersDesc = prometheus.NewDesc("locust_users",
+		"The current number of users.", testLabels, nil)
+	runningDesc = prometheus.NewDesc("locust_running",
+		"The current state of the execution (0 = STOPPED 1 = SPAWNING 2 = RUNNING).", testLabels, nil)
+	failRatioDesc = prometheus.NewDesc("locust_fail_ratio",
+		"The ratio of failed requests since the test started.", testLabels, nil)
+	percentile50Desc = prometheus.NewDesc("locust_current_response_time_percentile_50",
+		"The current median response time in milliseconds.", testLabels, nil)
+	percentile95Desc = prometheus.NewDesc("locust_current_response_time_percentile_95",
+		"The current 95th percentile response time in milliseconds.", testLabels, nil)
+	workersDesc = prometheus.NewDesc("locust_workers_count",
+		"The number of workers registered with the master.", testLabels, nil)
+	runningWorkersDesc = prometheus.NewDesc("locust_workers_running_count",
+		"The number of workers running users.", testLabels, nil)
+	missingWorkersDesc = prometheus.NewDesc("locust_workers_missing_count",
+		"The number of workers whose heartbeats stopped.", testLabels, nil)
+	errorsDesc = prometheus.NewDesc("locust_errors",
+		"The occurrences of each distinct request failure.", slices.Concat(testLabels, []string{"method", "name", "error"}), nil)
+)
+
+// endpointMetrics are the per-endpoint series, including the "Aggregated" row.
+var endpointMetrics = []struct {
+	desc  *prometheus.Desc
+	value func(e *stats.EndpointReport) float64
+}{
+	{endpointDesc("num_requests", "The total number of requests."),
+		func(e *stats.EndpointReport) float64 { return float64(e.Requests) }},
+	{endpointDesc("num_failures", "The total number of failed requests."),
+		func(e *stats.EndpointReport) float64 { return float64(e.Failures) }},
+	{endpointDesc("current_rps", "The current number of requests per second."),
+		func(e *stats.EndpointReport) float64 { return e.CurrentRPS }},
+	{endpointDesc("current_fail_per_sec", "The current number of failures per second."),
+		func(e *stats.EndpointReport) float64 { return e.CurrentFailPerSec }},
+	{endpointDesc("avg_response_time", "The average response time in milliseconds."),
+		func(e *stats.EndpointReport) float64 { return e.AvgResponseTime }},
+	{endpointDesc("min_response_time", "The shortest response time in milliseconds."),
+		func(e *stats.EndpointReport) float64 { return e.MinResponseTime }},
+	{endpointDesc("max_response_time", "The longest response time in milliseconds."),
+		func(e *stats.EndpointReport) float64 { return e.MaxResponseTime }},
+	{endpointDesc("median_response_time", "The median response time in milliseconds."),
+		func(e *stats.EndpointReport) float64 { return e.MedianResponseTime }},
+	{endpointDesc("avg_content_length", "The average size of the responses in bytes."),
+		func(e *stats.EndpointReport) float64 { return e.AvgContentLength }},
+}
+
+func endpointDesc(name, help string) *prometheus.Desc {
+	return prometheus.NewDesc("locust_requests_"+name, help, slices.Concat(testLabels, []string{"method", "name"}), nil)
+}
+
+// LocustCollector exports the statistics of running tests that the
+// controller reads from their masters, in place of the metrics exporter
+// sidecar. Series appear once a test's master is first read and go away when
+// the test stops running. A nil collector ignores updates.
+type LocustCollector struct {
+	mu      sync.RWMutex
+	reports map[types.NamespacedName]*stats.Report
+}
+
+var _ prometheus.Collector = &LocustCollector{}
+
+// NewLocustCollector returns a collector without any tests.
+func NewLocustCollector() *LocustCollector {
+	return &LocustCollector{reports: map[types.NamespacedName]*stats.Report{}}
+}
+
+// Set records the last report read from the master of a test.
+func (c *LocustCollector) Set(test types.NamespacedName, report *stats.Report) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.reports[test] = report
+}
+
+// Delete removes the series of a test.
+func (c *LocustCollector) Delete(test types.NamespacedName) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.reports, test)
+}
+
+// Describe implements prometheus.Collector.
+func (c *LocustCollector) Describe(ch chan<- *prometheus.Desc) {
+	for _, desc := range []*prometheus.Desc{
+		usersDesc, runningDesc, failRatioDesc, percentile50Desc, percentile95Desc,
+		workersDesc, runningWorkersDesc, missingWorkersDesc, errorsDesc,
+	} {
+		ch <- desc
+	}
+	for _, metric := range endpointMetrics {
+		ch <- metric.desc
+	}
+}
+
+// Collect implements prometheus.Collector.
+func (c *LocustCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for test, report := range c.reports {
+		gauge := func(desc *prometheus.Desc, value float64, extra ...string) {
+			labels := slices.Concat([]string{test.Namespace, test.Name}, extra)
+			ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, value, labels...)
+		}
+
+		gauge(usersDesc, float64(report.Users))
+		gauge(runningDesc, runnerState(report.State))
+		gauge(failRatioDesc, report.FailureRatio)
+		gauge(percentile50Desc, float64(report.MedianResponseTime.Microseconds())/1000)
+		gauge(percentile95Desc, float64(report.P95ResponseTime.Microseconds())/1000)
+
+		if report.Workers != nil {
+			var running float64
+			for _, worker := range report.Workers {
+				if worker.State == stats.RunnerStateRunning {
+					running++
+				}
+			}
+			connected := float64(report.ConnectedWorkers())
+			gauge(workersDesc, connected)
+			gauge(runningWorkersDesc, running)
+			gauge(missingWorkersDesc, float64(len(report.Workers))-connected)
+		}
+
+		for i := range report.Endpoints {
+			endpoint := &report.Endpoints[i]
+			for _, metric := range endpointMetrics {
+				gauge(metric.desc, metric.value(endpoint), endpoint.Method, endpoint.Name)
+			}
+		}
+		for _, e := range report.Errors {
+			gauge(errorsDesc, float64(e.Occurrences), e.Method, e.Name, e.Error)
+		}
+	}
+}
+
+// runnerState maps a runner state to the value of locust_running.
+func runnerState(state string) float64 {
+	switch state {
+	case stats.RunnerStateRunning:
+		return 2
+	case stats.RunnerStateSpawning:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/stats"
+)
+
+func newTestReport() *stats.Report {
+	return &stats.Report{
+		State:              stats.RunnerStateRunning,
+		Users:              50,
+		FailureRatio:       0.25,
+		MedianResponseTime: 120 * time.Millisecond,
+		P95ResponseTime:    1500 * time.Microsecond,
+		Workers: []stats.WorkerReport{
+			{ID: "worker-a", State: stats.RunnerStateRunning},
+			{ID: "worker-b", State: "ready"},
+			{ID: "worker-c", State: stats.WorkerStateMissing},
+		},
+		Endpoints: []stats.EndpointReport{
+			{Method: "GET", Name: "/", Requests: 40, Failures: 10, CurrentRPS: 4.5, AvgResponseTime: 110.5},
+			{Name: stats.AggregatedName, Requests: 40, Failures: 10, CurrentRPS: 4.5, AvgResponseTime: 110.5},
+		},
+		Errors: []stats.ErrorReport{
+			{Method: "GET", Name: "/", Error: "HTTPError('500 Server Error')", Occurrences: 10},
+		},
+	}
+}
+
+func TestLocustCollector_Collect(t *testing.T) {
+	collector := NewLocustCollector()
+	collector.Set(types.NamespacedName{Namespace: "load", Name: "my-test"}, newTestReport())
+
+	expected := `
+# HELP locust_users The current number of users.
+# TYPE locust_users gauge
+locust_users{namespace="load",test="my-test"} 50
+# HELP locust_running The current state of the execution (0 = STOPPED 1 = SPAWNING 2 = RUNNING).
+# TYPE locust_running gauge
+locust_running{namespace="load",test="my-test"} 2
+# HELP locust_fail_ratio The ratio of failed requests since the test started.
+# TYPE locust_fail_ratio gauge
+locust_fail_ratio{namespace="load",test="my-test"} 0.25
+# HELP locust_current_response_time_percentile_50 The current median response time in milliseconds.
+# TYPE locust_current_response_time_percentile_50 gauge
+locust_current_response_time_percentile_50{namespace="load",test="my-test"} 120
+# HELP locust_current_response_time_percentile_95 The current 95th percentile response time in milliseconds.
+# TYPE locust_current_response_time_percentile_95 gauge
+locust_current_response_time_percentile_95{namespace="load",test="my-test"} 1.5
+# HELP locust_workers_count The number of workers registered with the master.
+# TYPE locust_workers_count gauge
+locust_workers_count{namespace="load",test="my-test"} 2
+# HELP locust_workers_running_count The number of workers running users.
+# TYPE locust_workers_running_count gauge
+locust_workers_running_count{namespace="load",test="my-test"} 1
+# HELP locust_workers_missing_count The number of workers whose heartbeats stopped.
+# TYPE locust_workers_missing_count gauge
+locust_workers_missing_count{namespace="load",test="my-test"} 1
+# HELP locust_errors The occurrences of each distinct request failure.
+# TYPE locust_errors gauge
+locust_errors{error="HTTPError('500 Server Error')",method="GET",name="/",namespace="load",test="my-test"} 10
+# HELP locust_requests_num_requests The total number of requests.
+# TYPE locust_requests_num_requests gauge
+locust_requests_num_requests{method="",name="Aggregated",namespace="load",test="my-test"} 40
+locust_requests_num_requests{method="GET",name="/",namespace="load",test="my-test"} 40
+# HELP locust_requests_avg_response_time The average response time in milliseconds.
+# TYPE locust_requests_avg_response_time gauge
+locust_requests_avg_response_time{method="",name="Aggregated",namespace="load",test="my-test"} 110.5
+locust_requests_avg_response_time{method="GET",name="/",namespace="load",test="my-test"} 110.5
+`
+	err := testutil.CollectAndCompare(collector, strings.NewReader(expected),
+		"locust_users", "locust_running", "locust_fail_ratio",
+		"locust_current_response_time_percentile_50", "locust_current_response_time_percentile_95",
+		"locust_workers_count", "locust_workers_running_count", "locust_workers_missing_count",
+		"locust_errors", "locust_requests_num_requests", "locust_requests_avg_response_time")
+	require.NoError(t, err)
+}
+
+func TestLocustCollector_OmitsWorkersWithoutWorkerList(t *testing.T) {
+	collector := NewLocustCollector()
+	report := newTestReport()
+	report.Workers = nil
+	collector.Set(types.NamespacedName{Namespace: "load", Name: "my-test"}, report)
+
+	assert.Zero(t, testutil.CollectAndCount(collector, "locust_workers_count"))
+	assert.Equal(t, 1, testutil.CollectAndCount(collector, "locust_users"))
+}
+
+func TestLocustCollector_SetAndDelete(t *testing.T) {
+	collector := NewLocustCollector()
+	first := types.NamespacedName{Namespace: "load", Name: "first"}
+	second := types.NamespacedName{Namespace: "load", Name: "second"}
+
+	collector.Set(first, newTestReport())
+	collector.Set(second, newTestReport())
+	assert.Equal(t, 2, testutil.CollectAndCount(collector, "locust_users"))
+
+	collector.Delete(first)
+	assert.Equal(t, 1, testutil.CollectAndCount(collector, "locust_users"))
+
+	collector.Delete(second)
+	assert.Zero(t, testutil.CollectAndCount(collector))
+}
+
+func TestLocustCollector_NilIgnoresUpdates(t *testing.T) {
+	var collector *LocustCollector
+
+	assert.NotPanics(t, func() {
+		collector.Set(types.NamespacedName{Namespace: "load", Name: "my-test"}, newTestReport())
+		collector.Delete(types.NamespacedName{Namespace: "load", Name: "my-test"})
+	})
+}
+
+func TestRunnerState(t *testing.T) {
+	assert.Equal(t, 2.0, runnerState(stats.RunnerStateRunning))
+	assert.Equal(t, 1.0, runnerState(stats.RunnerStateSpawning))
+	assert.Equal(t, 0.0, runnerState("stopped"))
+}
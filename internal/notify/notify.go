@@ -0,0 +1,144 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package notify sends the phase transitions of LocustTests to webhooks as CloudEvents.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	neturl "net/url"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+)
+
+// ContentType is the media type of a CloudEvent in structured JSON mode.
+const ContentType = "application/cloudevents+json"
+
+// EventTypePrefix prefixes the CloudEvent type, followed by the lowercase phase,
+// e.g. "io.locust.locusttest.succeeded".
+const EventTypePrefix = "io.locust.locusttest."
+
+// Event is a CloudEvent (specification 1.0) in structured JSON mode.
+type Event struct {
+	SpecVersion     string      `json:"specversion"`
+	ID              string      `json:"id"`
+	Source          string      `json:"source"`
+	Type            string      `json:"type"`
+	Subject         string      `json:"subject"`
+	Time            time.Time   `json:"time"`
+	DataContentType string      `json:"datacontenttype"`
+	Data            PhaseChange `json:"data"`
+}
+
+// PhaseChange is the data of an Event: the test and the phase it entered.
+type PhaseChange struct {
+	Name           string              `json:"name"`
+	Namespace      string              `json:"namespace"`
+	Phase          locustv2.Phase      `json:"phase"`
+	PreviousPhase  locustv2.Phase      `json:"previousPhase,omitempty"`
+	Reason         string              `json:"reason,omitempty"`
+	Message        string              `json:"message,omitempty"`
+	StartTime      *metav1.Time        `json:"startTime,omitempty"`
+	CompletionTime *metav1.Time        `json:"completionTime,omitempty"`
+	Stats          *locustv2.LiveStats `json:"stats,omitempty"`
+}
+
+// NewPhaseEvent returns the Event announcing that lt entered its current
+// phase from previous. The reason and message are those of the TestCompleted
+// condition. The ID is the same for every delivery of the transition, so
+// receivers can drop duplicates.
+func NewPhaseEvent(lt *locustv2.LocustTest, previous locustv2.Phase, now time.Time) Event {
+	data := PhaseChange{
+		Name:           lt.Name,
+		Namespace:      lt.Namespace,
+		Phase:          lt.Status.Phase,
+		PreviousPhase:  previous,
+		StartTime:      lt.Status.StartTime,
+		CompletionTime: lt.Status.CompletionTime,
+		Stats:          lt.Status.Stats,
+	}
+	if cond := meta.FindStatusCondition(lt.Status.Conditions, locustv2.ConditionTypeTestCompleted); cond != nil {
+		data.Reason = cond.Reason
+		data.Message = cond.Message
+	}
+
+	return Event{
+		SpecVersion:     "1.0",
+		ID:              fmt.Sprintf("%s/%s", lt.UID, lt.Status.Phase),
+		Source:          fmt.Sprintf("/apis/%s/namespaces/%s/locusttests/%s", locustv2.GroupVersion, lt.Namespace, lt.Name),
+		Type:            EventTypePrefix + strings.ToLower(string(lt.Status.Phase)),
+		Subject:         lt.Name,
+		Time:            now.UTC(),
+		DataContentType: "application/json",
+		Data:            data,
+	}
+}
+
+// Sender delivers events to webhooks. The controller depends on this
+// interface rather than on HTTP so tests can substitute a fake endpoint.
+type Sender interface {
+	// Send POSTs event to url.
+	Send(ctx context.Context, url string, event Event) error
+}
+
+// HTTPSender is the Sender POSTing events over HTTP.
+type HTTPSender struct {
+	Client *http.Client
+}
+
+// NewHTTPSender returns an HTTPSender whose requests time out after timeout.
+func NewHTTPSender(timeout time.Duration) *HTTPSender {
+	return &HTTPSender{Client: &http.Client{Timeout: timeout}}
+}
+
+// Send implements Sender with a single request. Any status but 2xx is an error.
+func (s *HTTPSender) Send(ctx context.Context, url string, event Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return errors.New("failed to send event: invalid URL")
+	}
+	req.Header.Set("Content-Type", ContentType)
+
+	resp, err := s.Client.Do(req)
+	if err != nil {
+		// Leave the URL out of the error: it may embed a token
+		if urlErr, ok := errors.AsType[*neturl.Error](err); ok {
+			err = urlErr.Err
+		}
+		return fmt.Errorf("failed to send event: %w", err)
+	}
+	_ = resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("failed to send event: unexpected status %s", resp.Status)
+	}
+	return nil
+}
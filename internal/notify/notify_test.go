@@ -0,0 +1,127 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+)
+
+func newTestLocustTest() *locustv2.LocustTest {
+	start := metav1.NewTime(time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC))
+	completion := metav1.NewTime(time.Date(2026, 3, 1, 10, 30, 0, 0, time.UTC))
+	return &locustv2.LocustTest{
+		ObjectMeta: metav1.ObjectMeta{Name: "checkout", Namespace: "load", UID: "1234"},
+		Status: locustv2.LocustTestStatus{
+			Phase:          locustv2.PhaseFailed,
+			StartTime:      &start,
+			CompletionTime: &completion,
+			Stats:          &locustv2.LiveStats{Users: 100, RPS: "152.3", FailureRatio: "0.2"},
+			Conditions: []metav1.Condition{{
+				Type:    locustv2.ConditionTypeTestCompleted,
+				Status:  metav1.ConditionTrue,
+				Reason:  locustv2.ReasonTestFailed,
+				Message: "Test failed: thresholds breached",
+			}},
+		},
+	}
+}
+
+func TestNewPhaseEvent(t *testing.T) {
+	lt := newTestLocustTest()
+	now := time.Date(2026, 3, 1, 10, 30, 1, 0, time.UTC)
+
+	event := NewPhaseEvent(lt, locustv2.PhaseRunning, now)
+
+	assert.Equal(t, "1.0", event.SpecVersion)
+	assert.Equal(t, "1234/Failed", event.ID)
+	assert.Equal(t, "/apis/locust.io/v2/namespaces/load/locusttests/checkout", event.Source)
+	assert.Equal(t, "io.locust.locusttest.failed", event.Type)
+	assert.Equal(t, "checkout", event.Subject)
+	assert.Equal(t, now, event.Time)
+	assert.Equal(t, "application/json", event.DataContentType)
+	assert.Equal(t, PhaseChange{
+		Name:           "checkout",
+		Namespace:      "load",
+		Phase:          locustv2.PhaseFailed,
+		PreviousPhase:  locustv2.PhaseRunning,
+		Reason:         locustv2.ReasonTestFailed,
+		Message:        "Test failed: thresholds breached",
+		StartTime:      lt.Status.StartTime,
+		CompletionTime: lt.Status.CompletionTime,
+		Stats:          lt.Status.Stats,
+	}, event.Data)
+}
+
+func TestHTTPSender_Send(t *testing.T) {
+	var contentType string
+	var received map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		contentType = r.Header.Get("Content-Type")
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	event := NewPhaseEvent(newTestLocustTest(), locustv2.PhaseRunning, time.Date(2026, 3, 1, 10, 30, 1, 0, time.UTC))
+	err := NewHTTPSender(time.Second).Send(context.Background(), server.URL, event)
+
+	require.NoError(t, err)
+	assert.Equal(t, ContentType, contentType)
+	assert.Equal(t, "1.0", received["specversion"])
+	assert.Equal(t, "io.locust.locusttest.failed", received["type"])
+	assert.Equal(t, "2026-03-01T10:30:01Z", received["time"])
+	data := received["data"].(map[string]any)
+	assert.Equal(t, "Failed", data["phase"])
+	assert.Equal(t, "Running", data["previousPhase"])
+	assert.Equal(t, "2026-03-01T10:00:00Z", data["startTime"])
+	assert.Equal(t, "152.3", data["stats"].(map[string]any)["rps"])
+}
+
+func TestHTTPSender_SendUnexpectedStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	err := NewHTTPSender(time.Second).Send(context.Background(), server.URL, Event{})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "503")
+}
+
+func TestHTTPSender_SendErrorOmitsURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	url := server.URL + "/hooks/secret-token"
+	server.Close()
+
+	err := NewHTTPSender(time.Second).Send(context.Background(), url, Event{})
+
+	require.Error(t, err)
+	assert.NotContains(t, err.Error(), "secret-token")
+}
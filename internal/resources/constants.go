@@ -16,6 +16,8 @@ limitations under the License.
 
 package resources
 
+import locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+
 // Port constants matching Java Constants.java
 const (
 	// MasterPort is the port for master-worker communication.
@@ -26,14 +28,26 @@ const (
 	WebUIPort = 8089
 	// WorkerPort is the port exposed by worker nodes.
 	WorkerPort = 8080
+	// ResultsCollectorPort is the port the results collector serves the result files on.
+	ResultsCollectorPort = 8090
 )
 
 // Mount path constants
 const (
 	// DefaultMountPath is the default path where ConfigMap is mounted.
-	DefaultMountPath = "/lotest/src"
+	DefaultMountPath = locustv2.DefaultSrcMountPath
 	// LibMountPath is the path where the lib ConfigMap is mounted.
-	LibMountPath = "/opt/locust/lib"
+	LibMountPath = locustv2.DefaultLibMountPath
+	// LoadShapeMountPath is where the generated LoadTestShape is mounted in the master.
+	LoadShapeMountPath = locustv2.LoadShapeMountPath
+	// LoadShapeFileName is the file name of the generated LoadTestShape.
+	LoadShapeFileName = "locust_shape.py"
+	// ResultsMountPath is where the master writes its result files.
+	ResultsMountPath = locustv2.ResultsMountPath
+	// GitMountPath is where the git clone init container writes the repository.
+	GitMountPath = "/git"
+	// GitSecretMountPath is where the git clone init container reads its credentials.
+	GitSecretMountPath = "/etc/git-secret"
 )
 
 // Label constants
@@ -41,13 +55,15 @@ const (
 	// LabelTestName is the label key for the performance test name.
 	LabelTestName = "performance-test-name"
 	// LabelPodName is the label key for the pod name (used as service selector).
-	LabelPodName = "performance-test-pod-name"
+	LabelPodName = locustv2.PodNameLabel
 	// LabelManagedBy is the label key indicating the managing operator.
 	LabelManagedBy = "managed-by"
 	// ManagedByValue is the value for the managed-by label.
 	ManagedByValue = "locust-k8s-operator"
 	// LabelApp is the app label key.
 	LabelApp = "app"
+	// LabelWorkerGroup is the label key for the worker group of a group's worker pods.
+	LabelWorkerGroup = "performance-test-worker-group"
 )
 
 // Prometheus annotation constants
@@ -68,14 +84,28 @@ const (
 	BackoffLimit = 0
 	// MasterReplicaCount is the fixed replica count for master (always 1).
 	MasterReplicaCount = 1
+	// AnnotationSpecHash records on the master Job the SpecHash of the spec it was built from.
+	AnnotationSpecHash = "locust.io/spec-hash"
 )
 
 // Container constants
 const (
 	// MetricsExporterContainerName is the name of the metrics exporter sidecar.
-	MetricsExporterContainerName = "locust-metrics-exporter"
+	MetricsExporterContainerName = locustv2.MetricsExporterContainerName
 	// LibVolumeName is the name of the lib volume.
-	LibVolumeName = "locust-lib"
+	LibVolumeName = locustv2.LibVolumeName
+	// LoadShapeVolumeName is the name of the generated LoadTestShape volume.
+	LoadShapeVolumeName = locustv2.LoadShapeVolumeName
+	// ResultsVolumeName is the name of the volume the master writes its results to.
+	ResultsVolumeName = locustv2.ResultsVolumeName
+	// ResultsCollectorContainerName is the name of the results collector sidecar.
+	ResultsCollectorContainerName = locustv2.ResultsCollectorContainerName
+	// GitCloneContainerName is the name of the init container cloning testFiles.git.
+	GitCloneContainerName = locustv2.GitCloneContainerName
+	// GitVolumeName is the name of the volume holding the cloned repository.
+	GitVolumeName = locustv2.GitVolumeName
+	// GitSecretVolumeName is the name of the volume holding the git credentials.
+	GitSecretVolumeName = locustv2.GitSecretVolumeName
 )
 
 // Exporter environment variable constants
@@ -112,4 +142,9 @@ const (
 	PortNamePrefix = "port"
 	// MetricsPortName is the name for the metrics port.
 	MetricsPortName = "prometheus-metrics"
+	// MetricsContainerPortName is the name of the metrics exporter's container
+	// port. Container port names are limited to 15 characters.
+	MetricsContainerPortName = "metrics"
+	// WebUIPortName is the name of the web UI Service port.
+	WebUIPortName = "web-ui"
 )
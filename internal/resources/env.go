@@ -17,6 +17,7 @@ limitations under the License.
 package resources
 
 import (
+	"cmp"
 	"strconv"
 
 	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
@@ -66,7 +67,7 @@ func BuildEnvFrom(lt *locustv2.LocustTest) []corev1.EnvFromSource {
 }
 
 // BuildUserEnvVars creates EnvVar entries from the variables list.
-// These are appended to the existing Kafka env vars.
+// These are appended to the Kafka and OTel env vars.
 func BuildUserEnvVars(lt *locustv2.LocustTest) []corev1.EnvVar {
 	if lt.Spec.Env == nil || len(lt.Spec.Env.Variables) == 0 {
 		return nil
@@ -78,23 +79,72 @@ func BuildUserEnvVars(lt *locustv2.LocustTest) []corev1.EnvVar {
 	return result
 }
 
-// BuildKafkaEnvVars creates the Kafka environment variables for the Locust container.
-func BuildKafkaEnvVars(cfg *config.OperatorConfig) []corev1.EnvVar {
-	return []corev1.EnvVar{
-		{Name: EnvKafkaBootstrapServers, Value: cfg.KafkaBootstrapServers},
-		{Name: EnvKafkaSecurityEnabled, Value: strconv.FormatBool(cfg.KafkaSecurityEnabled)},
-		{Name: EnvKafkaSecurityProtocol, Value: cfg.KafkaSecurityProtocol},
-		{Name: EnvKafkaSaslMechanism, Value: cfg.KafkaSaslMechanism},
-		{Name: EnvKafkaSaslJaasConfig, Value: cfg.KafkaSaslJaasConfig},
-		{Name: EnvKafkaUsername, Value: cfg.KafkaUsername},
-		{Name: EnvKafkaPassword, Value: cfg.KafkaPassword},
+// BuildKafkaEnvVars creates the Kafka environment variables of tests setting
+// spec.integrations.kafka, defaulting unset fields to the operator's Kafka
+// configuration. Credentials reference Secrets, so they never appear in the
+// Job spec. Returns nil if the test doesn't use Kafka.
+func BuildKafkaEnvVars(lt *locustv2.LocustTest, cfg *config.OperatorConfig) []corev1.EnvVar {
+	if lt.Spec.Integrations == nil || lt.Spec.Integrations.Kafka == nil {
+		return nil
+	}
+	kafka := lt.Spec.Integrations.Kafka
+
+	securityEnabled := cfg.KafkaSecurityEnabled
+	if kafka.SecurityEnabled != nil {
+		securityEnabled = *kafka.SecurityEnabled
+	}
+
+	envVars := []corev1.EnvVar{
+		{Name: EnvKafkaBootstrapServers, Value: cmp.Or(kafka.BootstrapServers, cfg.KafkaBootstrapServers)},
+		{Name: EnvKafkaSecurityEnabled, Value: strconv.FormatBool(securityEnabled)},
+		{Name: EnvKafkaSecurityProtocol, Value: cmp.Or(kafka.SecurityProtocol, cfg.KafkaSecurityProtocol)},
+		{Name: EnvKafkaSaslMechanism, Value: cmp.Or(kafka.SaslMechanism, cfg.KafkaSaslMechanism)},
+	}
+
+	credentials := []struct {
+		name string
+		ref  *corev1.SecretKeySelector
+		key  string
+	}{
+		{name: EnvKafkaSaslJaasConfig, ref: kafka.SaslJaasConfigSecretRef, key: cfg.KafkaSaslJaasConfigKey},
+		{name: EnvKafkaUsername, ref: kafka.UsernameSecretRef, key: cfg.KafkaUsernameKey},
+		{name: EnvKafkaPassword, ref: kafka.PasswordSecretRef, key: cfg.KafkaPasswordKey},
+	}
+	for _, c := range credentials {
+		ref := kafkaSecretKeyRef(c.ref, cfg, securityEnabled, c.key)
+		if ref == nil {
+			continue
+		}
+		envVars = append(envVars, corev1.EnvVar{
+			Name:      c.name,
+			ValueFrom: &corev1.EnvVarSource{SecretKeyRef: ref},
+		})
+	}
+
+	return envVars
+}
+
+// kafkaSecretKeyRef returns ref, else key of the operator's Kafka credentials
+// Secret if the test authenticates, else nil. The operator's Secret is
+// required like any other: pods missing it don't start, rather than running
+// without credentials.
+func kafkaSecretKeyRef(ref *corev1.SecretKeySelector, cfg *config.OperatorConfig, securityEnabled bool, key string) *corev1.SecretKeySelector {
+	if ref != nil {
+		return ref.DeepCopy()
+	}
+	if !securityEnabled || cfg.KafkaCredentialsSecretName == "" || key == "" {
+		return nil
+	}
+	return &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: cfg.KafkaCredentialsSecretName},
+		Key:                  key,
 	}
 }
 
 // BuildEnvVars combines Kafka env vars, OTel env vars, and user-defined env vars.
 func BuildEnvVars(lt *locustv2.LocustTest, cfg *config.OperatorConfig) []corev1.EnvVar {
-	// Start with Kafka env vars (existing behavior)
-	envVars := BuildKafkaEnvVars(cfg)
+	// Start with Kafka env vars, if the test uses Kafka
+	envVars := BuildKafkaEnvVars(lt, cfg)
 
 	// Add OTel environment variables if enabled
 	otelEnvVars := BuildOTelEnvVars(lt)
@@ -24,6 +24,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 func TestBuildEnvFrom_NilEnvConfig(t *testing.T) {
@@ -254,10 +255,30 @@ func TestBuildUserEnvVars_ReturnsCopy(t *testing.T) {
 	assert.Equal(t, "value", original[0].Value)
 }
 
+// kafkaIntegration returns spec.integrations of a test using the operator's Kafka defaults.
+func kafkaIntegration() *locustv2.IntegrationsConfig {
+	return &locustv2.IntegrationsConfig{Kafka: &locustv2.KafkaIntegration{}}
+}
+
+func TestBuildEnvVars_NoKafka(t *testing.T) {
+	lt := &locustv2.LocustTest{}
+	cfg := &config.OperatorConfig{
+		KafkaBootstrapServers:      "kafka:9092",
+		KafkaCredentialsSecretName: "kafka-credentials",
+		KafkaUsernameKey:           "username",
+		KafkaPasswordKey:           "password",
+	}
+
+	result := BuildEnvVars(lt, cfg)
+
+	assert.Empty(t, result, "Kafka env vars are only injected into tests setting spec.integrations.kafka")
+}
+
 func TestBuildEnvVars_OnlyKafka(t *testing.T) {
 	lt := &locustv2.LocustTest{
 		Spec: locustv2.LocustTestSpec{
-			Env: nil,
+			Env:          nil,
+			Integrations: kafkaIntegration(),
 		},
 	}
 	cfg := &config.OperatorConfig{
@@ -267,12 +288,131 @@ func TestBuildEnvVars_OnlyKafka(t *testing.T) {
 
 	result := BuildEnvVars(lt, cfg)
 
-	// Should have 7 Kafka env vars
-	assert.Len(t, result, 7)
+	// Should have 4 Kafka env vars, no credentials
+	assert.Len(t, result, 4)
 	assert.Equal(t, EnvKafkaBootstrapServers, result[0].Name)
 	assert.Equal(t, "kafka:9092", result[0].Value)
 }
 
+func TestBuildKafkaEnvVars(t *testing.T) {
+	secretKeyRef := func(name, key string, optional *bool) *corev1.EnvVarSource {
+		return &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: name},
+			Key:                  key,
+			Optional:             optional,
+		}}
+	}
+	operatorDefaults := func() *config.OperatorConfig {
+		return &config.OperatorConfig{
+			KafkaBootstrapServers: "kafka:9092",
+			KafkaSecurityProtocol: "SASL_PLAINTEXT",
+			KafkaSaslMechanism:    "SCRAM-SHA-512",
+			KafkaUsernameKey:      "username",
+			KafkaPasswordKey:      "password",
+		}
+	}
+
+	tests := []struct {
+		name  string
+		kafka *locustv2.KafkaIntegration
+		cfg   func(cfg *config.OperatorConfig)
+		want  []corev1.EnvVar
+	}{
+		{
+			name:  "OperatorDefaults",
+			kafka: &locustv2.KafkaIntegration{},
+			want: []corev1.EnvVar{
+				{Name: EnvKafkaBootstrapServers, Value: "kafka:9092"},
+				{Name: EnvKafkaSecurityEnabled, Value: "false"},
+				{Name: EnvKafkaSecurityProtocol, Value: "SASL_PLAINTEXT"},
+				{Name: EnvKafkaSaslMechanism, Value: "SCRAM-SHA-512"},
+			},
+		},
+		{
+			name:  "OperatorCredentialsSecret",
+			kafka: &locustv2.KafkaIntegration{},
+			cfg: func(cfg *config.OperatorConfig) {
+				cfg.KafkaSecurityEnabled = true
+				cfg.KafkaCredentialsSecretName = "kafka-credentials"
+				cfg.KafkaSaslJaasConfigKey = "jaas"
+			},
+			want: []corev1.EnvVar{
+				{Name: EnvKafkaBootstrapServers, Value: "kafka:9092"},
+				{Name: EnvKafkaSecurityEnabled, Value: "true"},
+				{Name: EnvKafkaSecurityProtocol, Value: "SASL_PLAINTEXT"},
+				{Name: EnvKafkaSaslMechanism, Value: "SCRAM-SHA-512"},
+				{Name: EnvKafkaSaslJaasConfig, ValueFrom: secretKeyRef("kafka-credentials", "jaas", nil)},
+				{Name: EnvKafkaUsername, ValueFrom: secretKeyRef("kafka-credentials", "username", nil)},
+				{Name: EnvKafkaPassword, ValueFrom: secretKeyRef("kafka-credentials", "password", nil)},
+			},
+		},
+		{
+			name:  "NoOperatorCredentialsWithoutSecurity",
+			kafka: &locustv2.KafkaIntegration{},
+			cfg: func(cfg *config.OperatorConfig) {
+				cfg.KafkaCredentialsSecretName = "kafka-credentials"
+			},
+			want: []corev1.EnvVar{
+				{Name: EnvKafkaBootstrapServers, Value: "kafka:9092"},
+				{Name: EnvKafkaSecurityEnabled, Value: "false"},
+				{Name: EnvKafkaSecurityProtocol, Value: "SASL_PLAINTEXT"},
+				{Name: EnvKafkaSaslMechanism, Value: "SCRAM-SHA-512"},
+			},
+		},
+		{
+			name: "SpecOverridesOperatorDefaults",
+			kafka: &locustv2.KafkaIntegration{
+				BootstrapServers: "broker-1:9096,broker-2:9096",
+				SecurityEnabled:  ptr.To(true),
+				SecurityProtocol: "SASL_SSL",
+				SaslMechanism:    "SCRAM-SHA-256",
+				UsernameSecretRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "team-kafka"},
+					Key:                  "user",
+				},
+				PasswordSecretRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "team-kafka"},
+					Key:                  "pass",
+				},
+			},
+			cfg: func(cfg *config.OperatorConfig) {
+				cfg.KafkaCredentialsSecretName = "kafka-credentials"
+				cfg.KafkaSaslJaasConfigKey = "jaas"
+			},
+			want: []corev1.EnvVar{
+				{Name: EnvKafkaBootstrapServers, Value: "broker-1:9096,broker-2:9096"},
+				{Name: EnvKafkaSecurityEnabled, Value: "true"},
+				{Name: EnvKafkaSecurityProtocol, Value: "SASL_SSL"},
+				{Name: EnvKafkaSaslMechanism, Value: "SCRAM-SHA-256"},
+				{Name: EnvKafkaSaslJaasConfig, ValueFrom: secretKeyRef("kafka-credentials", "jaas", nil)},
+				{Name: EnvKafkaUsername, ValueFrom: secretKeyRef("team-kafka", "user", nil)},
+				{Name: EnvKafkaPassword, ValueFrom: secretKeyRef("team-kafka", "pass", nil)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lt := &locustv2.LocustTest{Spec: locustv2.LocustTestSpec{
+				Integrations: &locustv2.IntegrationsConfig{Kafka: tt.kafka},
+			}}
+			cfg := operatorDefaults()
+			if tt.cfg != nil {
+				tt.cfg(cfg)
+			}
+
+			result := BuildKafkaEnvVars(lt, cfg)
+
+			assert.Equal(t, tt.want, result)
+			for _, env := range result {
+				if env.Name == EnvKafkaUsername || env.Name == EnvKafkaPassword || env.Name == EnvKafkaSaslJaasConfig {
+					assert.Empty(t, env.Value, "credentials must never be literal values")
+				}
+			}
+		})
+	}
+}
+
 func TestBuildEnvVars_Combined(t *testing.T) {
 	lt := &locustv2.LocustTest{
 		Spec: locustv2.LocustTestSpec{
@@ -281,6 +421,7 @@ func TestBuildEnvVars_Combined(t *testing.T) {
 					{Name: "USER_VAR", Value: "user-value"},
 				},
 			},
+			Integrations: kafkaIntegration(),
 		},
 	}
 	cfg := &config.OperatorConfig{
@@ -289,15 +430,15 @@ func TestBuildEnvVars_Combined(t *testing.T) {
 
 	result := BuildEnvVars(lt, cfg)
 
-	// 7 Kafka vars + 1 user var
-	assert.Len(t, result, 8)
+	// 4 Kafka vars + 1 user var
+	assert.Len(t, result, 5)
 
 	// Kafka vars come first
 	assert.Equal(t, EnvKafkaBootstrapServers, result[0].Name)
 
 	// User var comes last
-	assert.Equal(t, "USER_VAR", result[7].Name)
-	assert.Equal(t, "user-value", result[7].Value)
+	assert.Equal(t, "USER_VAR", result[4].Name)
+	assert.Equal(t, "user-value", result[4].Value)
 }
 
 func TestBuildSecretVolumes_Nil(t *testing.T) {
@@ -523,6 +664,7 @@ func TestBuildEnvVars_WithOTel_Enabled(t *testing.T) {
 					},
 				},
 			},
+			Integrations: kafkaIntegration(),
 		},
 	}
 
@@ -533,8 +675,8 @@ func TestBuildEnvVars_WithOTel_Enabled(t *testing.T) {
 
 	result := BuildEnvVars(lt, cfg)
 
-	// Should have: 7 Kafka + 5 OTel (traces, metrics, endpoint, protocol, insecure) + 1 extra OTel + 1 user = 14
-	assert.Len(t, result, 14)
+	// Should have: 4 Kafka + 5 OTel (traces, metrics, endpoint, protocol, insecure) + 1 extra OTel + 1 user = 11
+	assert.Len(t, result, 11)
 
 	// Convert to map for easier assertions
 	envMap := make(map[string]string)
@@ -570,6 +712,7 @@ func TestBuildEnvVars_WithOTel_Disabled(t *testing.T) {
 					Enabled: false,
 				},
 			},
+			Integrations: kafkaIntegration(),
 		},
 	}
 
@@ -579,8 +722,8 @@ func TestBuildEnvVars_WithOTel_Disabled(t *testing.T) {
 
 	result := BuildEnvVars(lt, cfg)
 
-	// Should have: 7 Kafka + 1 user = 8 (no OTel vars)
-	assert.Len(t, result, 8)
+	// Should have: 4 Kafka + 1 user = 5 (no OTel vars)
+	assert.Len(t, result, 5)
 
 	// Convert to map for easier assertions
 	envMap := make(map[string]string)
@@ -620,8 +763,8 @@ func TestBuildEnvVars_WithOTel_NoObservabilityConfig(t *testing.T) {
 
 	result := BuildEnvVars(lt, cfg)
 
-	// Should have: 7 Kafka + 1 user = 8 (no OTel vars)
-	assert.Len(t, result, 8)
+	// Should have: 1 user (no Kafka or OTel vars)
+	assert.Len(t, result, 1)
 
 	// Convert to map for easier assertions
 	envMap := make(map[string]string)
@@ -678,6 +821,7 @@ func TestBuildEnvVars_OTel_EnvVarOrder(t *testing.T) {
 					Endpoint: "otel-collector:4317",
 				},
 			},
+			Integrations: kafkaIntegration(),
 		},
 	}
 
@@ -688,12 +832,12 @@ func TestBuildEnvVars_OTel_EnvVarOrder(t *testing.T) {
 	result := BuildEnvVars(lt, cfg)
 
 	// Verify order: Kafka vars first, then OTel vars, then user vars last
-	// First 7 should be Kafka
+	// First 4 should be Kafka
 	assert.Equal(t, EnvKafkaBootstrapServers, result[0].Name)
 
-	// OTel vars come after Kafka (at indices 7-10: traces, metrics, endpoint, protocol)
+	// OTel vars come after Kafka (at indices 4-7: traces, metrics, endpoint, protocol)
 	otelVarNames := []string{}
-	for i := 7; i <= 10; i++ {
+	for i := 4; i <= 7; i++ {
 		otelVarNames = append(otelVarNames, result[i].Name)
 	}
 	assert.Contains(t, otelVarNames, "OTEL_TRACES_EXPORTER")
@@ -0,0 +1,253 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"path"
+	"regexp"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/config"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// gitRepoDir is the directory of the clone inside the git volume.
+	gitRepoDir = "repo"
+	// GitCommitKey is the key of the commit SHA in the git commit ConfigMap.
+	GitCommitKey = "commit"
+)
+
+// gitCommitSHA matches a full SHA-1 or SHA-256 commit ID, which unlike a
+// branch or tag always names the same commit.
+var gitCommitSHA = regexp.MustCompile(`^([0-9a-f]{40}|[0-9a-f]{64})$`)
+
+// gitCloneScript clones the revision into the git volume and writes the
+// commit SHA to the termination log, where the operator reads it from the
+// container status. On failure the log tail becomes the termination message.
+const gitCloneScript = `set -eu
+export HOME=/git/.home
+mkdir -p "$HOME"
+trap 'rm -rf "$HOME"' EXIT
+
+if [ -f /etc/git-secret/ssh-privatekey ]; then
+  mkdir -p "$HOME/.ssh"
+  cp /etc/git-secret/ssh-privatekey "$HOME/.ssh/id"
+  chmod 600 "$HOME/.ssh/id"
+  if [ -f /etc/git-secret/known_hosts ]; then
+    export GIT_SSH_COMMAND="ssh -i $HOME/.ssh/id -o IdentitiesOnly=yes -o UserKnownHostsFile=/etc/git-secret/known_hosts"
+  else
+    export GIT_SSH_COMMAND="ssh -i $HOME/.ssh/id -o IdentitiesOnly=yes -o StrictHostKeyChecking=accept-new"
+  fi
+fi
+if [ -n "${GIT_PASSWORD:-}" ]; then
+  git config --global credential.helper '!f() { echo "username=${GIT_USERNAME:-git}"; echo "password=$GIT_PASSWORD"; }; f'
+fi
+
+git init -q /git/repo
+cd /git/repo
+git remote add origin "$GIT_URL"
+git fetch -q --depth 1 origin "$GIT_REVISION"
+git checkout -q FETCH_HEAD
+
+for dir in "$GIT_PATH" "$GIT_LIB_PATH"; do
+  if [ ! -d "/git/repo/$dir" ]; then
+    echo "directory $dir not found in $GIT_URL at $GIT_REVISION" >&2
+    exit 1
+  fi
+done
+
+git rev-parse HEAD > /dev/termination-log
+`
+
+// HasGitSource reports whether the test files are cloned from a Git repository.
+func HasGitSource(lt *locustv2.LocustTest) bool {
+	return lt.Spec.TestFiles != nil && lt.Spec.TestFiles.Git != nil
+}
+
+// gitRevision returns the revision to fetch; HEAD is the default branch.
+func gitRevision(git *locustv2.GitSource) string {
+	if git.Revision == "" {
+		return "HEAD"
+	}
+	return git.Revision
+}
+
+// HasGitCommitPin reports whether the workers clone the commit the master
+// resolved the revision to, read from the git commit ConfigMap. A branch or
+// tag can move between the clones; a full commit SHA can't.
+func HasGitCommitPin(lt *locustv2.LocustTest) bool {
+	return HasGitSource(lt) && !gitCommitSHA.MatchString(lt.Spec.TestFiles.Git.Revision)
+}
+
+// GitCommitConfigMapName returns the name of the ConfigMap holding the commit
+// the master cloned, e.g. "team-a.load-test" -> "team-a-load-test-git-commit".
+func GitCommitConfigMapName(crName string) string {
+	return locustv2.SanitizeResourceName(crName) + "-git-commit"
+}
+
+// BuildGitCommitConfigMap creates the ConfigMap the workers read the commit
+// to clone from. Returns nil unless the workers are pinned to it.
+func BuildGitCommitConfigMap(lt *locustv2.LocustTest, commit string) *corev1.ConfigMap {
+	if !HasGitCommitPin(lt) {
+		return nil
+	}
+
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      GitCommitConfigMapName(lt.Name),
+			Namespace: lt.Namespace,
+			Labels: map[string]string{
+				LabelManagedBy: ManagedByValue,
+				LabelTestName:  lt.Name,
+			},
+		},
+		// The workers of a run must all clone the same commit
+		Immutable: ptr.To(true),
+		Data:      map[string]string{GitCommitKey: commit},
+	}
+}
+
+// buildGitRevisionEnvVar returns GIT_REVISION. The master resolves the
+// revision; unless it is a commit SHA, the workers read the resulting commit
+// from the git commit ConfigMap, and wait for the operator to create it once
+// the master's clone finished.
+func buildGitRevisionEnvVar(lt *locustv2.LocustTest, mode OperationalMode) corev1.EnvVar {
+	if mode == Master || !HasGitCommitPin(lt) {
+		return corev1.EnvVar{Name: "GIT_REVISION", Value: gitRevision(lt.Spec.TestFiles.Git)}
+	}
+
+	return corev1.EnvVar{
+		Name: "GIT_REVISION",
+		ValueFrom: &corev1.EnvVarSource{
+			ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: GitCommitConfigMapName(lt.Name)},
+				Key:                  GitCommitKey,
+			},
+		},
+	}
+}
+
+// buildGitCloneContainer creates the init container cloning testFiles.git
+// into the git volume before Locust starts.
+func buildGitCloneContainer(lt *locustv2.LocustTest, cfg *config.OperatorConfig, mode OperationalMode) corev1.Container {
+	git := lt.Spec.TestFiles.Git
+
+	env := []corev1.EnvVar{
+		{Name: "GIT_URL", Value: git.URL},
+		buildGitRevisionEnvVar(lt, mode),
+		{Name: "GIT_PATH", Value: path.Clean(git.Path)},
+		{Name: "GIT_LIB_PATH", Value: path.Clean(git.LibPath)},
+	}
+	mounts := []corev1.VolumeMount{
+		{Name: GitVolumeName, MountPath: GitMountPath},
+	}
+	if git.SecretRef != nil {
+		env = append(env,
+			gitSecretEnvVar("GIT_USERNAME", git.SecretRef.Name, "username"),
+			gitSecretEnvVar("GIT_PASSWORD", git.SecretRef.Name, "password"),
+		)
+		mounts = append(mounts, corev1.VolumeMount{
+			Name:      GitSecretVolumeName,
+			MountPath: GitSecretMountPath,
+			ReadOnly:  true,
+		})
+	}
+
+	return corev1.Container{
+		Name:                     GitCloneContainerName,
+		Image:                    cfg.GitCloneImage,
+		ImagePullPolicy:          corev1.PullIfNotPresent,
+		Command:                  []string{"sh", "-c", gitCloneScript},
+		Env:                      env,
+		TerminationMessagePath:   corev1.TerminationMessagePathDefault,
+		TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+		Resources: corev1.ResourceRequirements{
+			Requests: buildResourceList("50m", "64Mi", ""),
+			Limits:   buildResourceList("500m", "256Mi", ""),
+		},
+		VolumeMounts: mounts,
+		SecurityContext: &corev1.SecurityContext{
+			AllowPrivilegeEscalation: ptr.To(false),
+			Capabilities: &corev1.Capabilities{
+				Drop: []corev1.Capability{"ALL"}, //nolint:goconst // idiomatic K8s security context literal
+			},
+			ReadOnlyRootFilesystem: ptr.To(true),
+		},
+	}
+}
+
+// gitSecretEnvVar reads an optional key of the git credentials Secret.
+func gitSecretEnvVar(name, secretName, key string) corev1.EnvVar {
+	return corev1.EnvVar{
+		Name: name,
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+				Key:                  key,
+				Optional:             ptr.To(true),
+			},
+		},
+	}
+}
+
+// buildGitVolumes creates the emptyDir the repository is cloned into and,
+// when credentials are set, the volume of the credentials Secret.
+func buildGitVolumes(lt *locustv2.LocustTest) []corev1.Volume {
+	git := lt.Spec.TestFiles.Git
+
+	volumes := []corev1.Volume{{
+		Name:         GitVolumeName,
+		VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+	}}
+	if git.SecretRef != nil {
+		volumes = append(volumes, corev1.Volume{
+			Name: GitSecretVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName:  git.SecretRef.Name,
+					DefaultMode: ptr.To(int32(0o440)),
+				},
+			},
+		})
+	}
+	return volumes
+}
+
+// buildGitVolumeMounts mounts the repository's test files directory at
+// srcPath and, when set, its library directory at libPath.
+func buildGitVolumeMounts(lt *locustv2.LocustTest, srcPath, libPath string) []corev1.VolumeMount {
+	git := lt.Spec.TestFiles.Git
+
+	mounts := []corev1.VolumeMount{{
+		Name:      GitVolumeName,
+		MountPath: srcPath,
+		SubPath:   path.Join(gitRepoDir, git.Path),
+		ReadOnly:  true,
+	}}
+	if git.LibPath != "" {
+		mounts = append(mounts, corev1.VolumeMount{
+			Name:      GitVolumeName,
+			MountPath: libPath,
+			SubPath:   path.Join(gitRepoDir, git.LibPath),
+			ReadOnly:  true,
+		})
+	}
+	return mounts
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+)
+
+func newTestGitLocustTest() *locustv2.LocustTest {
+	lt := newTestLocustTest()
+	lt.Spec.TestFiles = &locustv2.TestFilesConfig{
+		Git: &locustv2.GitSource{
+			URL:      "https://github.com/example/load-tests.git",
+			Revision: "v1.2.0",
+			Path:     "tests/checkout",
+			LibPath:  "lib",
+		},
+	}
+	return lt
+}
+
+func findInitContainer(podSpec corev1.PodSpec, name string) *corev1.Container {
+	for i := range podSpec.InitContainers {
+		if podSpec.InitContainers[i].Name == name {
+			return &podSpec.InitContainers[i]
+		}
+	}
+	return nil
+}
+
+func TestBuildJobs_WithGitSource(t *testing.T) {
+	lt := newTestGitLocustTest()
+	cfg := newTestConfig()
+
+	workerRevision := corev1.EnvVar{
+		Name: "GIT_REVISION",
+		ValueFrom: &corev1.EnvVarSource{ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "my-test-git-commit"},
+			Key:                  GitCommitKey,
+		}},
+	}
+	for _, job := range []struct {
+		podSpec  corev1.PodSpec
+		revision corev1.EnvVar
+	}{
+		{BuildMasterJob(lt, cfg, logr.Discard()).Spec.Template.Spec, corev1.EnvVar{Name: "GIT_REVISION", Value: "v1.2.0"}},
+		{BuildWorkerJob(lt, cfg, logr.Discard()).Spec.Template.Spec, workerRevision},
+		{BuildWorkerGroupJob(lt, &locustv2.WorkerGroup{Name: "eu", Replicas: 1}, cfg, logr.Discard()).Spec.Template.Spec, workerRevision},
+	} {
+		podSpec := job.podSpec
+		require.NotEmpty(t, podSpec.InitContainers)
+		clone := podSpec.InitContainers[0]
+		assert.Equal(t, GitCloneContainerName, clone.Name, "the clone runs before any sidecar")
+		assert.False(t, IsNativeSidecar(clone))
+		assert.Equal(t, cfg.GitCloneImage, clone.Image)
+		assert.Equal(t, []string{"sh", "-c", gitCloneScript}, clone.Command)
+		assert.Equal(t, corev1.TerminationMessageFallbackToLogsOnError, clone.TerminationMessagePolicy)
+		assert.Equal(t, []corev1.EnvVar{
+			{Name: "GIT_URL", Value: "https://github.com/example/load-tests.git"},
+			job.revision,
+			{Name: "GIT_PATH", Value: "tests/checkout"},
+			{Name: "GIT_LIB_PATH", Value: "lib"},
+		}, clone.Env)
+		assert.Equal(t, []corev1.VolumeMount{{Name: GitVolumeName, MountPath: GitMountPath}}, clone.VolumeMounts)
+
+		assert.Contains(t, podSpec.Volumes, corev1.Volume{
+			Name:         GitVolumeName,
+			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+		})
+		mounts := podSpec.Containers[0].VolumeMounts
+		assert.Contains(t, mounts, corev1.VolumeMount{
+			Name: GitVolumeName, MountPath: DefaultMountPath, SubPath: "repo/tests/checkout", ReadOnly: true,
+		})
+		assert.Contains(t, mounts, corev1.VolumeMount{
+			Name: GitVolumeName, MountPath: LibMountPath, SubPath: "repo/lib", ReadOnly: true,
+		})
+	}
+}
+
+func TestBuildWorkerJobs_WithGitCommitSHA(t *testing.T) {
+	lt := newTestGitLocustTest()
+	lt.Spec.TestFiles.Git.Revision = "4b825dc642cb6eb9a060e54bf8d69288fbee4904"
+	lt.Spec.WorkerGroups = []locustv2.WorkerGroup{{Name: "eu", Replicas: 2}}
+	cfg := newTestConfig()
+
+	assert.False(t, HasGitCommitPin(lt))
+	assert.Nil(t, BuildGitCommitConfigMap(lt, "4b825dc642cb6eb9a060e54bf8d69288fbee4904"))
+
+	jobs := append(BuildWorkerGroupJobs(lt, cfg, logr.Discard()), BuildWorkerJob(lt, cfg, logr.Discard()))
+	for _, job := range jobs {
+		clone := findInitContainer(job.Spec.Template.Spec, GitCloneContainerName)
+		require.NotNil(t, clone)
+		assert.Contains(t, clone.Env, corev1.EnvVar{
+			Name: "GIT_REVISION", Value: "4b825dc642cb6eb9a060e54bf8d69288fbee4904",
+		}, "a commit SHA can't move, so %s clones it directly", job.Name)
+	}
+}
+
+func TestBuildGitCommitConfigMap(t *testing.T) {
+	lt := newTestGitLocustTest()
+
+	configMap := BuildGitCommitConfigMap(lt, "4b825dc642cb6eb9a060e54bf8d69288fbee4904")
+	require.NotNil(t, configMap)
+	assert.Equal(t, "my-test-git-commit", configMap.Name)
+	assert.Equal(t, lt.Namespace, configMap.Namespace)
+	assert.Equal(t, map[string]string{"commit": "4b825dc642cb6eb9a060e54bf8d69288fbee4904"}, configMap.Data)
+	assert.True(t, *configMap.Immutable)
+
+	assert.Nil(t, BuildGitCommitConfigMap(newTestLocustTest(), "4b825dc642cb6eb9a060e54bf8d69288fbee4904"))
+}
+
+func TestBuildMasterJob_WithGitSourceDefaults(t *testing.T) {
+	lt := newTestLocustTest()
+	lt.Spec.TestFiles = &locustv2.TestFilesConfig{
+		SrcMountPath: "/home/locust/tests",
+		Git:          &locustv2.GitSource{URL: "git@github.com:example/load-tests.git"},
+	}
+
+	podSpec := BuildMasterJob(lt, newTestConfig(), logr.Discard()).Spec.Template.Spec
+
+	clone := findInitContainer(podSpec, GitCloneContainerName)
+	require.NotNil(t, clone)
+	assert.Contains(t, clone.Env, corev1.EnvVar{Name: "GIT_REVISION", Value: "HEAD"}, "the default branch")
+	assert.Contains(t, clone.Env, corev1.EnvVar{Name: "GIT_PATH", Value: "."})
+
+	var gitMounts []corev1.VolumeMount
+	for _, m := range podSpec.Containers[0].VolumeMounts {
+		if m.Name == GitVolumeName {
+			gitMounts = append(gitMounts, m)
+		}
+	}
+	assert.Equal(t, []corev1.VolumeMount{
+		{Name: GitVolumeName, MountPath: "/home/locust/tests", SubPath: "repo", ReadOnly: true},
+	}, gitMounts, "no library mount without libPath")
+}
+
+func TestBuildMasterJob_WithGitCredentials(t *testing.T) {
+	lt := newTestGitLocustTest()
+	lt.Spec.TestFiles.Git.SecretRef = &corev1.LocalObjectReference{Name: "git-credentials"}
+
+	podSpec := BuildMasterJob(lt, newTestConfig(), logr.Discard()).Spec.Template.Spec
+
+	assert.Contains(t, podSpec.Volumes, corev1.Volume{
+		Name: GitSecretVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{SecretName: "git-credentials", DefaultMode: ptr.To(int32(0o440))},
+		},
+	})
+
+	clone := findInitContainer(podSpec, GitCloneContainerName)
+	require.NotNil(t, clone)
+	assert.Contains(t, clone.VolumeMounts, corev1.VolumeMount{
+		Name: GitSecretVolumeName, MountPath: GitSecretMountPath, ReadOnly: true,
+	})
+	assert.Contains(t, clone.Env, corev1.EnvVar{
+		Name: "GIT_PASSWORD",
+		ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "git-credentials"},
+			Key:                  "password",
+			Optional:             ptr.To(true),
+		}},
+	})
+
+	for _, m := range podSpec.Containers[0].VolumeMounts {
+		assert.NotEqual(t, GitSecretVolumeName, m.Name, "Locust never sees the git credentials")
+	}
+}
+
+func TestBuildMasterJob_WithoutGitSource(t *testing.T) {
+	podSpec := BuildMasterJob(newTestLocustTest(), newTestConfig(), logr.Discard()).Spec.Template.Spec
+
+	assert.Nil(t, findInitContainer(podSpec, GitCloneContainerName))
+	for _, v := range podSpec.Volumes {
+		assert.NotEqual(t, GitVolumeName, v.Name)
+	}
+}
@@ -19,6 +19,7 @@ package resources
 import (
 	"fmt"
 	"log"
+	"slices"
 
 	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
 	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/config"
@@ -34,9 +35,19 @@ import (
 func BuildMasterJob(lt *locustv2.LocustTest, cfg *config.OperatorConfig, logger logr.Logger) *batchv1.Job {
 	nodeName := NodeName(lt.Name, Master)
 	otelEnabled := IsOTelEnabled(lt)
-	command := BuildMasterCommand(&lt.Spec.Master, lt.Spec.Worker.Replicas, otelEnabled, logger)
-
-	return buildJob(lt, cfg, Master, nodeName, command)
+	command := BuildMasterCommand(&lt.Spec.Master, ExpectedWorkers(lt), otelEnabled, logger)
+	// The load and results flags go before the extraArgs, which come last so
+	// that user flags take precedence, like for the other operator flags.
+	operatorArgs := append(BuildLoadArgs(lt.Spec.Load), BuildResultsArgs(lt)...)
+	command = slices.Insert(command, len(command)-len(lt.Spec.Master.ExtraArgs), operatorArgs...)
+	if HasLoadStages(lt) {
+		command = AppendLocustfile(command, LoadShapeMountPath+"/"+LoadShapeFileName)
+	}
+
+	job := buildJob(lt, cfg, Master, nodeName, command)
+	job.Annotations = map[string]string{AnnotationSpecHash: SpecHash(lt)}
+	applyPodTemplate(job, lt.Spec.Master.PodTemplate, logger)
+	return job
 }
 
 // BuildWorkerJob creates a Kubernetes Job for the Locust worker nodes.
@@ -46,7 +57,9 @@ func BuildWorkerJob(lt *locustv2.LocustTest, cfg *config.OperatorConfig, logger
 	otelEnabled := IsOTelEnabled(lt)
 	command := BuildWorkerCommand(lt.Spec.Worker.Command, masterHost, otelEnabled, lt.Spec.Worker.ExtraArgs, logger)
 
-	return buildJob(lt, cfg, Worker, nodeName, command)
+	job := buildJob(lt, cfg, Worker, nodeName, command)
+	applyPodTemplate(job, lt.Spec.Worker.PodTemplate, logger)
+	return job
 }
 
 // buildJob is the internal function that constructs a Job for either master or worker.
@@ -75,12 +88,22 @@ func buildJob(lt *locustv2.LocustTest, cfg *config.OperatorConfig, mode Operatio
 		buildLocustContainer(lt, nodeName, command, ports, cfg, mode),
 	}
 
-	// Build native sidecar containers (initContainers with restartPolicy: Always)
+	// Build init containers: the git clone and the user's init containers run
+	// to completion first, then the native sidecars (initContainers with
+	// restartPolicy: Always) start, the operator's before the user's.
 	// Native sidecars (k8s 1.29+) auto-terminate when main containers complete
 	var initContainers []corev1.Container
-	if mode == Master && !IsOTelEnabled(lt) {
+	if HasGitSource(lt) {
+		initContainers = append(initContainers, buildGitCloneContainer(lt, cfg, mode))
+	}
+	initContainers = append(initContainers, buildUserInitContainers(lt, mode)...)
+	if mode == Master && HasMetricsExporterSidecar(lt, cfg) {
 		initContainers = append(initContainers, buildMetricsExporterSidecar(cfg))
 	}
+	if mode == Master && HasResultsCollector(lt) {
+		initContainers = append(initContainers, buildResultsCollectorSidecar(lt))
+	}
+	initContainers = append(initContainers, buildUserSidecars(lt, mode)...)
 
 	backoffLimit := int32(BackoffLimit)
 
@@ -91,6 +114,8 @@ func buildJob(lt *locustv2.LocustTest, cfg *config.OperatorConfig, mode Operatio
 		},
 		Spec: batchv1.JobSpec{
 			TTLSecondsAfterFinished: cfg.TTLSecondsAfterFinished,
+			ActiveDeadlineSeconds:   buildActiveDeadlineSeconds(lt, cfg),
+			Suspend:                 buildSuspend(lt),
 			Parallelism:             &parallelism,
 			BackoffLimit:            &backoffLimit,
 			Template: corev1.PodTemplateSpec{
@@ -99,16 +124,17 @@ func buildJob(lt *locustv2.LocustTest, cfg *config.OperatorConfig, mode Operatio
 					Annotations: annotations,
 				},
 				Spec: corev1.PodSpec{
-					RestartPolicy:    corev1.RestartPolicyNever,
-					ImagePullSecrets: buildImagePullSecrets(lt),
-					InitContainers:   initContainers,
-					Containers:       containers,
-					Volumes:          buildVolumes(lt, nodeName, mode),
-					Affinity:         buildAffinity(lt, cfg),
-					Tolerations:      buildTolerations(lt, cfg),
-					NodeSelector:     buildNodeSelector(lt),
-					RuntimeClassName: buildRuntimeClassName(lt, cfg),
-					SecurityContext:  buildPodSecurityContext(lt),
+					RestartPolicy:                 corev1.RestartPolicyNever,
+					TerminationGracePeriodSeconds: buildTerminationGracePeriod(lt, mode),
+					ImagePullSecrets:              buildImagePullSecrets(lt),
+					InitContainers:                initContainers,
+					Containers:                    containers,
+					Volumes:                       buildVolumes(lt, nodeName, mode),
+					Affinity:                      buildAffinity(lt, cfg),
+					Tolerations:                   buildTolerations(lt, cfg),
+					NodeSelector:                  buildNodeSelector(lt),
+					RuntimeClassName:              buildRuntimeClassName(lt, cfg),
+					SecurityContext:               buildPodSecurityContext(lt),
 				},
 			},
 		},
@@ -117,6 +143,21 @@ func buildJob(lt *locustv2.LocustTest, cfg *config.OperatorConfig, mode Operatio
 	return job
 }
 
+// HasDeferredStart reports whether the test waits for spec.suspend to be
+// cleared or spec.startAt to pass before it starts.
+func HasDeferredStart(lt *locustv2.LocustTest) bool {
+	return lt.Spec.Suspend || lt.Spec.StartAt != nil
+}
+
+// buildSuspend creates the Jobs of a test with a deferred start suspended;
+// the controller unsuspends them when the test starts. Returns nil otherwise.
+func buildSuspend(lt *locustv2.LocustTest) *bool {
+	if !HasDeferredStart(lt) {
+		return nil
+	}
+	return ptr.To(true)
+}
+
 // buildLocustContainer creates the main Locust container.
 func buildLocustContainer(lt *locustv2.LocustTest, name string, command []string, ports []corev1.ContainerPort, cfg *config.OperatorConfig, mode OperationalMode) corev1.Container {
 	container := corev1.Container{
@@ -153,7 +194,7 @@ func buildMetricsExporterSidecar(cfg *config.OperatorConfig) corev1.Container {
 		ImagePullPolicy: corev1.PullPolicy(cfg.MetricsExporterPullPolicy),
 		RestartPolicy:   ptr.To(corev1.ContainerRestartPolicyAlways),
 		Ports: []corev1.ContainerPort{
-			{ContainerPort: cfg.MetricsExporterPort},
+			{Name: MetricsContainerPortName, ContainerPort: cfg.MetricsExporterPort},
 		},
 		Resources: buildResourceRequirements(cfg, true),
 		Env: []corev1.EnvVar{
@@ -182,8 +223,8 @@ func buildMetricsExporterSidecar(cfg *config.OperatorConfig) corev1.Container {
 // end-of-life, and that applies equally to sidecars injected by third parties
 // (e.g. a service mesh), so the check is deliberately structural rather than
 // scoped to the operator's own metrics exporter. Genuine mid-run failures
-// still surface as CrashLoopBackOff, which is never exempted. A test verifies
-// the built metrics exporter satisfies this predicate.
+// still surface as CrashLoopBackOff, which is never exempted. Tests verify
+// the built metrics exporter and user sidecars satisfy this predicate.
 func IsNativeSidecar(c corev1.Container) bool {
 	return c.RestartPolicy != nil && *c.RestartPolicy == corev1.ContainerRestartPolicyAlways
 }
@@ -193,14 +234,14 @@ func buildImagePullSecrets(lt *locustv2.LocustTest) []corev1.LocalObjectReferenc
 	return lt.Spec.ImagePullSecrets
 }
 
-// buildVolumes creates the volumes for ConfigMap, LibConfigMap, Secrets, and user volumes.
+// buildVolumes creates the volumes for ConfigMap, LibConfigMap, the git clone, the load shape, results, Secrets, and user volumes.
 func buildVolumes(lt *locustv2.LocustTest, nodeName string, mode OperationalMode) []corev1.Volume {
 	var volumes []corev1.Volume
 
 	// Get ConfigMap refs from v2 TestFiles config
-	var configMapRef, libConfigMapRef string
+	configMapRef := TestFilesConfigMapName(lt)
+	var libConfigMapRef string
 	if lt.Spec.TestFiles != nil {
-		configMapRef = lt.Spec.TestFiles.ConfigMapRef
 		libConfigMapRef = lt.Spec.TestFiles.LibConfigMapRef
 	}
 
@@ -230,6 +271,30 @@ func buildVolumes(lt *locustv2.LocustTest, nodeName string, mode OperationalMode
 		})
 	}
 
+	// Add the repository cloned from testFiles.git
+	if HasGitSource(lt) {
+		volumes = append(volumes, buildGitVolumes(lt)...)
+	}
+
+	// Add the generated LoadTestShape (master only)
+	if mode == Master && HasLoadStages(lt) {
+		volumes = append(volumes, corev1.Volume{
+			Name: LoadShapeVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: LoadShapeConfigMapName(lt.Name),
+					},
+				},
+			},
+		})
+	}
+
+	// Add the results volume (master only)
+	if mode == Master && HasResults(lt) {
+		volumes = append(volumes, buildResultsVolume(lt))
+	}
+
 	// Add secret volumes from env.secretMounts
 	secretVolumes := BuildSecretVolumes(lt)
 	if len(secretVolumes) > 0 {
@@ -245,16 +310,16 @@ func buildVolumes(lt *locustv2.LocustTest, nodeName string, mode OperationalMode
 	return volumes
 }
 
-// buildVolumeMounts creates the volume mounts for ConfigMap, LibConfigMap, Secrets, and user mounts.
+// buildVolumeMounts creates the volume mounts for ConfigMap, LibConfigMap, the git clone, the load shape, results, Secrets, and user mounts.
 func buildVolumeMounts(lt *locustv2.LocustTest, nodeName string, mode OperationalMode) []corev1.VolumeMount {
 	var mounts []corev1.VolumeMount
 
 	// Get ConfigMap refs and mount paths from v2 TestFiles config
-	var configMapRef, libConfigMapRef string
+	configMapRef := TestFilesConfigMapName(lt)
+	var libConfigMapRef string
 	srcMountPath := DefaultMountPath
 	libMountPath := LibMountPath
 	if lt.Spec.TestFiles != nil {
-		configMapRef = lt.Spec.TestFiles.ConfigMapRef
 		libConfigMapRef = lt.Spec.TestFiles.LibConfigMapRef
 		if lt.Spec.TestFiles.SrcMountPath != "" {
 			srcMountPath = lt.Spec.TestFiles.SrcMountPath
@@ -280,6 +345,28 @@ func buildVolumeMounts(lt *locustv2.LocustTest, nodeName string, mode Operationa
 		})
 	}
 
+	// Add the repository cloned from testFiles.git
+	if HasGitSource(lt) {
+		mounts = append(mounts, buildGitVolumeMounts(lt, srcMountPath, libMountPath)...)
+	}
+
+	// Add the generated LoadTestShape (master only)
+	if mode == Master && HasLoadStages(lt) {
+		mounts = append(mounts, corev1.VolumeMount{
+			Name:      LoadShapeVolumeName,
+			MountPath: LoadShapeMountPath,
+			ReadOnly:  true,
+		})
+	}
+
+	// Add the results volume (master only)
+	if mode == Master && HasResults(lt) {
+		mounts = append(mounts, corev1.VolumeMount{
+			Name:      ResultsVolumeName,
+			MountPath: ResultsMountPath,
+		})
+	}
+
 	// Add secret mounts from env.secretMounts
 	secretMounts := BuildSecretVolumeMounts(lt)
 	if len(secretMounts) > 0 {
@@ -18,6 +18,7 @@ package resources
 
 import (
 	"testing"
+	"time"
 
 	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
 	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/config"
@@ -28,6 +29,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 const secretTLSCertsVolumeName = "secret-tls-certs"
@@ -74,6 +76,8 @@ func newTestConfig() *config.OperatorConfig {
 		MetricsExporterMemLimit:                "1024Mi",
 		MetricsExporterEphemeralStorageLimit:   "50M",
 
+		GitCloneImage: "alpine/git:v2.47.2",
+
 		KafkaBootstrapServers: "localhost:9092",
 		KafkaSecurityEnabled:  false,
 		KafkaSecurityProtocol: "SASL_PLAINTEXT",
@@ -129,6 +133,24 @@ func TestBuildMasterJob_WithTTL(t *testing.T) {
 	assert.Equal(t, int32(3600), *job.Spec.TTLSecondsAfterFinished)
 }
 
+func TestBuildJob_Suspend(t *testing.T) {
+	lt := newTestLocustTest()
+	cfg := newTestConfig()
+	assert.Nil(t, BuildMasterJob(lt, cfg, logr.Discard()).Spec.Suspend, "Jobs start right away by default")
+
+	for name, mutate := range map[string]func(lt *locustv2.LocustTest){
+		"Suspend": func(lt *locustv2.LocustTest) { lt.Spec.Suspend = true },
+		"StartAt": func(lt *locustv2.LocustTest) { lt.Spec.StartAt = &metav1.Time{Time: time.Now().Add(time.Hour)} },
+	} {
+		t.Run(name, func(t *testing.T) {
+			lt := newTestLocustTest()
+			mutate(lt)
+			assert.Equal(t, ptr.To(true), BuildMasterJob(lt, cfg, logr.Discard()).Spec.Suspend)
+			assert.Equal(t, ptr.To(true), BuildWorkerJob(lt, cfg, logr.Discard()).Spec.Suspend)
+		})
+	}
+}
+
 func TestBuildMasterJob_WithImagePullSecrets(t *testing.T) {
 	lt := newTestLocustTest()
 	lt.Spec.ImagePullSecrets = []corev1.LocalObjectReference{
@@ -601,25 +623,55 @@ func TestBuildMasterJob_NoConfigMap(t *testing.T) {
 
 func TestBuildMasterJob_KafkaEnvVars(t *testing.T) {
 	lt := newTestLocustTest()
+	lt.Spec.Integrations = &locustv2.IntegrationsConfig{Kafka: &locustv2.KafkaIntegration{
+		SecurityProtocol: "SASL_SSL",
+		PasswordSecretRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "team-kafka"},
+			Key:                  "password",
+		},
+	}}
 	cfg := newTestConfig()
 	cfg.KafkaSecurityEnabled = true
 	cfg.KafkaBootstrapServers = "kafka.example.com:9092"
-	cfg.KafkaSecurityProtocol = "SASL_SSL"
-	cfg.KafkaUsername = "user"
-	cfg.KafkaPassword = "secret"
+	cfg.KafkaCredentialsSecretName = "kafka-credentials"
+	cfg.KafkaUsernameKey = "username"
+	cfg.KafkaPasswordKey = "password"
 
 	job := BuildMasterJob(lt, cfg, logr.Discard())
 
 	container := job.Spec.Template.Spec.Containers[0]
-	envMap := make(map[string]string)
+	envMap := make(map[string]corev1.EnvVar)
 	for _, env := range container.Env {
-		envMap[env.Name] = env.Value
+		envMap[env.Name] = env
 	}
 
-	assert.Equal(t, "kafka.example.com:9092", envMap["KAFKA_BOOTSTRAP_SERVERS"])
-	assert.Equal(t, "SASL_SSL", envMap["KAFKA_SECURITY_PROTOCOL_CONFIG"])
-	assert.Equal(t, "user", envMap["KAFKA_USERNAME"])
-	assert.Equal(t, "secret", envMap["KAFKA_PASSWORD"])
+	assert.Equal(t, "kafka.example.com:9092", envMap["KAFKA_BOOTSTRAP_SERVERS"].Value)
+	assert.Equal(t, "true", envMap["KAFKA_SECURITY_ENABLED"].Value)
+	assert.Equal(t, "SASL_SSL", envMap["KAFKA_SECURITY_PROTOCOL_CONFIG"].Value)
+
+	// Credentials are read from Secrets by the kubelet, never copied into the Job
+	username := envMap["KAFKA_USERNAME"]
+	assert.Empty(t, username.Value)
+	require.NotNil(t, username.ValueFrom)
+	assert.Equal(t, "kafka-credentials", username.ValueFrom.SecretKeyRef.Name)
+	assert.Equal(t, "username", username.ValueFrom.SecretKeyRef.Key)
+	password := envMap["KAFKA_PASSWORD"]
+	assert.Empty(t, password.Value)
+	require.NotNil(t, password.ValueFrom)
+	assert.Equal(t, "team-kafka", password.ValueFrom.SecretKeyRef.Name)
+	assert.NotContains(t, envMap, "KAFKA_SASL_JAAS_CONFIG")
+}
+
+func TestBuildMasterJob_NoKafkaEnvVarsByDefault(t *testing.T) {
+	lt := newTestLocustTest()
+	cfg := newTestConfig()
+	cfg.KafkaCredentialsSecretName = "kafka-credentials"
+
+	job := BuildMasterJob(lt, cfg, logr.Discard())
+
+	for _, env := range job.Spec.Template.Spec.Containers[0].Env {
+		assert.NotContains(t, env.Name, "KAFKA_")
+	}
 }
 
 func TestBuildAffinity_NilScheduling(t *testing.T) {
@@ -723,8 +775,8 @@ func TestBuildMasterJob_WithEnvVariables(t *testing.T) {
 	assert.Equal(t, "https://example.com", envMap["TARGET_HOST"])
 	assert.Equal(t, "DEBUG", envMap["LOG_LEVEL"])
 
-	// Kafka vars should still be present
-	assert.Contains(t, envMap, "KAFKA_BOOTSTRAP_SERVERS")
+	// Kafka vars are only present when the test uses Kafka
+	assert.NotContains(t, envMap, "KAFKA_BOOTSTRAP_SERVERS")
 }
 
 func TestBuildMasterJob_WithSecretMount(t *testing.T) {
@@ -769,6 +821,7 @@ func TestBuildMasterJob_EnvCombinesKafkaAndUser(t *testing.T) {
 			{Name: "USER_VAR", Value: "user-value"},
 		},
 	}
+	lt.Spec.Integrations = &locustv2.IntegrationsConfig{Kafka: &locustv2.KafkaIntegration{}}
 	cfg := newTestConfig()
 	cfg.KafkaBootstrapServers = "kafka:9092"
 
@@ -776,16 +829,16 @@ func TestBuildMasterJob_EnvCombinesKafkaAndUser(t *testing.T) {
 
 	container := job.Spec.Template.Spec.Containers[0]
 
-	// Should have 7 Kafka vars + 1 user var = 8 total
-	assert.Len(t, container.Env, 8)
+	// Should have 4 Kafka vars + 1 user var = 5 total
+	assert.Len(t, container.Env, 5)
 
 	// Kafka vars come first
 	assert.Equal(t, "KAFKA_BOOTSTRAP_SERVERS", container.Env[0].Name)
 	assert.Equal(t, "kafka:9092", container.Env[0].Value)
 
 	// User var comes last
-	assert.Equal(t, "USER_VAR", container.Env[7].Name)
-	assert.Equal(t, "user-value", container.Env[7].Value)
+	assert.Equal(t, "USER_VAR", container.Env[4].Name)
+	assert.Equal(t, "user-value", container.Env[4].Value)
 }
 
 func TestBuildWorkerJob_WithEnvConfig(t *testing.T) {
@@ -811,13 +864,13 @@ func TestBuildWorkerJob_WithEnvConfig(t *testing.T) {
 	require.Len(t, container.EnvFrom, 1)
 	assert.Equal(t, "app-config", container.EnvFrom[0].ConfigMapRef.Name)
 
-	// Env should have Kafka + user vars
+	// Env should have user vars only, as the test doesn't use Kafka
 	envMap := make(map[string]string)
 	for _, env := range container.Env {
 		envMap[env.Name] = env.Value
 	}
 	assert.Equal(t, "https://example.com", envMap["TARGET_HOST"])
-	assert.Contains(t, envMap, "KAFKA_BOOTSTRAP_SERVERS")
+	assert.NotContains(t, envMap, "KAFKA_BOOTSTRAP_SERVERS")
 
 	// Secret mount should exist
 	var secretMountFound bool
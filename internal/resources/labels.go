@@ -70,14 +70,15 @@ func getUserLabels(lt *locustv2.LocustTest, mode OperationalMode) map[string]str
 
 // BuildAnnotations constructs the annotations for a pod based on the LocustTest CR and mode.
 // Master pods include Prometheus scrape annotations; worker pods do not.
-// When OTel is enabled, Prometheus annotations are suppressed (Locust exports natively via OTLP).
+// When OTel is enabled, Prometheus annotations are suppressed (Locust exports natively via OTLP),
+// as they are with the built-in exporter (the operator exports the metrics).
 // Merges user-defined annotations from the CR spec.
 func BuildAnnotations(lt *locustv2.LocustTest, mode OperationalMode, cfg *config.OperatorConfig) map[string]string {
 	annotations := make(map[string]string)
 
-	// Master pods get Prometheus annotations ONLY if OTel is disabled
+	// Master pods get Prometheus annotations ONLY if the metrics exporter sidecar is deployed
 	// When OTel is enabled, Locust exports metrics natively via OTLP — no sidecar or scrape annotations needed
-	if mode == Master && !IsOTelEnabled(lt) {
+	if mode == Master && HasMetricsExporterSidecar(lt, cfg) {
 		annotations[AnnotationPrometheusScrape] = "true"
 		annotations[AnnotationPrometheusPath] = MetricsEndpointPath
 		annotations[AnnotationPrometheusPort] = fmt.Sprintf("%d", cfg.MetricsExporterPort)
@@ -0,0 +1,177 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// loadShapeTemplate is the LoadTestShape rendered for spec.load.stages.
+// Stages are listed with their cumulative end time in seconds; returning None
+// after the last stage stops the test.
+const loadShapeTemplate = `# Generated by the Locust k8s operator from spec.load.stages. Do not edit.
+from locust import LoadTestShape
+
+
+class OperatorStagesShape(LoadTestShape):
+    stages = [
+%s    ]
+
+    def tick(self):
+        run_time = self.get_run_time()
+        for stage in self.stages:
+            if run_time < stage["end"]:
+                return stage["users"], stage["spawn_rate"]
+        return None
+`
+
+// HasLoadStages reports whether the test uses a staged load profile.
+func HasLoadStages(lt *locustv2.LocustTest) bool {
+	return lt.Spec.Load != nil && len(lt.Spec.Load.Stages) > 0
+}
+
+// LoadShapeConfigMapName returns the name of the ConfigMap holding the
+// generated LoadTestShape, e.g. "team-a.load-test" -> "team-a-load-test-load-shape".
+func LoadShapeConfigMapName(crName string) string {
+	return locustv2.SanitizeResourceName(crName) + "-load-shape"
+}
+
+// BuildLoadShapeConfigMap creates the ConfigMap with the LoadTestShape
+// generated from spec.load.stages. Returns nil when no stages are configured.
+func BuildLoadShapeConfigMap(lt *locustv2.LocustTest) *corev1.ConfigMap {
+	if !HasLoadStages(lt) {
+		return nil
+	}
+
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      LoadShapeConfigMapName(lt.Name),
+			Namespace: lt.Namespace,
+			Labels: map[string]string{
+				LabelManagedBy: ManagedByValue,
+				LabelTestName:  lt.Name,
+			},
+		},
+		// The shape is fixed for the lifetime of the test, like the Jobs using it
+		Immutable: ptr.To(true),
+		Data: map[string]string{
+			LoadShapeFileName: RenderLoadShape(lt.Spec.Load.Stages),
+		},
+	}
+}
+
+// RenderLoadShape renders stages into a Python LoadTestShape class.
+func RenderLoadShape(stages []locustv2.LoadStage) string {
+	var b strings.Builder
+	var end time.Duration
+	for _, stage := range stages {
+		end += stage.Duration.Duration
+		fmt.Fprintf(&b, "        {\"end\": %d, \"users\": %d, \"spawn_rate\": %s},\n",
+			int64(end.Seconds()), stage.Users, formatRate(stage.SpawnRate))
+	}
+	return fmt.Sprintf(loadShapeTemplate, b.String())
+}
+
+// BuildLoadArgs returns the master flags for a constant load profile.
+// Staged profiles are driven by the generated LoadTestShape instead.
+func BuildLoadArgs(load *locustv2.LoadConfig) []string {
+	if load == nil || len(load.Stages) > 0 {
+		return nil
+	}
+
+	var args []string
+	if load.Users != nil {
+		args = append(args, fmt.Sprintf("--users=%d", *load.Users))
+	}
+	if load.SpawnRate != nil {
+		args = append(args, "--spawn-rate="+formatRate(*load.SpawnRate))
+	}
+	if load.RunTime != nil {
+		args = append(args, fmt.Sprintf("--run-time=%ds", int64(load.RunTime.Seconds())))
+	}
+	return args
+}
+
+// AppendLocustfile adds path to the locustfile list (-f/--locustfile) in
+// args, so Locust loads the generated shape class next to the user's
+// locustfile. Returns args unchanged if no locustfile flag is present; the
+// webhook requires one whenever stages are used.
+func AppendLocustfile(args []string, path string) []string {
+	result := make([]string, len(args))
+	copy(result, args)
+
+	for i, arg := range result {
+		switch {
+		case (arg == "-f" || arg == "--locustfile") && i+1 < len(result):
+			result[i+1] += "," + path
+			return result
+		case strings.HasPrefix(arg, "--locustfile="):
+			result[i] += "," + path
+			return result
+		}
+	}
+	return result
+}
+
+// DescribeLoadProfile summarizes the load profile for the status, e.g.
+// "100 users at 10/s for 10m0s" or "3 stages, peak 200 users, 15m0s".
+// Returns "" when no load is configured.
+func DescribeLoadProfile(load *locustv2.LoadConfig) string {
+	if load == nil {
+		return ""
+	}
+
+	if len(load.Stages) > 0 {
+		var total time.Duration
+		var peak int32
+		for _, stage := range load.Stages {
+			total += stage.Duration.Duration
+			peak = max(peak, stage.Users)
+		}
+		noun := "stages"
+		if len(load.Stages) == 1 {
+			noun = "stage"
+		}
+		return fmt.Sprintf("%d %s, peak %d users, %s", len(load.Stages), noun, peak, total)
+	}
+
+	var parts []string
+	if load.Users != nil {
+		parts = append(parts, fmt.Sprintf("%d users", *load.Users))
+	}
+	if load.SpawnRate != nil {
+		parts = append(parts, fmt.Sprintf("at %s/s", formatRate(*load.SpawnRate)))
+	}
+	if load.RunTime != nil {
+		parts = append(parts, fmt.Sprintf("for %s", load.RunTime.Duration))
+	}
+	return strings.Join(parts, " ")
+}
+
+// formatRate renders a quantity as a plain decimal Locust accepts, e.g. "500m" -> "0.5".
+func formatRate(q resource.Quantity) string {
+	return strconv.FormatFloat(q.AsApproximateFloat64(), 'f', -1, 64)
+}
@@ -0,0 +1,223 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+	"time"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func newTestLoadStages() []locustv2.LoadStage {
+	return []locustv2.LoadStage{
+		{Duration: metav1.Duration{Duration: 2 * time.Minute}, Users: 50, SpawnRate: resource.MustParse("5")},
+		{Duration: metav1.Duration{Duration: 10 * time.Minute}, Users: 200, SpawnRate: resource.MustParse("10")},
+		{Duration: metav1.Duration{Duration: 90 * time.Second}, Users: 0, SpawnRate: resource.MustParse("500m")},
+	}
+}
+
+func TestBuildLoadArgs_Nil(t *testing.T) {
+	assert.Nil(t, BuildLoadArgs(nil))
+}
+
+func TestBuildLoadArgs_ConstantLoad(t *testing.T) {
+	load := &locustv2.LoadConfig{
+		Users:     ptr.To(int32(100)),
+		SpawnRate: ptr.To(resource.MustParse("2500m")),
+		RunTime:   &metav1.Duration{Duration: 10 * time.Minute},
+	}
+
+	assert.Equal(t, []string{"--users=100", "--spawn-rate=2.5", "--run-time=600s"}, BuildLoadArgs(load))
+}
+
+func TestBuildLoadArgs_PartialLoad(t *testing.T) {
+	load := &locustv2.LoadConfig{RunTime: &metav1.Duration{Duration: 90 * time.Second}}
+
+	assert.Equal(t, []string{"--run-time=90s"}, BuildLoadArgs(load))
+}
+
+func TestBuildLoadArgs_StagesSetNoFlags(t *testing.T) {
+	load := &locustv2.LoadConfig{Stages: newTestLoadStages()}
+
+	assert.Nil(t, BuildLoadArgs(load))
+}
+
+func TestRenderLoadShape(t *testing.T) {
+	shape := RenderLoadShape(newTestLoadStages())
+
+	assert.Contains(t, shape, "class OperatorStagesShape(LoadTestShape):")
+	// End times are cumulative
+	assert.Contains(t, shape, `{"end": 120, "users": 50, "spawn_rate": 5},`)
+	assert.Contains(t, shape, `{"end": 720, "users": 200, "spawn_rate": 10},`)
+	assert.Contains(t, shape, `{"end": 810, "users": 0, "spawn_rate": 0.5},`)
+	assert.Contains(t, shape, "return None")
+}
+
+func TestBuildLoadShapeConfigMap(t *testing.T) {
+	lt := newTestLocustTest()
+	assert.Nil(t, BuildLoadShapeConfigMap(lt), "no ConfigMap without stages")
+
+	lt.Name = "team-a.load-test"
+	lt.Spec.Load = &locustv2.LoadConfig{Stages: newTestLoadStages()}
+	cm := BuildLoadShapeConfigMap(lt)
+	require.NotNil(t, cm)
+
+	assert.Equal(t, "team-a-load-test-load-shape", cm.Name)
+	assert.Equal(t, "default", cm.Namespace)
+	assert.Equal(t, "team-a.load-test", cm.Labels[LabelTestName])
+	require.NotNil(t, cm.Immutable)
+	assert.True(t, *cm.Immutable)
+	assert.Equal(t, RenderLoadShape(lt.Spec.Load.Stages), cm.Data[LoadShapeFileName])
+}
+
+func TestAppendLocustfile(t *testing.T) {
+	shape := "/lotest/shape/locust_shape.py"
+
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{
+			name: "ShortFlag",
+			args: []string{"-f", "/lotest/src/test.py", "--master"},
+			want: []string{"-f", "/lotest/src/test.py,/lotest/shape/locust_shape.py", "--master"},
+		},
+		{
+			name: "LongFlag",
+			args: []string{"--locustfile", "/lotest/src/test.py"},
+			want: []string{"--locustfile", "/lotest/src/test.py,/lotest/shape/locust_shape.py"},
+		},
+		{
+			name: "LongFlagWithValue",
+			args: []string{"--locustfile=/lotest/src/a.py,/lotest/src/b.py"},
+			want: []string{"--locustfile=/lotest/src/a.py,/lotest/src/b.py,/lotest/shape/locust_shape.py"},
+		},
+		{
+			name: "NoFlag",
+			args: []string{"--master"},
+			want: []string{"--master"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := append([]string(nil), tt.args...)
+			assert.Equal(t, tt.want, AppendLocustfile(tt.args, shape))
+			assert.Equal(t, original, tt.args, "input must not be modified")
+		})
+	}
+}
+
+func TestDescribeLoadProfile(t *testing.T) {
+	assert.Empty(t, DescribeLoadProfile(nil))
+
+	assert.Equal(t, "100 users at 10/s for 10m0s", DescribeLoadProfile(&locustv2.LoadConfig{
+		Users:     ptr.To(int32(100)),
+		SpawnRate: ptr.To(resource.MustParse("10")),
+		RunTime:   &metav1.Duration{Duration: 10 * time.Minute},
+	}))
+
+	assert.Equal(t, "50 users", DescribeLoadProfile(&locustv2.LoadConfig{
+		Users: ptr.To(int32(50)),
+	}))
+
+	assert.Equal(t, "3 stages, peak 200 users, 13m30s", DescribeLoadProfile(&locustv2.LoadConfig{
+		Stages: newTestLoadStages(),
+	}))
+}
+
+func TestBuildMasterJob_WithConstantLoad(t *testing.T) {
+	lt := newTestLocustTest()
+	lt.Spec.Load = &locustv2.LoadConfig{
+		Users:     ptr.To(int32(100)),
+		SpawnRate: ptr.To(resource.MustParse("10")),
+		RunTime:   &metav1.Duration{Duration: 5 * time.Minute},
+	}
+
+	job := BuildMasterJob(lt, newTestConfig(), logr.Discard())
+
+	args := job.Spec.Template.Spec.Containers[0].Args
+	assert.Contains(t, args, "--users=100")
+	assert.Contains(t, args, "--spawn-rate=10")
+	assert.Contains(t, args, "--run-time=300s")
+	for _, vol := range job.Spec.Template.Spec.Volumes {
+		assert.NotEqual(t, LoadShapeVolumeName, vol.Name, "no load shape without stages")
+	}
+}
+
+func TestBuildMasterJob_ExtraArgsAfterLoadAndResultsArgs(t *testing.T) {
+	lt := newTestLocustTest()
+	lt.Spec.Load = &locustv2.LoadConfig{Users: ptr.To(int32(100))}
+	lt.Spec.Results = &locustv2.ResultsConfig{Formats: []locustv2.ResultsFormat{locustv2.ResultsFormatHTML}}
+	lt.Spec.Master.ExtraArgs = []string{"--host", "https://example.com"}
+
+	args := BuildMasterJob(lt, newTestConfig(), logr.Discard()).Spec.Template.Spec.Containers[0].Args
+
+	assert.Equal(t, []string{"--users=100", "--html=/lotest/results/report.html", "--only-summary",
+		"--host", "https://example.com"}, args[len(args)-5:], "user flags come last and take precedence")
+}
+
+func TestBuildMasterJob_WithLoadStages(t *testing.T) {
+	lt := newTestLocustTest()
+	lt.Spec.Load = &locustv2.LoadConfig{Stages: newTestLoadStages()}
+
+	job := BuildMasterJob(lt, newTestConfig(), logr.Discard())
+	podSpec := job.Spec.Template.Spec
+
+	args := podSpec.Containers[0].Args
+	assert.Equal(t, []string{"locust", "-f", "/lotest/src/test.py,/lotest/shape/locust_shape.py"}, args[:3])
+	assert.NotContains(t, args, "--users")
+
+	var shapeVolume *corev1.Volume
+	for i := range podSpec.Volumes {
+		if podSpec.Volumes[i].Name == LoadShapeVolumeName {
+			shapeVolume = &podSpec.Volumes[i]
+		}
+	}
+	require.NotNil(t, shapeVolume)
+	require.NotNil(t, shapeVolume.ConfigMap)
+	assert.Equal(t, "my-test-load-shape", shapeVolume.ConfigMap.Name)
+
+	assert.Contains(t, podSpec.Containers[0].VolumeMounts, corev1.VolumeMount{
+		Name:      LoadShapeVolumeName,
+		MountPath: LoadShapeMountPath,
+		ReadOnly:  true,
+	})
+}
+
+func TestBuildWorkerJob_WithLoadStages(t *testing.T) {
+	lt := newTestLocustTest()
+	lt.Spec.Load = &locustv2.LoadConfig{Stages: newTestLoadStages()}
+
+	job := BuildWorkerJob(lt, newTestConfig(), logr.Discard())
+	podSpec := job.Spec.Template.Spec
+
+	assert.Equal(t, []string{"locust", "-f", "/lotest/src/test.py"}, podSpec.Containers[0].Args[:3],
+		"the shape only runs on the master")
+	for _, vol := range podSpec.Volumes {
+		assert.NotEqual(t, LoadShapeVolumeName, vol.Name)
+	}
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"time"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/config"
+)
+
+// MaxDuration returns how long the test may run: spec.maxDuration, or the
+// operator default when unset, capped at the operator limit. Zero means
+// unlimited.
+func MaxDuration(lt *locustv2.LocustTest, cfg *config.OperatorConfig) time.Duration {
+	maxDuration := cfg.DefaultMaxDuration
+	if lt.Spec.MaxDuration != nil {
+		maxDuration = lt.Spec.MaxDuration.Duration
+	}
+	if cfg.MaxDurationLimit > 0 && (maxDuration <= 0 || maxDuration > cfg.MaxDurationLimit) {
+		maxDuration = cfg.MaxDurationLimit
+	}
+	return maxDuration
+}
+
+// buildActiveDeadlineSeconds returns the Job deadline enforcing the maximum
+// duration, rounded up to whole seconds. Returns nil for unlimited tests.
+func buildActiveDeadlineSeconds(lt *locustv2.LocustTest, cfg *config.OperatorConfig) *int64 {
+	maxDuration := MaxDuration(lt, cfg)
+	if maxDuration <= 0 {
+		return nil
+	}
+	seconds := int64((maxDuration + time.Second - 1) / time.Second)
+	return &seconds
+}
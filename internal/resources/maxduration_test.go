@@ -0,0 +1,71 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMaxDuration(t *testing.T) {
+	tests := []struct {
+		name            string
+		spec            *metav1.Duration
+		defaultDuration time.Duration
+		limit           time.Duration
+		want            time.Duration
+	}{
+		{name: "Unlimited"},
+		{name: "Spec", spec: &metav1.Duration{Duration: time.Hour}, want: time.Hour},
+		{name: "OperatorDefault", defaultDuration: 2 * time.Hour, want: 2 * time.Hour},
+		{name: "SpecOverridesDefault", spec: &metav1.Duration{Duration: time.Hour}, defaultDuration: 2 * time.Hour, want: time.Hour},
+		{name: "SpecCappedAtLimit", spec: &metav1.Duration{Duration: 8 * time.Hour}, limit: 4 * time.Hour, want: 4 * time.Hour},
+		{name: "UnlimitedCappedAtLimit", limit: 4 * time.Hour, want: 4 * time.Hour},
+		{name: "SpecBelowLimit", spec: &metav1.Duration{Duration: time.Hour}, limit: 4 * time.Hour, want: time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lt := newTestLocustTest()
+			lt.Spec.MaxDuration = tt.spec
+			cfg := newTestConfig()
+			cfg.DefaultMaxDuration = tt.defaultDuration
+			cfg.MaxDurationLimit = tt.limit
+
+			assert.Equal(t, tt.want, MaxDuration(lt, cfg))
+		})
+	}
+}
+
+func TestBuildJob_ActiveDeadlineSeconds(t *testing.T) {
+	lt := newTestLocustTest()
+	cfg := newTestConfig()
+	assert.Nil(t, BuildMasterJob(lt, cfg, logr.Discard()).Spec.ActiveDeadlineSeconds, "no deadline for unlimited tests")
+
+	lt.Spec.MaxDuration = &metav1.Duration{Duration: 90*time.Minute + 500*time.Millisecond}
+	master := BuildMasterJob(lt, cfg, logr.Discard())
+	worker := BuildWorkerJob(lt, cfg, logr.Discard())
+	require.NotNil(t, master.Spec.ActiveDeadlineSeconds)
+	require.NotNil(t, worker.Spec.ActiveDeadlineSeconds)
+	assert.Equal(t, int64(5401), *master.Spec.ActiveDeadlineSeconds, "rounded up to whole seconds")
+	assert.Equal(t, int64(5401), *worker.Spec.ActiveDeadlineSeconds)
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/config"
+)
+
+// MetricsExporterMode returns how the Locust metrics of a test are exported:
+// spec.observability.metricsExporter, or the operator default.
+func MetricsExporterMode(lt *locustv2.LocustTest, cfg *config.OperatorConfig) locustv2.MetricsExporterMode {
+	if lt.Spec.Observability != nil && lt.Spec.Observability.MetricsExporter != "" {
+		return lt.Spec.Observability.MetricsExporter
+	}
+	if cfg.MetricsExporterMode == "" {
+		return locustv2.MetricsExporterSidecar
+	}
+	return locustv2.MetricsExporterMode(cfg.MetricsExporterMode)
+}
+
+// HasMetricsExporterSidecar reports whether the master runs the metrics
+// exporter sidecar. OpenTelemetry replaces it, as does the built-in exporter.
+func HasMetricsExporterSidecar(lt *locustv2.LocustTest, cfg *config.OperatorConfig) bool {
+	return !IsOTelEnabled(lt) && MetricsExporterMode(lt, cfg) == locustv2.MetricsExporterSidecar
+}
+
+// HasBuiltinMetrics reports whether the operator exports the Locust metrics
+// of a test from its own metrics endpoint.
+func HasBuiltinMetrics(lt *locustv2.LocustTest, cfg *config.OperatorConfig) bool {
+	return !IsOTelEnabled(lt) && MetricsExporterMode(lt, cfg) == locustv2.MetricsExporterBuiltin
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/config"
+)
+
+func TestMetricsExporterMode(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     locustv2.MetricsExporterMode
+		operator string
+		want     locustv2.MetricsExporterMode
+	}{
+		{name: "Default", want: locustv2.MetricsExporterSidecar},
+		{name: "Operator", operator: config.MetricsExporterModeBuiltin, want: locustv2.MetricsExporterBuiltin},
+		{name: "SpecOverridesOperator", spec: locustv2.MetricsExporterSidecar, operator: config.MetricsExporterModeBuiltin, want: locustv2.MetricsExporterSidecar},
+		{name: "Spec", spec: locustv2.MetricsExporterBuiltin, want: locustv2.MetricsExporterBuiltin},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lt := newTestLocustTest()
+			if tt.spec != "" {
+				lt.Spec.Observability = &locustv2.ObservabilityConfig{MetricsExporter: tt.spec}
+			}
+			cfg := newTestConfig()
+			cfg.MetricsExporterMode = tt.operator
+
+			assert.Equal(t, tt.want, MetricsExporterMode(lt, cfg))
+		})
+	}
+}
+
+func TestHasBuiltinMetrics_OTelTakesPrecedence(t *testing.T) {
+	lt := newTestLocustTest()
+	lt.Spec.Observability = &locustv2.ObservabilityConfig{
+		MetricsExporter: locustv2.MetricsExporterBuiltin,
+		OpenTelemetry: &locustv2.OpenTelemetryConfig{
+			Enabled:  true,
+			Endpoint: "otel-collector:4317",
+		},
+	}
+	cfg := newTestConfig()
+
+	assert.False(t, HasBuiltinMetrics(lt, cfg))
+	assert.False(t, HasMetricsExporterSidecar(lt, cfg))
+}
+
+func TestBuiltinMetrics_DropsExporterSidecar(t *testing.T) {
+	lt := newTestLocustTest()
+	cfg := newTestConfig()
+	cfg.MetricsExporterMode = config.MetricsExporterModeBuiltin
+
+	assert.True(t, HasBuiltinMetrics(lt, cfg))
+	assert.False(t, HasMetricsExporterSidecar(lt, cfg))
+
+	job := BuildMasterJob(lt, cfg, logr.Discard())
+	assert.NotContains(t, containerNames(job.Spec.Template.Spec.Containers), MetricsExporterContainerName)
+	assert.NotContains(t, containerNames(job.Spec.Template.Spec.InitContainers), MetricsExporterContainerName)
+
+	annotations := BuildAnnotations(lt, Master, cfg)
+	assert.NotContains(t, annotations, AnnotationPrometheusScrape)
+	assert.NotContains(t, annotations, AnnotationPrometheusPort)
+
+	service := BuildMasterService(lt, cfg)
+	for _, port := range service.Spec.Ports {
+		assert.NotEqual(t, MetricsPortName, port.Name)
+	}
+}
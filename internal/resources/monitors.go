@@ -0,0 +1,172 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"maps"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/config"
+)
+
+// ServiceMonitorGVK and PodMonitorGVK are the Prometheus Operator monitor
+// kinds. The monitors are built as unstructured objects so the operator
+// doesn't depend on the Prometheus Operator API.
+var (
+	ServiceMonitorGVK = schema.GroupVersionKind{
+		Group:   "monitoring.coreos.com",
+		Version: "v1",
+		Kind:    "ServiceMonitor",
+	}
+	PodMonitorGVK = schema.GroupVersionKind{
+		Group:   "monitoring.coreos.com",
+		Version: "v1",
+		Kind:    "PodMonitor",
+	}
+)
+
+// HasPrometheusMonitors reports whether spec.observability.prometheus asks for a monitor.
+func HasPrometheusMonitors(lt *locustv2.LocustTest) bool {
+	prometheus := prometheusConfig(lt)
+	return prometheus != nil && (prometheus.ServiceMonitor != nil || prometheus.PodMonitor != nil)
+}
+
+func prometheusConfig(lt *locustv2.LocustTest) *locustv2.PrometheusConfig {
+	if lt.Spec.Observability == nil {
+		return nil
+	}
+	return lt.Spec.Observability.Prometheus
+}
+
+// BuildServiceMonitor creates the ServiceMonitor scraping the metrics port of
+// the master Service. Returns nil unless a ServiceMonitor is configured and
+// the master runs the metrics exporter sidecar.
+func BuildServiceMonitor(lt *locustv2.LocustTest, cfg *config.OperatorConfig) *unstructured.Unstructured {
+	prometheus := prometheusConfig(lt)
+	if prometheus == nil || prometheus.ServiceMonitor == nil || !HasMetricsExporterSidecar(lt, cfg) {
+		return nil
+	}
+
+	spec := map[string]any{
+		"selector": masterSelector(lt),
+		"endpoints": []any{
+			monitorEndpoint(prometheus.ServiceMonitor, MetricsPortName),
+		},
+	}
+	return buildMonitor(lt, ServiceMonitorGVK, prometheus.ServiceMonitor, spec)
+}
+
+// BuildPodMonitor creates the PodMonitor scraping the metrics exporter
+// sidecar of the master pod. Returns nil unless a PodMonitor is configured
+// and the master runs the metrics exporter sidecar.
+func BuildPodMonitor(lt *locustv2.LocustTest, cfg *config.OperatorConfig) *unstructured.Unstructured {
+	prometheus := prometheusConfig(lt)
+	if prometheus == nil || prometheus.PodMonitor == nil || !HasMetricsExporterSidecar(lt, cfg) {
+		return nil
+	}
+
+	spec := map[string]any{
+		"selector": masterSelector(lt),
+		"podMetricsEndpoints": []any{
+			monitorEndpoint(prometheus.PodMonitor, MetricsContainerPortName),
+		},
+	}
+	return buildMonitor(lt, PodMonitorGVK, prometheus.PodMonitor, spec)
+}
+
+// buildMonitor creates a monitor named after the master, labelled with the
+// configured labels.
+func buildMonitor(lt *locustv2.LocustTest, gvk schema.GroupVersionKind, monitor *locustv2.PrometheusMonitorConfig, spec map[string]any) *unstructured.Unstructured {
+	labels := maps.Clone(monitor.Labels)
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[LabelManagedBy] = ManagedByValue
+	labels[LabelTestName] = lt.Name
+
+	obj := &unstructured.Unstructured{Object: map[string]any{"spec": spec}}
+	obj.SetGroupVersionKind(gvk)
+	obj.SetName(NodeName(lt.Name, Master))
+	obj.SetNamespace(lt.Namespace)
+	obj.SetLabels(labels)
+	return obj
+}
+
+// masterSelector selects the master Service and pod of a test.
+func masterSelector(lt *locustv2.LocustTest) map[string]any {
+	return map[string]any{
+		"matchLabels": map[string]any{
+			LabelPodName: NodeName(lt.Name, Master),
+		},
+	}
+}
+
+// monitorEndpoint creates the endpoint scraping the named port.
+func monitorEndpoint(monitor *locustv2.PrometheusMonitorConfig, port string) map[string]any {
+	endpoint := map[string]any{
+		"port": port,
+		"path": MetricsEndpointPath,
+	}
+	if monitor.Interval != "" {
+		endpoint["interval"] = monitor.Interval
+	}
+	if monitor.ScrapeTimeout != "" {
+		endpoint["scrapeTimeout"] = monitor.ScrapeTimeout
+	}
+	if len(monitor.Relabelings) > 0 {
+		relabelings := make([]any, 0, len(monitor.Relabelings))
+		for i := range monitor.Relabelings {
+			relabelings = append(relabelings, relabeling(&monitor.Relabelings[i]))
+		}
+		endpoint["relabelings"] = relabelings
+	}
+	return endpoint
+}
+
+// relabeling converts a relabeling rule to its Prometheus Operator form.
+func relabeling(rule *locustv2.PrometheusRelabelConfig) map[string]any {
+	out := map[string]any{}
+	if len(rule.SourceLabels) > 0 {
+		sourceLabels := make([]any, 0, len(rule.SourceLabels))
+		for _, label := range rule.SourceLabels {
+			sourceLabels = append(sourceLabels, label)
+		}
+		out["sourceLabels"] = sourceLabels
+	}
+	if rule.Separator != nil {
+		out["separator"] = *rule.Separator
+	}
+	if rule.TargetLabel != "" {
+		out["targetLabel"] = rule.TargetLabel
+	}
+	if rule.Regex != "" {
+		out["regex"] = rule.Regex
+	}
+	if rule.Modulus != 0 {
+		out["modulus"] = int64(rule.Modulus)
+	}
+	if rule.Replacement != nil {
+		out["replacement"] = *rule.Replacement
+	}
+	if rule.Action != "" {
+		out["action"] = rule.Action
+	}
+	return out
+}
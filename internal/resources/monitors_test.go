@@ -0,0 +1,152 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/config"
+)
+
+func newTestMonitoredLocustTest(prometheus *locustv2.PrometheusConfig) *locustv2.LocustTest {
+	lt := newTestLocustTest()
+	lt.Spec.Observability = &locustv2.ObservabilityConfig{Prometheus: prometheus}
+	return lt
+}
+
+func TestBuildServiceMonitor(t *testing.T) {
+	lt := newTestMonitoredLocustTest(&locustv2.PrometheusConfig{
+		ServiceMonitor: &locustv2.PrometheusMonitorConfig{
+			Labels:        map[string]string{"release": "prometheus"},
+			Interval:      "15s",
+			ScrapeTimeout: "10s",
+			Relabelings: []locustv2.PrometheusRelabelConfig{{
+				SourceLabels: []string{"__meta_kubernetes_namespace"},
+				TargetLabel:  "test_namespace",
+			}, {
+				SourceLabels: []string{"__address__"},
+				Separator:    ptr.To(";"),
+				TargetLabel:  "shard",
+				Modulus:      4,
+				Action:       "hashmod",
+			}},
+		},
+	})
+	cfg := newTestConfig()
+
+	monitor := BuildServiceMonitor(lt, cfg)
+	require.NotNil(t, monitor)
+	assert.Nil(t, BuildPodMonitor(lt, cfg), "no PodMonitor without prometheus.podMonitor")
+
+	assert.Equal(t, ServiceMonitorGVK, monitor.GroupVersionKind())
+	assert.Equal(t, "my-test-master", monitor.GetName())
+	assert.Equal(t, "default", monitor.GetNamespace())
+	assert.Equal(t, map[string]string{
+		"release":      "prometheus",
+		LabelManagedBy: ManagedByValue,
+		LabelTestName:  "my-test",
+	}, monitor.GetLabels())
+
+	selector, _, err := unstructured.NestedStringMap(monitor.Object, "spec", "selector", "matchLabels")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{LabelPodName: "my-test-master"}, selector)
+	service := BuildMasterService(lt, cfg)
+	for k, v := range selector {
+		assert.Equal(t, v, service.Labels[k], "the selector matches the master Service")
+	}
+
+	endpoints, _, err := unstructured.NestedSlice(monitor.Object, "spec", "endpoints")
+	require.NoError(t, err)
+	assert.Equal(t, []any{map[string]any{
+		"port":          MetricsPortName,
+		"path":          MetricsEndpointPath,
+		"interval":      "15s",
+		"scrapeTimeout": "10s",
+		"relabelings": []any{
+			map[string]any{
+				"sourceLabels": []any{"__meta_kubernetes_namespace"},
+				"targetLabel":  "test_namespace",
+			},
+			map[string]any{
+				"sourceLabels": []any{"__address__"},
+				"separator":    ";",
+				"targetLabel":  "shard",
+				"modulus":      int64(4),
+				"action":       "hashmod",
+			},
+		},
+	}}, endpoints)
+}
+
+func TestBuildPodMonitor(t *testing.T) {
+	lt := newTestMonitoredLocustTest(&locustv2.PrometheusConfig{
+		PodMonitor: &locustv2.PrometheusMonitorConfig{},
+	})
+	cfg := newTestConfig()
+
+	monitor := BuildPodMonitor(lt, cfg)
+	require.NotNil(t, monitor)
+	assert.Nil(t, BuildServiceMonitor(lt, cfg), "no ServiceMonitor without prometheus.serviceMonitor")
+
+	assert.Equal(t, PodMonitorGVK, monitor.GroupVersionKind())
+	assert.Equal(t, "my-test-master", monitor.GetName())
+
+	selector, _, err := unstructured.NestedStringMap(monitor.Object, "spec", "selector", "matchLabels")
+	require.NoError(t, err)
+	job := BuildMasterJob(lt, cfg, logr.Discard())
+	for k, v := range selector {
+		assert.Equal(t, v, job.Spec.Template.Labels[k], "the selector matches the master pod")
+	}
+
+	endpoints, _, err := unstructured.NestedSlice(monitor.Object, "spec", "podMetricsEndpoints")
+	require.NoError(t, err)
+	assert.Equal(t, []any{map[string]any{"port": MetricsContainerPortName, "path": MetricsEndpointPath}}, endpoints)
+
+	exporter := findInitContainer(job.Spec.Template.Spec, MetricsExporterContainerName)
+	require.NotNil(t, exporter)
+	require.Len(t, exporter.Ports, 1)
+	assert.Equal(t, MetricsContainerPortName, exporter.Ports[0].Name, "the endpoint names the exporter's port")
+}
+
+func TestBuildMonitors_WithoutExporterSidecar(t *testing.T) {
+	lt := newTestMonitoredLocustTest(&locustv2.PrometheusConfig{
+		ServiceMonitor: &locustv2.PrometheusMonitorConfig{},
+		PodMonitor:     &locustv2.PrometheusMonitorConfig{},
+	})
+	cfg := newTestConfig()
+	cfg.MetricsExporterMode = config.MetricsExporterModeBuiltin
+
+	assert.True(t, HasPrometheusMonitors(lt))
+	assert.Nil(t, BuildServiceMonitor(lt, cfg))
+	assert.Nil(t, BuildPodMonitor(lt, cfg))
+}
+
+func TestHasPrometheusMonitors(t *testing.T) {
+	assert.False(t, HasPrometheusMonitors(newTestLocustTest()))
+	assert.False(t, HasPrometheusMonitors(newTestMonitoredLocustTest(nil)))
+	assert.False(t, HasPrometheusMonitors(newTestMonitoredLocustTest(&locustv2.PrometheusConfig{})))
+	assert.True(t, HasPrometheusMonitors(newTestMonitoredLocustTest(&locustv2.PrometheusConfig{
+		PodMonitor: &locustv2.PrometheusMonitorConfig{},
+	})))
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+)
+
+// applyPodTemplate applies the podTemplate override of the master or worker
+// spec over the pod template of a Job. The fields the operator relies on, the
+// LabelPodName label, the restart policy and the Locust container's command
+// and args, keep their generated values. An override that can't be applied
+// is logged and left out; the webhook rejects those.
+func applyPodTemplate(job *batchv1.Job, override *runtime.RawExtension, logger logr.Logger) {
+	if override == nil || len(override.Raw) == 0 {
+		return
+	}
+
+	template, err := patchPodTemplate(&job.Spec.Template, override.Raw)
+	if err != nil {
+		logger.Error(err, "Ignoring podTemplate override", "job", job.Name)
+		return
+	}
+
+	template.Labels[LabelPodName] = job.Spec.Template.Labels[LabelPodName]
+	template.Spec.RestartPolicy = job.Spec.Template.Spec.RestartPolicy
+	locust := job.Spec.Template.Spec.Containers[0]
+	for i := range template.Spec.Containers {
+		if template.Spec.Containers[i].Name == locust.Name {
+			template.Spec.Containers[i].Command = locust.Command
+			template.Spec.Containers[i].Args = locust.Args
+		}
+	}
+	job.Spec.Template = *template
+}
+
+// patchPodTemplate applies a strategic merge patch over a pod template and
+// returns the patched copy. Containers, volumes and the other lists Kubernetes
+// merges by key are merged the same way here, e.g. a container patch names
+// the container it changes.
+func patchPodTemplate(template *corev1.PodTemplateSpec, patch []byte) (*corev1.PodTemplateSpec, error) {
+	original, err := json.Marshal(template)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode the pod template: %w", err)
+	}
+	patched, err := strategicpatch.StrategicMergePatch(original, patch, corev1.PodTemplateSpec{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply the podTemplate patch: %w", err)
+	}
+
+	result := &corev1.PodTemplateSpec{}
+	if err := json.Unmarshal(patched, result); err != nil {
+		return nil, fmt.Errorf("failed to decode the patched pod template: %w", err)
+	}
+	if result.Labels == nil {
+		result.Labels = map[string]string{}
+	}
+	return result, nil
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+func rawPodTemplate(patch string) *runtime.RawExtension {
+	return &runtime.RawExtension{Raw: []byte(patch)}
+}
+
+func TestBuildMasterJob_PodTemplate(t *testing.T) {
+	lt := newTestLocustTest()
+	lt.Spec.Master.PodTemplate = rawPodTemplate(`{
+		"metadata": {"labels": {"team": "perf"}},
+		"spec": {
+			"priorityClassName": "load-testing",
+			"serviceAccountName": "locust",
+			"terminationGracePeriodSeconds": 5,
+			"shareProcessNamespace": true,
+			"hostAliases": [{"ip": "10.0.0.1", "hostnames": ["api.internal"]}],
+			"topologySpreadConstraints": [{"maxSkew": 1, "topologyKey": "kubernetes.io/hostname", "whenUnsatisfiable": "ScheduleAnyway"}],
+			"containers": [{"name": "my-test-master", "env": [{"name": "EXTRA", "value": "1"}]}]
+		}
+	}`)
+
+	generated := BuildMasterJob(newTestLocustTest(), newTestConfig(), logr.Discard())
+	job := BuildMasterJob(lt, newTestConfig(), logr.Discard())
+	spec := job.Spec.Template.Spec
+
+	assert.Equal(t, "load-testing", spec.PriorityClassName)
+	assert.Equal(t, "locust", spec.ServiceAccountName)
+	assert.Equal(t, ptr.To(int64(5)), spec.TerminationGracePeriodSeconds)
+	assert.Equal(t, ptr.To(true), spec.ShareProcessNamespace)
+	assert.Equal(t, []corev1.HostAlias{{IP: "10.0.0.1", Hostnames: []string{"api.internal"}}}, spec.HostAliases)
+	require.Len(t, spec.TopologySpreadConstraints, 1)
+	assert.Equal(t, "kubernetes.io/hostname", spec.TopologySpreadConstraints[0].TopologyKey)
+
+	// Labels and containers are merged, not replaced
+	assert.Equal(t, "perf", job.Spec.Template.Labels["team"])
+	assert.Equal(t, generated.Spec.Template.Labels[LabelPodName], job.Spec.Template.Labels[LabelPodName])
+	require.Len(t, spec.Containers, 1)
+	assert.Equal(t, generated.Spec.Template.Spec.Containers[0].Args, spec.Containers[0].Args)
+	assert.Equal(t, generated.Spec.Template.Spec.Containers[0].Image, spec.Containers[0].Image)
+	assert.Contains(t, spec.Containers[0].Env, corev1.EnvVar{Name: "EXTRA", Value: "1"})
+	assert.Len(t, spec.Containers[0].Env, len(generated.Spec.Template.Spec.Containers[0].Env)+1)
+	assert.True(t, equality.Semantic.DeepEqual(generated.Spec.Template.Spec.InitContainers, spec.InitContainers))
+}
+
+func TestBuildJob_PodTemplateKeepsProtectedFields(t *testing.T) {
+	lt := newTestLocustTest()
+	lt.Spec.Worker.PodTemplate = rawPodTemplate(`{
+		"metadata": {"labels": {"performance-test-pod-name": "other"}},
+		"spec": {
+			"restartPolicy": "Always",
+			"containers": [{"name": "my-test-worker", "command": ["sh"], "args": ["-c", "sleep 1"]}]
+		}
+	}`)
+
+	generated := BuildWorkerJob(newTestLocustTest(), newTestConfig(), logr.Discard())
+	job := BuildWorkerJob(lt, newTestConfig(), logr.Discard())
+
+	assert.Equal(t, generated.Spec.Template.Labels, job.Spec.Template.Labels)
+	assert.Equal(t, corev1.RestartPolicyNever, job.Spec.Template.Spec.RestartPolicy)
+	assert.Nil(t, job.Spec.Template.Spec.Containers[0].Command)
+	assert.Equal(t, generated.Spec.Template.Spec.Containers[0].Args, job.Spec.Template.Spec.Containers[0].Args)
+}
+
+func TestBuildJob_InvalidPodTemplateIgnored(t *testing.T) {
+	lt := newTestLocustTest()
+	lt.Spec.Master.PodTemplate = rawPodTemplate(`{"spec": "not a pod spec"}`)
+
+	generated := BuildMasterJob(newTestLocustTest(), newTestConfig(), logr.Discard())
+	job := BuildMasterJob(lt, newTestConfig(), logr.Discard())
+
+	assert.Equal(t, generated.Spec.Template, job.Spec.Template)
+}
+
+func TestBuildWorkerGroupJob_PodTemplate(t *testing.T) {
+	lt := newTestWorkerGroupsLocustTest()
+	lt.Spec.Worker.PodTemplate = rawPodTemplate(`{
+		"metadata": {"labels": {"performance-test-worker-group": "other"}},
+		"spec": {"priorityClassName": "load-testing"}
+	}`)
+
+	job := BuildWorkerGroupJob(lt, &lt.Spec.WorkerGroups[1], newTestConfig(), logr.Discard())
+
+	assert.Equal(t, "load-testing", job.Spec.Template.Spec.PriorityClassName)
+	assert.Equal(t, "checkout", job.Spec.Template.Labels[LabelWorkerGroup])
+	assert.Equal(t, map[string]string{"pool": "large"}, job.Spec.Template.Spec.NodeSelector)
+}
+
+func TestBuildJob_NoPodTemplate(t *testing.T) {
+	lt := newTestLocustTest()
+	lt.Spec.Master.PodTemplate = &runtime.RawExtension{}
+
+	assert.Equal(t,
+		BuildMasterJob(newTestLocustTest(), newTestConfig(), logr.Discard()).Spec.Template,
+		BuildMasterJob(lt, newTestConfig(), logr.Discard()).Spec.Template)
+	assert.Empty(t, BuildWorkerJob(lt, newTestConfig(), logr.Discard()).Spec.Template.Spec.PriorityClassName)
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/config"
+)
+
+// RequestedResources returns the CPU and memory requested by all pods of a
+// test: the master and the workers of every group, sidecars included. It is
+// what the test takes from its queue's capacity.
+func RequestedResources(lt *locustv2.LocustTest, cfg *config.OperatorConfig) corev1.ResourceList {
+	total := corev1.ResourceList{}
+	jobs := []*batchv1.Job{
+		BuildMasterJob(lt, cfg, logr.Discard()),
+		BuildWorkerJob(lt, cfg, logr.Discard()),
+	}
+	jobs = append(jobs, BuildWorkerGroupJobs(lt, cfg, logr.Discard())...)
+	for _, job := range jobs {
+		pods := int64(ptr.Deref(job.Spec.Parallelism, 1))
+		for name, quantity := range podRequests(&job.Spec.Template.Spec) {
+			quantity.Mul(pods)
+			addQuantity(total, name, quantity)
+		}
+	}
+	return total
+}
+
+// podRequests returns the CPU and memory a pod requests the way the
+// scheduler counts them: the containers and native sidecars together, or
+// the largest regular init container if it requests more.
+func podRequests(spec *corev1.PodSpec) corev1.ResourceList {
+	running := corev1.ResourceList{}
+	for i := range spec.Containers {
+		addRequests(running, &spec.Containers[i])
+	}
+	for i := range spec.InitContainers {
+		if isSidecar(&spec.InitContainers[i]) {
+			addRequests(running, &spec.InitContainers[i])
+		}
+	}
+
+	for i := range spec.InitContainers {
+		if isSidecar(&spec.InitContainers[i]) {
+			continue
+		}
+		requests := corev1.ResourceList{}
+		addRequests(requests, &spec.InitContainers[i])
+		for name, quantity := range requests {
+			if current, ok := running[name]; !ok || quantity.Cmp(current) > 0 {
+				running[name] = quantity
+			}
+		}
+	}
+	return running
+}
+
+// addRequests adds the CPU and memory requests of a container to total.
+// A resource without a request counts its limit, as Kubernetes defaults
+// requests to limits.
+func addRequests(total corev1.ResourceList, container *corev1.Container) {
+	for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+		if quantity, ok := container.Resources.Requests[name]; ok {
+			addQuantity(total, name, quantity)
+		} else if quantity, ok := container.Resources.Limits[name]; ok {
+			addQuantity(total, name, quantity)
+		}
+	}
+}
+
+func addQuantity(total corev1.ResourceList, name corev1.ResourceName, quantity resource.Quantity) {
+	current := total[name]
+	current.Add(quantity)
+	total[name] = current
+}
+
+func isSidecar(container *corev1.Container) bool {
+	return ptr.Deref(container.RestartPolicy, "") == corev1.ContainerRestartPolicyAlways
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+)
+
+func TestRequestedResources(t *testing.T) {
+	lt := newTestLocustTest()
+	cfg := newTestConfig()
+
+	// Master: Locust 250m/128Mi + metrics exporter 250m/128Mi.
+	// Workers: 3 x 250m/128Mi.
+	requested := RequestedResources(lt, cfg)
+	assert.True(t, resource.MustParse("1250m").Equal(requested[corev1.ResourceCPU]),
+		"got %s", requested.Cpu())
+	assert.True(t, resource.MustParse("640Mi").Equal(requested[corev1.ResourceMemory]),
+		"got %s", requested.Memory())
+	assert.NotContains(t, requested, corev1.ResourceEphemeralStorage)
+}
+
+func TestRequestedResources_ScalesWithWorkers(t *testing.T) {
+	lt := newTestLocustTest()
+	lt.Spec.Worker.Replicas = 10
+
+	requested := RequestedResources(lt, newTestConfig())
+	assert.True(t, resource.MustParse("3").Equal(requested[corev1.ResourceCPU]),
+		"got %s", requested.Cpu())
+}
+
+func TestRequestedResources_CountsWorkerGroups(t *testing.T) {
+	lt := newTestLocustTest()
+	lt.Spec.WorkerGroups = []locustv2.WorkerGroup{{
+		Name:     "checkout",
+		Replicas: 2,
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+		},
+	}}
+
+	// 1250m for the master and spec.worker, 2 x 1 CPU for the group
+	requested := RequestedResources(lt, newTestConfig())
+	assert.True(t, resource.MustParse("3250m").Equal(requested[corev1.ResourceCPU]),
+		"got %s", requested.Cpu())
+}
+
+func TestPodRequests(t *testing.T) {
+	container := func(cpu string) corev1.Container {
+		return corev1.Container{Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(cpu)},
+		}}
+	}
+	sidecar := container("100m")
+	sidecar.RestartPolicy = ptr.To(corev1.ContainerRestartPolicyAlways)
+	limitOnly := corev1.Container{Resources: corev1.ResourceRequirements{
+		Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("64Mi")},
+	}}
+
+	tests := []struct {
+		name       string
+		spec       corev1.PodSpec
+		wantCPU    string
+		wantMemory string
+	}{
+		{
+			name:    "Containers",
+			spec:    corev1.PodSpec{Containers: []corev1.Container{container("250m"), container("250m")}},
+			wantCPU: "500m",
+		},
+		{
+			name: "NativeSidecarAdds",
+			spec: corev1.PodSpec{
+				InitContainers: []corev1.Container{sidecar},
+				Containers:     []corev1.Container{container("250m")},
+			},
+			wantCPU: "350m",
+		},
+		{
+			name: "LargerInitContainer",
+			spec: corev1.PodSpec{
+				InitContainers: []corev1.Container{container("1")},
+				Containers:     []corev1.Container{container("250m")},
+			},
+			wantCPU: "1",
+		},
+		{
+			name: "SmallerInitContainer",
+			spec: corev1.PodSpec{
+				InitContainers: []corev1.Container{container("100m")},
+				Containers:     []corev1.Container{container("250m")},
+			},
+			wantCPU: "250m",
+		},
+		{
+			name:       "LimitWithoutRequest",
+			spec:       corev1.PodSpec{Containers: []corev1.Container{container("250m"), limitOnly}},
+			wantCPU:    "250m",
+			wantMemory: "64Mi",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := podRequests(&tt.spec)
+			assert.True(t, resource.MustParse(tt.wantCPU).Equal(requests[corev1.ResourceCPU]),
+				"got %s", requests.Cpu())
+			if tt.wantMemory != "" {
+				assert.True(t, resource.MustParse(tt.wantMemory).Equal(requests[corev1.ResourceMemory]),
+					"got %s", requests.Memory())
+			}
+		})
+	}
+}
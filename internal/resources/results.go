@@ -0,0 +1,297 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"net"
+	"slices"
+	"strconv"
+	"time"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// Result file names written by the master.
+const (
+	// ResultsCSVPrefix is the --csv and --json-file prefix; Locust appends "_stats.csv", ".json" etc.
+	ResultsCSVPrefix = "locust"
+	// ResultsStatsFileName is the CSV file of the final request statistics.
+	ResultsStatsFileName = ResultsCSVPrefix + "_stats.csv"
+	// ResultsHTMLFileName is the file name of the HTML report.
+	ResultsHTMLFileName = "report.html"
+	// ResultsSummaryFileName is the ConfigMap key holding the master's summary output.
+	ResultsSummaryFileName = "summary.txt"
+)
+
+// ResultsCollectorWait is how long the collector keeps serving the result
+// files after Locust exits, waiting for the operator to fetch them.
+const ResultsCollectorWait = 60 * time.Second
+
+// defaultResultsClaimSize is the size of the results claim when none is set.
+const defaultResultsClaimSize = "1Gi"
+
+// resultsCollectorScript serves the results directory over HTTP. When the pod
+// stops it keeps serving until the operator posts /done, or until the wait
+// runs out, so the files outlive the Locust container long enough to be
+// collected.
+const resultsCollectorScript = `import http.server, signal, sys, threading
+
+root, port, wait = sys.argv[1], int(sys.argv[2]), float(sys.argv[3])
+done = threading.Event()
+
+
+class Handler(http.server.SimpleHTTPRequestHandler):
+    def __init__(self, *args, **kwargs):
+        super().__init__(*args, directory=root, **kwargs)
+
+    def do_POST(self):
+        if self.path != "/done":
+            self.send_error(404)
+            return
+        done.set()
+        self.send_response(204)
+        self.end_headers()
+
+    def log_message(self, *args):
+        pass
+
+
+server = http.server.ThreadingHTTPServer(("", port), Handler)
+
+
+def stop(signum, frame):
+    def shutdown():
+        done.wait(wait)
+        server.shutdown()
+    threading.Thread(target=shutdown, daemon=True).start()
+
+
+signal.signal(signal.SIGTERM, stop)
+server.serve_forever()
+`
+
+// HasResults reports whether the test collects its results.
+func HasResults(lt *locustv2.LocustTest) bool {
+	return lt.Spec.Results != nil
+}
+
+// HasResultsClaim reports whether the results are written to a PersistentVolumeClaim.
+func HasResultsClaim(lt *locustv2.LocustTest) bool {
+	return HasResults(lt) && lt.Spec.Results.Storage == locustv2.ResultsStoragePersistentVolumeClaim
+}
+
+// HasResultsCollector reports whether the master runs the results collector,
+// which serves the result files until the operator copied them to the
+// ConfigMap or uploaded them to S3, or read the final statistics to judge the
+// thresholds.
+func HasResultsCollector(lt *locustv2.LocustTest) bool {
+	return HasResults(lt) && (!HasResultsClaim(lt) || lt.Spec.Results.S3 != nil || JudgesFinalStats(lt))
+}
+
+// JudgesFinalStats reports whether the thresholds are judged against the
+// final statistics, which the master writes when collecting CSV results.
+func JudgesFinalStats(lt *locustv2.LocustTest) bool {
+	return lt.Spec.Thresholds != nil && slices.Contains(ResultFileNames(lt), ResultsStatsFileName)
+}
+
+// ResultsName returns the name of the ConfigMap and PersistentVolumeClaim
+// holding the results, e.g. "team-a.load-test" -> "team-a-load-test-results".
+func ResultsName(crName string) string {
+	return locustv2.SanitizeResourceName(crName) + "-results"
+}
+
+// resultsFormats returns the configured formats, defaulting to CSV and HTML.
+func resultsFormats(lt *locustv2.LocustTest) []locustv2.ResultsFormat {
+	if len(lt.Spec.Results.Formats) == 0 {
+		return []locustv2.ResultsFormat{locustv2.ResultsFormatCSV, locustv2.ResultsFormatHTML}
+	}
+	return lt.Spec.Results.Formats
+}
+
+// BuildResultsArgs returns the master flags writing the result files.
+// Returns nil when results aren't collected.
+func BuildResultsArgs(lt *locustv2.LocustTest) []string {
+	if !HasResults(lt) {
+		return nil
+	}
+
+	var args []string
+	for _, format := range resultsFormats(lt) {
+		switch format {
+		case locustv2.ResultsFormatCSV:
+			args = append(args, "--csv="+ResultsMountPath+"/"+ResultsCSVPrefix)
+		case locustv2.ResultsFormatHTML:
+			args = append(args, "--html="+ResultsMountPath+"/"+ResultsHTMLFileName)
+		case locustv2.ResultsFormatJSON:
+			args = append(args, "--json-file="+ResultsMountPath+"/"+ResultsCSVPrefix)
+		}
+	}
+	return append(args, "--only-summary")
+}
+
+// ResultFileNames returns the names of the result files the master writes.
+func ResultFileNames(lt *locustv2.LocustTest) []string {
+	if !HasResults(lt) {
+		return nil
+	}
+
+	var names []string
+	for _, format := range resultsFormats(lt) {
+		switch format {
+		case locustv2.ResultsFormatCSV:
+			names = append(names, ResultsStatsFileName)
+			for _, suffix := range []string{"failures", "exceptions", "stats_history"} {
+				names = append(names, ResultsCSVPrefix+"_"+suffix+".csv")
+			}
+		case locustv2.ResultsFormatHTML:
+			names = append(names, ResultsHTMLFileName)
+		case locustv2.ResultsFormatJSON:
+			names = append(names, ResultsCSVPrefix+".json")
+		}
+	}
+	return names
+}
+
+// ResultsCollectorURL returns the base URL of the results collector in the master pod.
+func ResultsCollectorURL(podIP string) string {
+	return "http://" + net.JoinHostPort(podIP, strconv.Itoa(ResultsCollectorPort))
+}
+
+// BuildResultsConfigMap creates the ConfigMap holding the collected results.
+func BuildResultsConfigMap(lt *locustv2.LocustTest, data map[string]string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ResultsName(lt.Name),
+			Namespace: lt.Namespace,
+			Labels: map[string]string{
+				LabelManagedBy: ManagedByValue,
+				LabelTestName:  lt.Name,
+			},
+		},
+		// Results describe a finished run and never change
+		Immutable: ptr.To(true),
+		Data:      data,
+	}
+}
+
+// BuildResultsVolumeClaim creates the PersistentVolumeClaim the master writes
+// its results to. Returns nil unless PersistentVolumeClaim storage is used.
+func BuildResultsVolumeClaim(lt *locustv2.LocustTest) *corev1.PersistentVolumeClaim {
+	if !HasResultsClaim(lt) {
+		return nil
+	}
+
+	size := resource.MustParse(defaultResultsClaimSize)
+	var storageClassName *string
+	if claim := lt.Spec.Results.PersistentVolumeClaim; claim != nil {
+		if !claim.Size.IsZero() {
+			size = claim.Size
+		}
+		storageClassName = claim.StorageClassName
+	}
+
+	return &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ResultsName(lt.Name),
+			Namespace: lt.Namespace,
+			Labels: map[string]string{
+				LabelManagedBy: ManagedByValue,
+				LabelTestName:  lt.Name,
+			},
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			StorageClassName: storageClassName,
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: size},
+			},
+		},
+	}
+}
+
+// buildResultsVolume creates the volume the master writes its results to:
+// the results claim, or an emptyDir served by the results collector.
+func buildResultsVolume(lt *locustv2.LocustTest) corev1.Volume {
+	if HasResultsClaim(lt) {
+		return corev1.Volume{
+			Name: ResultsVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: ResultsName(lt.Name),
+				},
+			},
+		}
+	}
+
+	return corev1.Volume{
+		Name:         ResultsVolumeName,
+		VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+	}
+}
+
+// buildResultsCollectorSidecar creates a native sidecar serving the result
+// files on ResultsCollectorPort. It runs the test's Locust image, which
+// already ships Python, so no extra image has to be pulled.
+func buildResultsCollectorSidecar(lt *locustv2.LocustTest) corev1.Container {
+	pullPolicy := lt.Spec.ImagePullPolicy
+	if pullPolicy == "" {
+		pullPolicy = corev1.PullIfNotPresent
+	}
+
+	return corev1.Container{
+		Name:            ResultsCollectorContainerName,
+		Image:           lt.Spec.Image,
+		ImagePullPolicy: pullPolicy,
+		RestartPolicy:   ptr.To(corev1.ContainerRestartPolicyAlways),
+		Command: []string{
+			"python3", "-c", resultsCollectorScript,
+			ResultsMountPath,
+			strconv.Itoa(ResultsCollectorPort),
+			strconv.FormatInt(int64(ResultsCollectorWait.Seconds()), 10),
+		},
+		Ports: []corev1.ContainerPort{
+			{ContainerPort: ResultsCollectorPort},
+		},
+		Resources: corev1.ResourceRequirements{
+			Requests: buildResourceList("10m", "32Mi", ""),
+			Limits:   buildResourceList("100m", "64Mi", ""),
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: ResultsVolumeName, MountPath: ResultsMountPath, ReadOnly: true},
+		},
+		SecurityContext: &corev1.SecurityContext{
+			AllowPrivilegeEscalation: ptr.To(false),
+			Capabilities: &corev1.Capabilities{
+				Drop: []corev1.Capability{"ALL"}, //nolint:goconst // idiomatic K8s security context literal
+			},
+			ReadOnlyRootFilesystem: ptr.To(true),
+		},
+	}
+}
+
+// buildTerminationGracePeriod gives the results collector time to serve the
+// result files after Locust exits. Returns nil to keep the default otherwise.
+func buildTerminationGracePeriod(lt *locustv2.LocustTest, mode OperationalMode) *int64 {
+	if mode != Master || !HasResultsCollector(lt) {
+		return nil
+	}
+	return ptr.To(int64((ResultsCollectorWait + 30*time.Second).Seconds()))
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+)
+
+func TestBuildResultsArgs(t *testing.T) {
+	lt := newTestLocustTest()
+	assert.Nil(t, BuildResultsArgs(lt))
+
+	lt.Spec.Results = &locustv2.ResultsConfig{}
+	assert.Equal(t, []string{
+		"--csv=/lotest/results/locust",
+		"--html=/lotest/results/report.html",
+		"--only-summary",
+	}, BuildResultsArgs(lt), "CSV and HTML by default")
+
+	lt.Spec.Results.Formats = []locustv2.ResultsFormat{locustv2.ResultsFormatHTML}
+	assert.Equal(t, []string{"--html=/lotest/results/report.html", "--only-summary"}, BuildResultsArgs(lt))
+
+	lt.Spec.Results.Formats = []locustv2.ResultsFormat{locustv2.ResultsFormatJSON}
+	assert.Equal(t, []string{"--json-file=/lotest/results/locust", "--only-summary"}, BuildResultsArgs(lt))
+}
+
+func TestResultFileNames(t *testing.T) {
+	lt := newTestLocustTest()
+	assert.Nil(t, ResultFileNames(lt))
+
+	lt.Spec.Results = &locustv2.ResultsConfig{Formats: []locustv2.ResultsFormat{locustv2.ResultsFormatCSV}}
+	assert.Equal(t, []string{
+		"locust_stats.csv", "locust_failures.csv", "locust_exceptions.csv", "locust_stats_history.csv",
+	}, ResultFileNames(lt))
+
+	lt.Spec.Results.Formats = []locustv2.ResultsFormat{locustv2.ResultsFormatHTML, locustv2.ResultsFormatJSON}
+	assert.Equal(t, []string{"report.html", "locust.json"}, ResultFileNames(lt))
+}
+
+func TestResultsCollectorURL(t *testing.T) {
+	assert.Equal(t, "http://10.0.0.7:8090", ResultsCollectorURL("10.0.0.7"))
+	assert.Equal(t, "http://[fd00::7]:8090", ResultsCollectorURL("fd00::7"))
+}
+
+func TestBuildResultsConfigMap(t *testing.T) {
+	lt := newTestLocustTest()
+	lt.Name = "team-a.load-test"
+
+	cm := BuildResultsConfigMap(lt, map[string]string{ResultsSummaryFileName: "summary"})
+
+	assert.Equal(t, "team-a-load-test-results", cm.Name)
+	assert.Equal(t, "default", cm.Namespace)
+	assert.Equal(t, "team-a.load-test", cm.Labels[LabelTestName])
+	require.NotNil(t, cm.Immutable)
+	assert.True(t, *cm.Immutable)
+	assert.Equal(t, "summary", cm.Data[ResultsSummaryFileName])
+}
+
+func TestBuildResultsVolumeClaim(t *testing.T) {
+	lt := newTestLocustTest()
+	assert.Nil(t, BuildResultsVolumeClaim(lt))
+
+	lt.Spec.Results = &locustv2.ResultsConfig{Storage: locustv2.ResultsStorageConfigMap}
+	assert.Nil(t, BuildResultsVolumeClaim(lt), "no claim with ConfigMap storage")
+
+	lt.Spec.Results.Storage = locustv2.ResultsStoragePersistentVolumeClaim
+	claim := BuildResultsVolumeClaim(lt)
+	require.NotNil(t, claim)
+	assert.Equal(t, "my-test-results", claim.Name)
+	assert.Equal(t, resource.MustParse("1Gi"), claim.Spec.Resources.Requests[corev1.ResourceStorage])
+	assert.Nil(t, claim.Spec.StorageClassName)
+
+	lt.Spec.Results.PersistentVolumeClaim = &locustv2.ResultsVolumeClaim{
+		Size:             resource.MustParse("20Gi"),
+		StorageClassName: ptr.To("fast"),
+	}
+	claim = BuildResultsVolumeClaim(lt)
+	assert.Equal(t, resource.MustParse("20Gi"), claim.Spec.Resources.Requests[corev1.ResourceStorage])
+	assert.Equal(t, ptr.To("fast"), claim.Spec.StorageClassName)
+}
+
+func TestBuildMasterJob_WithResults(t *testing.T) {
+	lt := newTestLocustTest()
+	lt.Spec.Results = &locustv2.ResultsConfig{}
+
+	job := BuildMasterJob(lt, newTestConfig(), logr.Discard())
+	podSpec := job.Spec.Template.Spec
+
+	assert.Contains(t, podSpec.Containers[0].Args, "--only-summary")
+	assert.Contains(t, podSpec.Containers[0].VolumeMounts, corev1.VolumeMount{
+		Name:      ResultsVolumeName,
+		MountPath: ResultsMountPath,
+	})
+	assert.Contains(t, podSpec.Volumes, corev1.Volume{
+		Name:         ResultsVolumeName,
+		VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+	})
+
+	var collector *corev1.Container
+	for i := range podSpec.InitContainers {
+		if podSpec.InitContainers[i].Name == ResultsCollectorContainerName {
+			collector = &podSpec.InitContainers[i]
+		}
+	}
+	require.NotNil(t, collector)
+	assert.True(t, IsNativeSidecar(*collector), "the collector must outlive the init phase")
+	assert.Equal(t, lt.Spec.Image, collector.Image)
+	assert.Equal(t, []string{"python3", "-c"}, collector.Command[:2])
+	assert.Equal(t, []string{ResultsMountPath, "8090", "60"}, collector.Command[3:])
+	require.NotNil(t, podSpec.TerminationGracePeriodSeconds)
+	assert.Equal(t, int64(90), *podSpec.TerminationGracePeriodSeconds)
+}
+
+func TestBuildMasterJob_WithResultsClaim(t *testing.T) {
+	lt := newTestLocustTest()
+	lt.Spec.Results = &locustv2.ResultsConfig{Storage: locustv2.ResultsStoragePersistentVolumeClaim}
+
+	job := BuildMasterJob(lt, newTestConfig(), logr.Discard())
+	podSpec := job.Spec.Template.Spec
+
+	assert.Contains(t, podSpec.Volumes, corev1.Volume{
+		Name: ResultsVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "my-test-results"},
+		},
+	})
+	for _, c := range podSpec.InitContainers {
+		assert.NotEqual(t, ResultsCollectorContainerName, c.Name, "no collector with claim storage")
+	}
+	assert.Nil(t, podSpec.TerminationGracePeriodSeconds)
+}
+
+func TestBuildMasterJob_WithResultsClaimAndS3(t *testing.T) {
+	lt := newTestLocustTest()
+	lt.Spec.Results = &locustv2.ResultsConfig{
+		Storage: locustv2.ResultsStoragePersistentVolumeClaim,
+		S3:      &locustv2.ResultsS3Config{Endpoint: "http://minio:9000", Bucket: "results"},
+	}
+
+	job := BuildMasterJob(lt, newTestConfig(), logr.Discard())
+	podSpec := job.Spec.Template.Spec
+
+	// The collector serves the files on the claim so they can be uploaded
+	var names []string
+	for _, c := range podSpec.InitContainers {
+		names = append(names, c.Name)
+	}
+	assert.Contains(t, names, ResultsCollectorContainerName)
+	assert.Equal(t, ptr.To(int64(90)), podSpec.TerminationGracePeriodSeconds)
+}
+
+func TestBuildMasterJob_WithResultsClaimAndThresholds(t *testing.T) {
+	lt := newTestLocustTest()
+	lt.Spec.Results = &locustv2.ResultsConfig{Storage: locustv2.ResultsStoragePersistentVolumeClaim}
+	lt.Spec.Thresholds = &locustv2.ThresholdsConfig{
+		ThresholdLimits: locustv2.ThresholdLimits{MaxFailureRatio: ptr.To(resource.MustParse("0.01"))},
+	}
+	require.True(t, JudgesFinalStats(lt))
+
+	podSpec := BuildMasterJob(lt, newTestConfig(), logr.Discard()).Spec.Template.Spec
+
+	// The collector serves the final statistics on the claim for the thresholds
+	var names []string
+	for _, c := range podSpec.InitContainers {
+		names = append(names, c.Name)
+	}
+	assert.Contains(t, names, ResultsCollectorContainerName)
+
+	lt.Spec.Results.Formats = []locustv2.ResultsFormat{locustv2.ResultsFormatHTML}
+	assert.False(t, JudgesFinalStats(lt), "no final statistics without the CSV format")
+	assert.False(t, HasResultsCollector(lt))
+}
+
+func TestBuildWorkerJob_WithResults(t *testing.T) {
+	lt := newTestLocustTest()
+	lt.Spec.Results = &locustv2.ResultsConfig{}
+
+	job := BuildWorkerJob(lt, newTestConfig(), logr.Discard())
+	podSpec := job.Spec.Template.Spec
+
+	assert.NotContains(t, podSpec.Containers[0].Args, "--only-summary")
+	for _, vol := range podSpec.Volumes {
+		assert.NotEqual(t, ResultsVolumeName, vol.Name, "results are written by the master only")
+	}
+	assert.Empty(t, podSpec.InitContainers)
+	assert.Nil(t, podSpec.TerminationGracePeriodSeconds)
+}
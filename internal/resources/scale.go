@@ -0,0 +1,58 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+)
+
+// SpecHash returns a hash of the spec fields a test can't change once its
+// Jobs exist. It leaves out spec.worker.replicas, which scales the running
+// test, and spec.suspend and spec.startAt, which start a waiting test.
+func SpecHash(lt *locustv2.LocustTest) string {
+	spec := lt.Spec.DeepCopy()
+	spec.Worker.Replicas = 0
+	spec.Suspend = false
+	spec.StartAt = nil
+
+	// Marshaling a spec can't fail: it only holds plain fields and maps
+	data, _ := json.Marshal(spec)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:8])
+}
+
+// WorkerSelector returns the label selector of the pods of spec.worker,
+// leaving out the pods of worker groups, which share their pod name label.
+func WorkerSelector(lt *locustv2.LocustTest) string {
+	selector, err := metav1.LabelSelectorAsSelector(&metav1.LabelSelector{
+		MatchLabels: map[string]string{LabelPodName: NodeName(lt.Name, Worker)},
+		MatchExpressions: []metav1.LabelSelectorRequirement{
+			{Key: LabelWorkerGroup, Operator: metav1.LabelSelectorOpDoesNotExist},
+		},
+	})
+	if err != nil {
+		// The pod name label is a valid label value: the webhook limits its length
+		return ""
+	}
+	return selector.String()
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+)
+
+func TestSpecHash_IgnoresMutableFields(t *testing.T) {
+	lt := newTestLocustTest()
+	hash := SpecHash(lt)
+	assert.Len(t, hash, 16)
+
+	lt.Spec.Worker.Replicas = 10
+	lt.Spec.Suspend = true
+	lt.Spec.StartAt = &metav1.Time{Time: time.Now()}
+	assert.Equal(t, hash, SpecHash(lt))
+}
+
+func TestSpecHash_ChangesWithImmutableFields(t *testing.T) {
+	tests := map[string]func(lt *locustv2.LocustTest){
+		"Image":         func(lt *locustv2.LocustTest) { lt.Spec.Image = "locustio/locust:2.43.3" },
+		"WorkerCommand": func(lt *locustv2.LocustTest) { lt.Spec.Worker.Command = "locust -f other.py" },
+		"WorkerGroupReplicas": func(lt *locustv2.LocustTest) {
+			lt.Spec.WorkerGroups = []locustv2.WorkerGroup{{Name: "checkout", Replicas: 1}}
+		},
+	}
+	for name, mutate := range tests {
+		t.Run(name, func(t *testing.T) {
+			lt := newTestLocustTest()
+			hash := SpecHash(lt)
+			mutate(lt)
+			assert.NotEqual(t, hash, SpecHash(lt))
+		})
+	}
+}
+
+func TestBuildMasterJob_RecordsSpecHash(t *testing.T) {
+	lt := newTestLocustTest()
+	job := BuildMasterJob(lt, newTestConfig(), logr.Discard())
+
+	assert.Equal(t, SpecHash(lt), job.Annotations[AnnotationSpecHash])
+}
+
+func TestWorkerSelector(t *testing.T) {
+	lt := newTestLocustTest()
+	lt.Name = "team-a.load-test"
+
+	assert.Equal(t,
+		"performance-test-pod-name=team-a-load-test-worker,!performance-test-worker-group",
+		WorkerSelector(lt))
+}
@@ -26,21 +26,17 @@ import (
 )
 
 // BuildMasterService creates a Kubernetes Service for the Locust master node.
-// The service exposes ports 5557 (master), 5558 (bind), and the metrics port.
-// Port 8089 (web UI) is NOT exposed via the service.
+// The service exposes ports 5557 (master), 5558 (bind), 8089 (web UI), and the metrics port.
+// The web UI port is used by the operator to read test statistics; the
+// Service is ClusterIP, so it is not reachable from outside the cluster.
+// It carries the master's pod name label, which ServiceMonitors select it by.
 func BuildMasterService(lt *locustv2.LocustTest, cfg *config.OperatorConfig) *corev1.Service {
 	nodeName := NodeName(lt.Name, Master)
 
-	// Build service ports - exclude WebUIPort (8089)
-	// Pre-allocate: 2 master ports (excluding WebUI) + 1 metrics port = 3
-	servicePorts := make([]corev1.ServicePort, 0, 3)
+	// Pre-allocate: 3 master ports + 1 metrics port = 4
+	servicePorts := make([]corev1.ServicePort, 0, 4)
 
 	for _, port := range MasterPortInts() {
-		// Skip WebUI port - it's not exposed via service
-		if port == WebUIPort {
-			continue
-		}
-
 		servicePorts = append(servicePorts, corev1.ServicePort{
 			Name:     fmt.Sprintf("%s%d", PortNamePrefix, port),
 			Protocol: corev1.ProtocolTCP,
@@ -48,8 +44,8 @@ func BuildMasterService(lt *locustv2.LocustTest, cfg *config.OperatorConfig) *co
 		})
 	}
 
-	// Add metrics port ONLY if OTel is disabled (sidecar will be deployed)
-	if !IsOTelEnabled(lt) {
+	// Add metrics port ONLY if the metrics exporter sidecar is deployed
+	if HasMetricsExporterSidecar(lt, cfg) {
 		servicePorts = append(servicePorts, corev1.ServicePort{
 			Name:     MetricsPortName,
 			Protocol: corev1.ProtocolTCP,
@@ -61,6 +57,11 @@ func BuildMasterService(lt *locustv2.LocustTest, cfg *config.OperatorConfig) *co
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      nodeName,
 			Namespace: lt.Namespace,
+			Labels: map[string]string{
+				LabelManagedBy: ManagedByValue,
+				LabelTestName:  lt.Name,
+				LabelPodName:   nodeName,
+			},
 		},
 		Spec: corev1.ServiceSpec{
 			Selector: map[string]string{
@@ -70,3 +71,9 @@ func BuildMasterService(lt *locustv2.LocustTest, cfg *config.OperatorConfig) *co
 		},
 	}
 }
+
+// MasterWebUIURL returns the in-cluster URL of the master's web UI, served
+// through the master Service.
+func MasterWebUIURL(lt *locustv2.LocustTest) string {
+	return fmt.Sprintf("http://%s.%s.svc:%d", NodeName(lt.Name, Master), lt.Namespace, WebUIPort)
+}
@@ -69,9 +69,8 @@ func TestBuildMasterService_Ports(t *testing.T) {
 
 	svc := BuildMasterService(lt, cfg)
 
-	// Should have 3 ports: 5557, 5558, and metrics (9646)
-	// WebUI port 8089 should NOT be included
-	assert.Len(t, svc.Spec.Ports, 3)
+	// Should have 4 ports: 5557, 5558, 8089, and metrics (9646)
+	assert.Len(t, svc.Spec.Ports, 4)
 
 	portNumbers := make([]int32, len(svc.Spec.Ports))
 	for i, p := range svc.Spec.Ports {
@@ -80,10 +79,11 @@ func TestBuildMasterService_Ports(t *testing.T) {
 
 	assert.Contains(t, portNumbers, int32(MasterPort))
 	assert.Contains(t, portNumbers, int32(MasterBindPort))
+	assert.Contains(t, portNumbers, int32(WebUIPort))
 	assert.Contains(t, portNumbers, int32(9646))
 }
 
-func TestBuildMasterService_NoWebUIPort(t *testing.T) {
+func TestBuildMasterService_WebUIPort(t *testing.T) {
 	lt := newTestLocustTestForService()
 
 	cfg := &config.OperatorConfig{
@@ -92,10 +92,24 @@ func TestBuildMasterService_NoWebUIPort(t *testing.T) {
 
 	svc := BuildMasterService(lt, cfg)
 
-	// WebUI port 8089 should NOT be exposed
-	for _, p := range svc.Spec.Ports {
-		assert.NotEqual(t, int32(WebUIPort), p.Port, "WebUI port 8089 should NOT be exposed via service")
+	// WebUI port 8089 is exposed so the operator can read statistics
+	var webUIPort *corev1.ServicePort
+	for i := range svc.Spec.Ports {
+		if svc.Spec.Ports[i].Port == WebUIPort {
+			webUIPort = &svc.Spec.Ports[i]
+		}
 	}
+	require.NotNil(t, webUIPort, "WebUI port 8089 should be exposed via service")
+	assert.Equal(t, "port8089", webUIPort.Name)
+	assert.Empty(t, svc.Spec.Type, "service must stay cluster-internal (ClusterIP)")
+}
+
+func TestMasterWebUIURL(t *testing.T) {
+	lt := newTestLocustTestForService()
+	lt.Name = "team-a.load-test"
+	lt.Namespace = "perf"
+
+	assert.Equal(t, "http://team-a-load-test-master.perf.svc:8089", MasterWebUIURL(lt))
 }
 
 func TestBuildMasterService_CustomMetricsPort(t *testing.T) {
@@ -146,8 +160,8 @@ func TestBuildMasterService_OTelDisabled_HasMetricsPort(t *testing.T) {
 
 	svc := BuildMasterService(lt, cfg)
 
-	// Should have 3 ports: 5557, 5558, and metrics (9646)
-	assert.Len(t, svc.Spec.Ports, 3)
+	// Should have 4 ports: 5557, 5558, 8089, and metrics (9646)
+	assert.Len(t, svc.Spec.Ports, 4)
 
 	var metricsPortFound bool
 	for _, p := range svc.Spec.Ports {
@@ -174,8 +188,8 @@ func TestBuildMasterService_OTelEnabled_NoMetricsPort(t *testing.T) {
 
 	svc := BuildMasterService(lt, cfg)
 
-	// Should have 2 ports: 5557, 5558 (no metrics)
-	assert.Len(t, svc.Spec.Ports, 2)
+	// Should have 3 ports: 5557, 5558, 8089 (no metrics)
+	assert.Len(t, svc.Spec.Ports, 3)
 
 	for _, p := range svc.Spec.Ports {
 		assert.NotEqual(t, MetricsPortName, p.Name, "Metrics port should NOT be present when OTel is enabled")
@@ -193,8 +207,8 @@ func TestBuildMasterService_NoObservability_HasMetricsPort(t *testing.T) {
 
 	svc := BuildMasterService(lt, cfg)
 
-	// Should have 3 ports when observability is nil
-	assert.Len(t, svc.Spec.Ports, 3)
+	// Should have 4 ports when observability is nil
+	assert.Len(t, svc.Spec.Ports, 4)
 
 	var metricsPortFound bool
 	for _, p := range svc.Spec.Ports {
@@ -219,8 +233,8 @@ func TestBuildMasterService_OTelEnabledFalse_HasMetricsPort(t *testing.T) {
 
 	svc := BuildMasterService(lt, cfg)
 
-	// Should have 3 ports when OTel is explicitly disabled
-	assert.Len(t, svc.Spec.Ports, 3)
+	// Should have 4 ports when OTel is explicitly disabled
+	assert.Len(t, svc.Spec.Ports, 4)
 
 	var metricsPortFound bool
 	for _, p := range svc.Spec.Ports {
@@ -0,0 +1,57 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+)
+
+// buildUserInitContainers returns the init containers declared in the master
+// or worker spec.
+func buildUserInitContainers(lt *locustv2.LocustTest, mode OperationalMode) []corev1.Container {
+	declared := lt.Spec.Worker.InitContainers
+	if mode == Master {
+		declared = lt.Spec.Master.InitContainers
+	}
+
+	containers := make([]corev1.Container, 0, len(declared))
+	for i := range declared {
+		containers = append(containers, *declared[i].Container.DeepCopy())
+	}
+	return containers
+}
+
+// buildUserSidecars returns the sidecars declared in the master or worker
+// spec as native sidecars, so they run for the life of the pod and their
+// end-of-life termination isn't taken for a failure (see IsNativeSidecar).
+func buildUserSidecars(lt *locustv2.LocustTest, mode OperationalMode) []corev1.Container {
+	declared := lt.Spec.Worker.Sidecars
+	if mode == Master {
+		declared = lt.Spec.Master.Sidecars
+	}
+
+	containers := make([]corev1.Container, 0, len(declared))
+	for i := range declared {
+		sidecar := declared[i].Container.DeepCopy()
+		sidecar.RestartPolicy = ptr.To(corev1.ContainerRestartPolicyAlways)
+		containers = append(containers, *sidecar)
+	}
+	return containers
+}
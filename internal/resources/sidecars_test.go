@@ -0,0 +1,104 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+)
+
+func containerNames(containers []corev1.Container) []string {
+	names := make([]string, 0, len(containers))
+	for _, container := range containers {
+		names = append(names, container.Name)
+	}
+	return names
+}
+
+func TestBuildMasterJob_UserContainers(t *testing.T) {
+	lt := newTestGitLocustTest()
+	lt.Spec.Master.Sidecars = []locustv2.Container{{Container: corev1.Container{Name: "log-shipper", Image: "fluent/fluent-bit:3.1"}}}
+	lt.Spec.Master.InitContainers = []locustv2.Container{{Container: corev1.Container{
+		Name: "prepare", Image: "busybox", Command: []string{"true"},
+	}}}
+
+	podSpec := BuildMasterJob(lt, newTestConfig(), logr.Discard()).Spec.Template.Spec
+
+	assert.Equal(t, []string{GitCloneContainerName, "prepare", MetricsExporterContainerName, "log-shipper"},
+		containerNames(podSpec.InitContainers),
+		"init containers run after the clone, user sidecars start after the operator's")
+	assert.False(t, IsNativeSidecar(*findInitContainer(podSpec, "prepare")))
+	shipper := findInitContainer(podSpec, "log-shipper")
+	assert.True(t, IsNativeSidecar(*shipper),
+		"IsNativeSidecar must accept user sidecars; pod health relies on it to exempt them")
+	assert.Equal(t, "fluent/fluent-bit:3.1", shipper.Image)
+	assert.Nil(t, lt.Spec.Master.Sidecars[0].RestartPolicy, "the spec isn't modified")
+
+	// Worker pods don't get the master's containers
+	workerSpec := BuildWorkerJob(lt, newTestConfig(), logr.Discard()).Spec.Template.Spec
+	assert.Equal(t, []string{GitCloneContainerName}, containerNames(workerSpec.InitContainers))
+}
+
+func TestBuildWorkerJobs_UserContainers(t *testing.T) {
+	lt := newTestWorkerGroupsLocustTest()
+	lt.Spec.Worker.InitContainers = []locustv2.Container{{Container: corev1.Container{
+		Name: "warm-token-cache", Image: "registry.example.com/token-cache",
+	}}}
+	lt.Spec.Worker.Sidecars = []locustv2.Container{{Container: corev1.Container{
+		Name:          "log-shipper",
+		Image:         "fluent/fluent-bit:3.1",
+		RestartPolicy: ptr.To(corev1.ContainerRestartPolicyAlways),
+	}}}
+
+	jobs := append(BuildWorkerGroupJobs(lt, newTestConfig(), logr.Discard()),
+		BuildWorkerJob(lt, newTestConfig(), logr.Discard()))
+	for _, job := range jobs {
+		podSpec := job.Spec.Template.Spec
+		assert.Equal(t, []string{"warm-token-cache", "log-shipper"}, containerNames(podSpec.InitContainers), job.Name)
+		assert.True(t, IsNativeSidecar(podSpec.InitContainers[1]), job.Name)
+		assert.Len(t, podSpec.Containers, 1, job.Name)
+	}
+
+	masterSpec := BuildMasterJob(lt, newTestConfig(), logr.Discard()).Spec.Template.Spec
+	assert.Equal(t, []string{MetricsExporterContainerName}, containerNames(masterSpec.InitContainers))
+}
+
+func TestRequestedResources_UserSidecars(t *testing.T) {
+	lt := newTestLocustTest()
+	cfg := newTestConfig()
+	base := RequestedResources(lt, cfg)
+
+	lt.Spec.Worker.Sidecars = []locustv2.Container{{Container: corev1.Container{
+		Name: "log-shipper",
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+		},
+	}}}
+
+	requested := RequestedResources(lt, cfg)
+	cpu := requested[corev1.ResourceCPU]
+	cpu.Sub(base[corev1.ResourceCPU])
+	require.Equal(t, "300m", cpu.String(), "every worker runs the sidecar")
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// HasInlineTestFiles reports whether the test files are given inline in the spec.
+func HasInlineTestFiles(lt *locustv2.LocustTest) bool {
+	return lt.Spec.TestFiles != nil && len(lt.Spec.TestFiles.Inline) > 0
+}
+
+// InlineTestFilesConfigMapName returns the name of the ConfigMap holding the
+// inline test files, e.g. "team-a.load-test" -> "team-a-load-test-test-files".
+func InlineTestFilesConfigMapName(crName string) string {
+	return locustv2.SanitizeResourceName(crName) + "-test-files"
+}
+
+// TestFilesConfigMapName returns the name of the ConfigMap mounted at the
+// test files path: testFiles.configMapRef, or the ConfigMap generated from
+// testFiles.inline. Returns "" when neither is set.
+func TestFilesConfigMapName(lt *locustv2.LocustTest) string {
+	if HasInlineTestFiles(lt) {
+		return InlineTestFilesConfigMapName(lt.Name)
+	}
+	if lt.Spec.TestFiles != nil {
+		return lt.Spec.TestFiles.ConfigMapRef
+	}
+	return ""
+}
+
+// BuildInlineTestFilesConfigMap creates the ConfigMap with the files of
+// testFiles.inline. Returns nil when no inline files are configured.
+func BuildInlineTestFilesConfigMap(lt *locustv2.LocustTest) *corev1.ConfigMap {
+	if !HasInlineTestFiles(lt) {
+		return nil
+	}
+
+	data := make(map[string]string, len(lt.Spec.TestFiles.Inline))
+	for name, content := range lt.Spec.TestFiles.Inline {
+		data[name] = content
+	}
+
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      InlineTestFilesConfigMapName(lt.Name),
+			Namespace: lt.Namespace,
+			Labels: map[string]string{
+				LabelManagedBy: ManagedByValue,
+				LabelTestName:  lt.Name,
+			},
+		},
+		// The files are fixed for the lifetime of the test, like the Jobs using them
+		Immutable: ptr.To(true),
+		Data:      data,
+	}
+}
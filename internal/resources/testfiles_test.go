@@ -0,0 +1,98 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func newTestInlineLocustTest() *locustv2.LocustTest {
+	lt := newTestLocustTest()
+	lt.Spec.TestFiles = &locustv2.TestFilesConfig{
+		Inline: map[string]string{
+			"test.py":    "from locust import HttpUser\n",
+			"helpers.py": "BASE_PATH = '/api'\n",
+		},
+	}
+	return lt
+}
+
+func TestTestFilesConfigMapName(t *testing.T) {
+	lt := newTestLocustTest()
+	assert.Equal(t, "my-test-configmap", TestFilesConfigMapName(lt))
+
+	lt = newTestInlineLocustTest()
+	lt.Name = "team-a.load-test"
+	assert.Equal(t, "team-a-load-test-test-files", TestFilesConfigMapName(lt))
+
+	lt.Spec.TestFiles = nil
+	assert.Empty(t, TestFilesConfigMapName(lt))
+}
+
+func TestBuildInlineTestFilesConfigMap(t *testing.T) {
+	assert.Nil(t, BuildInlineTestFilesConfigMap(newTestLocustTest()), "no ConfigMap without inline files")
+
+	lt := newTestInlineLocustTest()
+	cm := BuildInlineTestFilesConfigMap(lt)
+	require.NotNil(t, cm)
+
+	assert.Equal(t, "my-test-test-files", cm.Name)
+	assert.Equal(t, "default", cm.Namespace)
+	assert.Equal(t, "my-test", cm.Labels[LabelTestName])
+	assert.Equal(t, ManagedByValue, cm.Labels[LabelManagedBy])
+	require.NotNil(t, cm.Immutable)
+	assert.True(t, *cm.Immutable)
+	assert.Equal(t, lt.Spec.TestFiles.Inline, cm.Data)
+
+	// The ConfigMap doesn't share its data with the spec
+	cm.Data["test.py"] = "changed"
+	assert.Equal(t, "from locust import HttpUser\n", lt.Spec.TestFiles.Inline["test.py"])
+}
+
+func TestBuildJobs_WithInlineTestFiles(t *testing.T) {
+	lt := newTestInlineLocustTest()
+	cfg := newTestConfig()
+
+	for _, job := range []struct {
+		name     string
+		nodeName string
+		podSpec  corev1.PodSpec
+	}{
+		{"master", "my-test-master", BuildMasterJob(lt, cfg, logr.Discard()).Spec.Template.Spec},
+		{"worker", "my-test-worker", BuildWorkerJob(lt, cfg, logr.Discard()).Spec.Template.Spec},
+	} {
+		// Mounted exactly like testFiles.configMapRef
+		assert.Contains(t, job.podSpec.Volumes, corev1.Volume{
+			Name: job.nodeName,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "my-test-test-files"},
+				},
+			},
+		}, job.name)
+		assert.Contains(t, job.podSpec.Containers[0].VolumeMounts, corev1.VolumeMount{
+			Name:      job.nodeName,
+			MountPath: DefaultMountPath,
+		}, job.name)
+	}
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+)
+
+// HTTPRouteGVK is the Gateway API HTTPRoute kind. The HTTPRoute is built as an
+// unstructured object so the operator doesn't depend on the Gateway API module
+// and keeps working in clusters without its CRDs.
+var HTTPRouteGVK = schema.GroupVersionKind{
+	Group:   "gateway.networking.k8s.io",
+	Version: "v1",
+	Kind:    "HTTPRoute",
+}
+
+// IsWebUIEnabled reports whether the master's web UI is exposed.
+func IsWebUIEnabled(lt *locustv2.LocustTest) bool {
+	return lt.Spec.WebUI != nil && lt.Spec.WebUI.Enabled
+}
+
+// WebUIName returns the name of the web UI Service, Ingress and HTTPRoute,
+// e.g. "team-a.load-test" -> "team-a-load-test-webui".
+func WebUIName(crName string) string {
+	return locustv2.SanitizeResourceName(crName) + "-webui"
+}
+
+// webUILabels returns the labels of the web UI objects.
+func webUILabels(lt *locustv2.LocustTest) map[string]string {
+	return map[string]string{
+		LabelManagedBy: ManagedByValue,
+		LabelTestName:  lt.Name,
+	}
+}
+
+// BuildWebUIService creates the Service exposing only the master's web UI
+// port, so a NodePort or LoadBalancer type doesn't also expose the ports
+// workers connect to. Returns nil unless the web UI is enabled.
+func BuildWebUIService(lt *locustv2.LocustTest) *corev1.Service {
+	if !IsWebUIEnabled(lt) {
+		return nil
+	}
+
+	serviceType := lt.Spec.WebUI.ServiceType
+	if serviceType == "" {
+		serviceType = corev1.ServiceTypeClusterIP
+	}
+
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      WebUIName(lt.Name),
+			Namespace: lt.Namespace,
+			Labels:    webUILabels(lt),
+		},
+		Spec: corev1.ServiceSpec{
+			Type: serviceType,
+			Selector: map[string]string{
+				LabelPodName: NodeName(lt.Name, Master),
+			},
+			Ports: []corev1.ServicePort{
+				{
+					Name:       WebUIPortName,
+					Protocol:   corev1.ProtocolTCP,
+					Port:       WebUIPort,
+					TargetPort: intstr.FromInt32(WebUIPort),
+				},
+			},
+		},
+	}
+}
+
+// BuildWebUIIngress creates the Ingress routing webUI.ingress.host to the web
+// UI Service. Returns nil unless an Ingress is configured.
+func BuildWebUIIngress(lt *locustv2.LocustTest) *networkingv1.Ingress {
+	if !IsWebUIEnabled(lt) || lt.Spec.WebUI.Ingress == nil {
+		return nil
+	}
+	cfg := lt.Spec.WebUI.Ingress
+
+	var tls []networkingv1.IngressTLS
+	if cfg.TLSSecretName != "" {
+		tls = []networkingv1.IngressTLS{{Hosts: []string{cfg.Host}, SecretName: cfg.TLSSecretName}}
+	}
+
+	return &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        WebUIName(lt.Name),
+			Namespace:   lt.Namespace,
+			Labels:      webUILabels(lt),
+			Annotations: cfg.Annotations,
+		},
+		Spec: networkingv1.IngressSpec{
+			IngressClassName: cfg.ClassName,
+			TLS:              tls,
+			Rules: []networkingv1.IngressRule{
+				{
+					Host: cfg.Host,
+					IngressRuleValue: networkingv1.IngressRuleValue{
+						HTTP: &networkingv1.HTTPIngressRuleValue{
+							Paths: []networkingv1.HTTPIngressPath{
+								{
+									Path:     "/",
+									PathType: ptr.To(networkingv1.PathTypePrefix),
+									Backend: networkingv1.IngressBackend{
+										Service: &networkingv1.IngressServiceBackend{
+											Name: WebUIName(lt.Name),
+											Port: networkingv1.ServiceBackendPort{Number: WebUIPort},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// BuildWebUIHTTPRoute creates the Gateway API HTTPRoute routing to the web UI
+// Service. Returns nil unless an HTTPRoute is configured.
+func BuildWebUIHTTPRoute(lt *locustv2.LocustTest) *unstructured.Unstructured {
+	if !IsWebUIEnabled(lt) || lt.Spec.WebUI.HTTPRoute == nil {
+		return nil
+	}
+	cfg := lt.Spec.WebUI.HTTPRoute
+
+	parentRefs := make([]any, 0, len(cfg.ParentRefs))
+	for _, ref := range cfg.ParentRefs {
+		parentRef := map[string]any{"name": ref.Name}
+		if ref.Namespace != "" {
+			parentRef["namespace"] = ref.Namespace
+		}
+		if ref.SectionName != "" {
+			parentRef["sectionName"] = ref.SectionName
+		}
+		parentRefs = append(parentRefs, parentRef)
+	}
+
+	spec := map[string]any{
+		"parentRefs": parentRefs,
+		"rules": []any{
+			map[string]any{
+				"backendRefs": []any{
+					map[string]any{"name": WebUIName(lt.Name), "port": int64(WebUIPort)},
+				},
+			},
+		},
+	}
+	if len(cfg.Hostnames) > 0 {
+		hostnames := make([]any, 0, len(cfg.Hostnames))
+		for _, hostname := range cfg.Hostnames {
+			hostnames = append(hostnames, hostname)
+		}
+		spec["hostnames"] = hostnames
+	}
+
+	route := &unstructured.Unstructured{Object: map[string]any{"spec": spec}}
+	route.SetGroupVersionKind(HTTPRouteGVK)
+	route.SetName(WebUIName(lt.Name))
+	route.SetNamespace(lt.Namespace)
+	route.SetLabels(webUILabels(lt))
+	return route
+}
+
+// WebUIURL returns the URL the web UI is reachable at: the Ingress host, the
+// first non-wildcard HTTPRoute hostname, the LoadBalancer address once
+// assigned, or the in-cluster address of the web UI Service. service is the
+// current web UI Service and may be nil. Returns "" unless the web UI is enabled.
+func WebUIURL(lt *locustv2.LocustTest, service *corev1.Service) string {
+	if !IsWebUIEnabled(lt) {
+		return ""
+	}
+	webUI := lt.Spec.WebUI
+
+	if ing := webUI.Ingress; ing != nil {
+		if ing.TLSSecretName != "" {
+			return "https://" + ing.Host + "/"
+		}
+		return "http://" + ing.Host + "/"
+	}
+
+	if route := webUI.HTTPRoute; route != nil {
+		for _, hostname := range route.Hostnames {
+			if !strings.HasPrefix(hostname, "*") {
+				return "http://" + hostname + "/"
+			}
+		}
+	}
+
+	if service != nil && service.Spec.Type == corev1.ServiceTypeLoadBalancer {
+		for _, ingress := range service.Status.LoadBalancer.Ingress {
+			host := ingress.Hostname
+			if host == "" {
+				host = ingress.IP
+			}
+			if host != "" {
+				return "http://" + net.JoinHostPort(host, strconv.Itoa(WebUIPort)) + "/"
+			}
+		}
+	}
+
+	return WebUIServiceURL(lt)
+}
+
+// WebUIServiceURL returns the in-cluster URL of the web UI Service.
+func WebUIServiceURL(lt *locustv2.LocustTest) string {
+	return fmt.Sprintf("http://%s.%s.svc:%d/", WebUIName(lt.Name), lt.Namespace, WebUIPort)
+}
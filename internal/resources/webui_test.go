@@ -0,0 +1,205 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+)
+
+func newTestWebUILocustTest(webUI *locustv2.WebUIConfig) *locustv2.LocustTest {
+	lt := newTestLocustTest()
+	lt.Spec.WebUI = webUI
+	return lt
+}
+
+func TestBuildWebUIService(t *testing.T) {
+	assert.Nil(t, BuildWebUIService(newTestLocustTest()), "no Service without webUI")
+	assert.Nil(t, BuildWebUIService(newTestWebUILocustTest(&locustv2.WebUIConfig{})), "no Service when disabled")
+
+	service := BuildWebUIService(newTestWebUILocustTest(&locustv2.WebUIConfig{Enabled: true}))
+	require.NotNil(t, service)
+	assert.Equal(t, "my-test-webui", service.Name)
+	assert.Equal(t, "default", service.Namespace)
+	assert.Equal(t, "my-test", service.Labels[LabelTestName])
+	assert.Equal(t, corev1.ServiceTypeClusterIP, service.Spec.Type)
+	assert.Equal(t, map[string]string{LabelPodName: "my-test-master"}, service.Spec.Selector)
+	// Only the web UI port, never the ports workers connect to
+	require.Len(t, service.Spec.Ports, 1)
+	assert.Equal(t, WebUIPortName, service.Spec.Ports[0].Name)
+	assert.Equal(t, int32(WebUIPort), service.Spec.Ports[0].Port)
+	assert.Equal(t, int32(WebUIPort), service.Spec.Ports[0].TargetPort.IntVal)
+
+	service = BuildWebUIService(newTestWebUILocustTest(&locustv2.WebUIConfig{
+		Enabled:     true,
+		ServiceType: corev1.ServiceTypeLoadBalancer,
+	}))
+	assert.Equal(t, corev1.ServiceTypeLoadBalancer, service.Spec.Type)
+}
+
+func TestBuildWebUIIngress(t *testing.T) {
+	assert.Nil(t, BuildWebUIIngress(newTestWebUILocustTest(&locustv2.WebUIConfig{Enabled: true})),
+		"no Ingress without webUI.ingress")
+
+	lt := newTestWebUILocustTest(&locustv2.WebUIConfig{
+		Enabled: true,
+		Ingress: &locustv2.WebUIIngressConfig{
+			ClassName:     ptr.To("nginx"),
+			Host:          "load.example.com",
+			TLSSecretName: "load-tls",
+			Annotations:   map[string]string{"cert-manager.io/cluster-issuer": "letsencrypt"},
+		},
+	})
+	ingress := BuildWebUIIngress(lt)
+	require.NotNil(t, ingress)
+
+	assert.Equal(t, "my-test-webui", ingress.Name)
+	assert.Equal(t, "letsencrypt", ingress.Annotations["cert-manager.io/cluster-issuer"])
+	assert.Equal(t, ptr.To("nginx"), ingress.Spec.IngressClassName)
+	assert.Equal(t, []networkingv1.IngressTLS{{Hosts: []string{"load.example.com"}, SecretName: "load-tls"}},
+		ingress.Spec.TLS)
+
+	require.Len(t, ingress.Spec.Rules, 1)
+	rule := ingress.Spec.Rules[0]
+	assert.Equal(t, "load.example.com", rule.Host)
+	require.Len(t, rule.HTTP.Paths, 1)
+	assert.Equal(t, "/", rule.HTTP.Paths[0].Path)
+	assert.Equal(t, &networkingv1.IngressServiceBackend{
+		Name: "my-test-webui",
+		Port: networkingv1.ServiceBackendPort{Number: WebUIPort},
+	}, rule.HTTP.Paths[0].Backend.Service)
+
+	lt.Spec.WebUI.Ingress.TLSSecretName = ""
+	assert.Empty(t, BuildWebUIIngress(lt).Spec.TLS)
+}
+
+func TestBuildWebUIHTTPRoute(t *testing.T) {
+	assert.Nil(t, BuildWebUIHTTPRoute(newTestWebUILocustTest(&locustv2.WebUIConfig{Enabled: true})),
+		"no HTTPRoute without webUI.httpRoute")
+
+	route := BuildWebUIHTTPRoute(newTestWebUILocustTest(&locustv2.WebUIConfig{
+		Enabled: true,
+		HTTPRoute: &locustv2.WebUIHTTPRouteConfig{
+			ParentRefs: []locustv2.GatewayParentReference{
+				{Name: "public", Namespace: "gateways", SectionName: "https"},
+				{Name: "internal"},
+			},
+			Hostnames: []string{"load.example.com"},
+		},
+	}))
+	require.NotNil(t, route)
+
+	assert.Equal(t, HTTPRouteGVK, route.GroupVersionKind())
+	assert.Equal(t, "my-test-webui", route.GetName())
+	assert.Equal(t, "default", route.GetNamespace())
+
+	parentRefs, _, err := unstructured.NestedSlice(route.Object, "spec", "parentRefs")
+	require.NoError(t, err)
+	assert.Equal(t, []any{
+		map[string]any{"name": "public", "namespace": "gateways", "sectionName": "https"},
+		map[string]any{"name": "internal"},
+	}, parentRefs)
+
+	hostnames, _, err := unstructured.NestedStringSlice(route.Object, "spec", "hostnames")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"load.example.com"}, hostnames)
+
+	rules, _, err := unstructured.NestedSlice(route.Object, "spec", "rules")
+	require.NoError(t, err)
+	assert.Equal(t, []any{
+		map[string]any{"backendRefs": []any{map[string]any{"name": "my-test-webui", "port": int64(WebUIPort)}}},
+	}, rules)
+}
+
+func TestWebUIURL(t *testing.T) {
+	lbService := &corev1.Service{Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer}}
+
+	tests := []struct {
+		name     string
+		webUI    *locustv2.WebUIConfig
+		service  *corev1.Service
+		expected string
+	}{
+		{name: "Disabled", webUI: nil, expected: ""},
+		{name: "ClusterIP", webUI: &locustv2.WebUIConfig{Enabled: true}, expected: "http://my-test-webui.default.svc:8089/"},
+		{
+			name:     "Ingress",
+			webUI:    &locustv2.WebUIConfig{Enabled: true, Ingress: &locustv2.WebUIIngressConfig{Host: "load.example.com"}},
+			expected: "http://load.example.com/",
+		},
+		{
+			name: "IngressWithTLS",
+			webUI: &locustv2.WebUIConfig{Enabled: true, Ingress: &locustv2.WebUIIngressConfig{
+				Host: "load.example.com", TLSSecretName: "load-tls",
+			}},
+			expected: "https://load.example.com/",
+		},
+		{
+			name: "HTTPRoute",
+			webUI: &locustv2.WebUIConfig{Enabled: true, HTTPRoute: &locustv2.WebUIHTTPRouteConfig{
+				Hostnames: []string{"*.example.com", "load.example.com"},
+			}},
+			expected: "http://load.example.com/",
+		},
+		{
+			name:     "HTTPRouteWithoutHostnames",
+			webUI:    &locustv2.WebUIConfig{Enabled: true, HTTPRoute: &locustv2.WebUIHTTPRouteConfig{}},
+			expected: "http://my-test-webui.default.svc:8089/",
+		},
+		{
+			name:     "LoadBalancerPending",
+			webUI:    &locustv2.WebUIConfig{Enabled: true, ServiceType: corev1.ServiceTypeLoadBalancer},
+			service:  lbService,
+			expected: "http://my-test-webui.default.svc:8089/",
+		},
+		{
+			name:  "LoadBalancerHostname",
+			webUI: &locustv2.WebUIConfig{Enabled: true, ServiceType: corev1.ServiceTypeLoadBalancer},
+			service: &corev1.Service{
+				Spec: lbService.Spec,
+				Status: corev1.ServiceStatus{LoadBalancer: corev1.LoadBalancerStatus{
+					Ingress: []corev1.LoadBalancerIngress{{Hostname: "lb.example.com", IP: "203.0.113.10"}},
+				}},
+			},
+			expected: "http://lb.example.com:8089/",
+		},
+		{
+			name:  "LoadBalancerIPv6",
+			webUI: &locustv2.WebUIConfig{Enabled: true, ServiceType: corev1.ServiceTypeLoadBalancer},
+			service: &corev1.Service{
+				Spec: lbService.Spec,
+				Status: corev1.ServiceStatus{LoadBalancer: corev1.LoadBalancerStatus{
+					Ingress: []corev1.LoadBalancerIngress{{IP: "2001:db8::10"}},
+				}},
+			},
+			expected: "http://[2001:db8::10]:8089/",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, WebUIURL(newTestWebUILocustTest(tt.webUI), tt.service))
+		})
+	}
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+	"github.com/AbdelrhmanHamouda/locust-k8s-operator/internal/config"
+)
+
+// WorkerGroupNodeName returns the name of a worker group's Job.
+// Format: "{cr-name}-worker-{group}" with dots replaced by dashes.
+func WorkerGroupNodeName(crName, group string) string {
+	return locustv2.GeneratedWorkerGroupName(crName, group)
+}
+
+// ExpectedWorkers returns the number of workers the master expects: the
+// workers of spec.worker and of every worker group.
+func ExpectedWorkers(lt *locustv2.LocustTest) int32 {
+	expected := lt.Spec.Worker.Replicas
+	for _, group := range lt.Spec.WorkerGroups {
+		expected += group.Replicas
+	}
+	return expected
+}
+
+// JobNames returns the names of all Jobs of a test: the master, the worker
+// Job and the Job of every worker group.
+func JobNames(lt *locustv2.LocustTest) []string {
+	names := []string{NodeName(lt.Name, Master), NodeName(lt.Name, Worker)}
+	for _, group := range lt.Spec.WorkerGroups {
+		names = append(names, WorkerGroupNodeName(lt.Name, group.Name))
+	}
+	return names
+}
+
+// BuildWorkerGroupJobs creates a worker Job for every worker group.
+func BuildWorkerGroupJobs(lt *locustv2.LocustTest, cfg *config.OperatorConfig, logger logr.Logger) []*batchv1.Job {
+	jobs := make([]*batchv1.Job, 0, len(lt.Spec.WorkerGroups))
+	for i := range lt.Spec.WorkerGroups {
+		jobs = append(jobs, BuildWorkerGroupJob(lt, &lt.Spec.WorkerGroups[i], cfg, logger))
+	}
+	return jobs
+}
+
+// BuildWorkerGroupJob creates the worker Job of a worker group. The Job is
+// built like the worker Job of spec.worker, from a copy of the test whose
+// worker spec, and optionally image and scheduling, are the group's. The
+// sidecars, init containers and podTemplate override of spec.worker apply
+// to the group too.
+func BuildWorkerGroupJob(lt *locustv2.LocustTest, group *locustv2.WorkerGroup, cfg *config.OperatorConfig, logger logr.Logger) *batchv1.Job {
+	groupTest := lt.DeepCopy()
+	groupTest.Spec.Worker = locustv2.WorkerSpec{
+		Command:        group.Command,
+		Replicas:       group.Replicas,
+		Resources:      group.Resources,
+		Labels:         group.Labels,
+		Annotations:    group.Annotations,
+		ExtraArgs:      group.ExtraArgs,
+		Sidecars:       lt.Spec.Worker.Sidecars,
+		InitContainers: lt.Spec.Worker.InitContainers,
+		PodTemplate:    lt.Spec.Worker.PodTemplate,
+	}
+	if groupTest.Spec.Worker.Command == "" {
+		groupTest.Spec.Worker.Command = lt.Spec.Worker.Command
+	}
+	if group.Image != "" {
+		groupTest.Spec.Image = group.Image
+	}
+	if group.Scheduling != nil {
+		groupTest.Spec.Scheduling = group.Scheduling
+	}
+
+	nodeName := WorkerGroupNodeName(lt.Name, group.Name)
+	masterHost := NodeName(lt.Name, Master)
+	command := BuildWorkerCommand(groupTest.Spec.Worker.Command, masterHost, IsOTelEnabled(groupTest),
+		groupTest.Spec.Worker.ExtraArgs, logger)
+
+	job := buildJob(groupTest, cfg, Worker, nodeName, command)
+	applyPodTemplate(job, groupTest.Spec.Worker.PodTemplate, logger)
+	job.Spec.Template.Labels[LabelWorkerGroup] = group.Name
+	return job
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	locustv2 "github.com/AbdelrhmanHamouda/locust-k8s-operator/api/v2"
+)
+
+func newTestWorkerGroupsLocustTest() *locustv2.LocustTest {
+	lt := newTestLocustTest()
+	lt.Spec.Scheduling = &locustv2.SchedulingConfig{NodeSelector: map[string]string{"pool": "default"}}
+	lt.Spec.WorkerGroups = []locustv2.WorkerGroup{
+		{
+			Name:      "browse",
+			Replicas:  2,
+			ExtraArgs: []string{"BrowsingUser"},
+		},
+		{
+			Name:      "checkout",
+			Replicas:  1,
+			Command:   "locust -f /lotest/src/checkout.py",
+			ExtraArgs: []string{"CheckoutUser"},
+			Image:     "registry.example.com/locust:checkout",
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+			},
+			Labels:      map[string]string{"team": "payments"},
+			Annotations: map[string]string{"note": "checkout"},
+			Scheduling:  &locustv2.SchedulingConfig{NodeSelector: map[string]string{"pool": "large"}},
+		},
+	}
+	return lt
+}
+
+func TestExpectedWorkers(t *testing.T) {
+	assert.Equal(t, int32(3), ExpectedWorkers(newTestLocustTest()))
+	assert.Equal(t, int32(6), ExpectedWorkers(newTestWorkerGroupsLocustTest()))
+}
+
+func TestJobNames(t *testing.T) {
+	assert.Equal(t, []string{"my-test-master", "my-test-worker"}, JobNames(newTestLocustTest()))
+	assert.Equal(t,
+		[]string{"my-test-master", "my-test-worker", "my-test-worker-browse", "my-test-worker-checkout"},
+		JobNames(newTestWorkerGroupsLocustTest()))
+}
+
+func TestBuildMasterJob_ExpectsWorkerGroups(t *testing.T) {
+	job := BuildMasterJob(newTestWorkerGroupsLocustTest(), newTestConfig(), logr.Discard())
+
+	assert.Contains(t, job.Spec.Template.Spec.Containers[0].Args, "--expect-workers=6")
+}
+
+func TestBuildWorkerGroupJobs(t *testing.T) {
+	lt := newTestWorkerGroupsLocustTest()
+	jobs := BuildWorkerGroupJobs(lt, newTestConfig(), logr.Discard())
+	require.Len(t, jobs, 2)
+
+	// A group without overrides is built like spec.worker
+	browse := jobs[0]
+	assert.Equal(t, "my-test-worker-browse", browse.Name)
+	assert.Equal(t, int32(2), ptr.Deref(browse.Spec.Parallelism, 0))
+	assert.Equal(t, "browse", browse.Spec.Template.Labels[LabelWorkerGroup])
+	assert.Equal(t, "my-test-worker", browse.Spec.Template.Labels[LabelPodName])
+	assert.Equal(t, "my-test", browse.Spec.Template.Labels[LabelTestName])
+	assert.Equal(t, map[string]string{"pool": "default"}, browse.Spec.Template.Spec.NodeSelector)
+	container := browse.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, "my-test-worker-browse", container.Name)
+	assert.Equal(t, "locustio/locust:latest", container.Image)
+	assert.Equal(t, []string{
+		"locust", "-f", "/lotest/src/test.py",
+		"--worker", "--master-port=5557", "--master-host=my-test-master",
+		"BrowsingUser",
+	}, container.Args)
+	assert.Equal(t, resource.MustParse("250m"), container.Resources.Requests[corev1.ResourceCPU])
+
+	// A group's command, image, resources, labels and scheduling win
+	checkout := jobs[1]
+	assert.Equal(t, "my-test-worker-checkout", checkout.Name)
+	assert.Equal(t, int32(1), ptr.Deref(checkout.Spec.Parallelism, 0))
+	assert.Equal(t, "checkout", checkout.Spec.Template.Labels[LabelWorkerGroup])
+	assert.Equal(t, "payments", checkout.Spec.Template.Labels["team"])
+	assert.Equal(t, "checkout", checkout.Spec.Template.Annotations["note"])
+	assert.Equal(t, map[string]string{"pool": "large"}, checkout.Spec.Template.Spec.NodeSelector)
+	container = checkout.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, "registry.example.com/locust:checkout", container.Image)
+	assert.Equal(t, []string{
+		"locust", "-f", "/lotest/src/checkout.py",
+		"--worker", "--master-port=5557", "--master-host=my-test-master",
+		"CheckoutUser",
+	}, container.Args)
+	assert.Equal(t, resource.MustParse("1"), container.Resources.Requests[corev1.ResourceCPU])
+
+	// The test itself is left untouched
+	assert.Equal(t, "locustio/locust:latest", lt.Spec.Image)
+	assert.Equal(t, int32(3), lt.Spec.Worker.Replicas)
+}
+
+func TestBuildWorkerGroupJob_UserLabelCannotOverrideGroup(t *testing.T) {
+	lt := newTestWorkerGroupsLocustTest()
+	group := &lt.Spec.WorkerGroups[0]
+	group.Labels = map[string]string{LabelWorkerGroup: "other"}
+
+	job := BuildWorkerGroupJob(lt, group, newTestConfig(), logr.Discard())
+
+	assert.Equal(t, "browse", job.Spec.Template.Labels[LabelWorkerGroup])
+}
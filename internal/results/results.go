@@ -0,0 +1,138 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package results reads the result files and summary of a finished Locust master.
+package results
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// DonePath is the collector path that tells it the files were collected.
+const DonePath = "/done"
+
+// ErrNotFound is returned for a result file the master didn't write.
+var ErrNotFound = errors.New("result file not found")
+
+// ErrTooLarge is returned for a result file larger than the requested limit.
+var ErrTooLarge = errors.New("result file too large")
+
+// Fetcher reads result files from the results collector of a master pod.
+// The controller depends on this interface rather than on HTTP so tests can
+// substitute a fake collector.
+type Fetcher interface {
+	// File returns the content of the result file name served at baseURL.
+	// It returns ErrNotFound if the file doesn't exist and ErrTooLarge if
+	// it is larger than limit bytes.
+	File(ctx context.Context, baseURL, name string, limit int64) ([]byte, error)
+	// Done tells the collector at baseURL that it can stop serving.
+	Done(ctx context.Context, baseURL string) error
+}
+
+// HTTPFetcher is the Fetcher talking to the results collector port.
+type HTTPFetcher struct {
+	Client *http.Client
+}
+
+// NewHTTPFetcher returns an HTTPFetcher whose requests time out after timeout.
+func NewHTTPFetcher(timeout time.Duration) *HTTPFetcher {
+	return &HTTPFetcher{Client: &http.Client{Timeout: timeout}}
+}
+
+// File implements Fetcher.
+func (f *HTTPFetcher) File(ctx context.Context, baseURL, name string, limit int64) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+"/"+url.PathEscape(name), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := f.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", name, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, fmt.Errorf("failed to fetch %s: unexpected status %s", name, resp.Status)
+	}
+
+	if resp.ContentLength > limit {
+		return nil, ErrTooLarge
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, limit+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", name, err)
+	}
+	if int64(len(data)) > limit {
+		return nil, ErrTooLarge
+	}
+	return data, nil
+}
+
+// Done implements Fetcher.
+func (f *HTTPFetcher) Done(ctx context.Context, baseURL string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, baseURL+DonePath, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := f.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to release results collector: %w", err)
+	}
+	_ = resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("failed to release results collector: unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
+// LogReader reads container logs, where the master prints its summary.
+type LogReader interface {
+	// TailLog returns the last lines of the log of a container.
+	TailLog(ctx context.Context, namespace, pod, container string, lines int64) (string, error)
+}
+
+// KubeLogReader is the LogReader using the pods/log API.
+type KubeLogReader struct {
+	Clientset kubernetes.Interface
+}
+
+// TailLog implements LogReader.
+func (r *KubeLogReader) TailLog(ctx context.Context, namespace, pod, container string, lines int64) (string, error) {
+	data, err := r.Clientset.CoreV1().Pods(namespace).GetLogs(pod, &corev1.PodLogOptions{
+		Container: container,
+		TailLines: &lines,
+	}).DoRaw(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to read logs of %s/%s: %w", pod, container, err)
+	}
+	return string(data), nil
+}
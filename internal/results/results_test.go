@@ -0,0 +1,104 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// newTestCollector serves report.html and records whether /done was posted.
+func newTestCollector(t *testing.T, done *bool) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /report.html", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("<html>report</html>"))
+	})
+	mux.HandleFunc("POST /done", func(w http.ResponseWriter, _ *http.Request) {
+		*done = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestHTTPFetcher_File(t *testing.T) {
+	var done bool
+	server := newTestCollector(t, &done)
+	fetcher := NewHTTPFetcher(time.Second)
+
+	data, err := fetcher.File(context.Background(), server.URL, "report.html", 1024)
+	require.NoError(t, err)
+	assert.Equal(t, "<html>report</html>", string(data))
+}
+
+func TestHTTPFetcher_FileNotFound(t *testing.T) {
+	var done bool
+	server := newTestCollector(t, &done)
+
+	_, err := NewHTTPFetcher(time.Second).File(context.Background(), server.URL, "locust_stats.csv", 1024)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestHTTPFetcher_FileTooLarge(t *testing.T) {
+	var done bool
+	server := newTestCollector(t, &done)
+
+	_, err := NewHTTPFetcher(time.Second).File(context.Background(), server.URL, "report.html", 5)
+	assert.ErrorIs(t, err, ErrTooLarge)
+}
+
+func TestHTTPFetcher_CollectorUnreachable(t *testing.T) {
+	var done bool
+	server := newTestCollector(t, &done)
+	server.Close()
+
+	_, err := NewHTTPFetcher(time.Second).File(context.Background(), server.URL, "report.html", 1024)
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrNotFound)
+	assert.Contains(t, err.Error(), "failed to fetch report.html")
+}
+
+func TestHTTPFetcher_Done(t *testing.T) {
+	var done bool
+	server := newTestCollector(t, &done)
+
+	require.NoError(t, NewHTTPFetcher(time.Second).Done(context.Background(), server.URL))
+	assert.True(t, done)
+}
+
+func TestKubeLogReader_TailLog(t *testing.T) {
+	clientset := fake.NewClientset(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-test-master-abcde", Namespace: "default"},
+	})
+	reader := &KubeLogReader{Clientset: clientset}
+
+	log, err := reader.TailLog(context.Background(), "default", "my-test-master-abcde", "my-test-master", 200)
+	require.NoError(t, err)
+	// The fake clientset returns a fixed body for every log request
+	assert.Equal(t, "fake logs", log)
+}
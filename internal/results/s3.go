@@ -0,0 +1,214 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// S3 request signing (AWS Signature Version 4).
+const (
+	s3Service         = "s3"
+	s3SigningAlgo     = "AWS4-HMAC-SHA256"
+	s3DateFormat      = "20060102"
+	s3TimestampFormat = "20060102T150405Z"
+)
+
+// S3Target is a bucket of an S3-compatible object store and the credentials to write to it.
+type S3Target struct {
+	// Endpoint is the URL of the S3 API, e.g. "https://s3.amazonaws.com".
+	Endpoint string
+	// Region used to sign requests.
+	Region string
+	// Bucket the objects are written to.
+	Bucket string
+	// ForcePathStyle addresses the bucket in the URL path rather than the host name.
+	ForcePathStyle bool
+	// AccessKeyID, SecretAccessKey and SessionToken are the credentials.
+	// SessionToken is only set for temporary credentials.
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+}
+
+// ObjectURL returns the URL of the object key in the target's bucket.
+func (t S3Target) ObjectURL(key string) (string, error) {
+	u, err := url.Parse(strings.TrimSuffix(t.Endpoint, "/"))
+	if err != nil {
+		return "", fmt.Errorf("invalid S3 endpoint %q: %w", t.Endpoint, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+		return "", fmt.Errorf("invalid S3 endpoint %q: must be an http or https URL", t.Endpoint)
+	}
+
+	if t.ForcePathStyle {
+		u.Path += "/" + t.Bucket + "/" + key
+	} else {
+		u.Host = t.Bucket + "." + u.Host
+		u.Path += "/" + key
+	}
+	u.RawPath = s3EscapePath(u.Path)
+	return u.String(), nil
+}
+
+// s3EscapePath URI-encodes path the way S3 canonicalizes it: everything but
+// unreserved characters and slashes is percent-encoded.
+func s3EscapePath(path string) string {
+	var b strings.Builder
+	for _, c := range []byte(path) {
+		if 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' ||
+			c == '-' || c == '_' || c == '.' || c == '~' || c == '/' {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+// Uploader writes objects to S3-compatible storage. The controller depends
+// on this interface rather than on HTTP so tests can substitute a fake store.
+type Uploader interface {
+	// Upload writes body to key in the target's bucket and returns the object URL.
+	Upload(ctx context.Context, target S3Target, key string, body []byte, contentType string) (string, error)
+}
+
+// S3Uploader is the Uploader talking to the S3 REST API.
+type S3Uploader struct {
+	Client *http.Client
+	// Now returns the signing time. Defaults to time.Now.
+	Now func() time.Time
+}
+
+// NewS3Uploader returns an S3Uploader whose requests time out after timeout.
+func NewS3Uploader(timeout time.Duration) *S3Uploader {
+	return &S3Uploader{Client: &http.Client{Timeout: timeout}}
+}
+
+// Upload implements Uploader with a single PutObject request.
+func (u *S3Uploader) Upload(ctx context.Context, target S3Target, key string, body []byte, contentType string) (string, error) {
+	objectURL, err := target.ObjectURL(key)
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, objectURL, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	now := time.Now
+	if u.Now != nil {
+		now = u.Now
+	}
+	signV4(req, sha256Hex(body), target, now())
+
+	resp, err := u.Client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to upload %s: %w", key, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		// S3 errors are a short XML document naming the problem, e.g. NoSuchBucket
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return "", fmt.Errorf("failed to upload %s: unexpected status %s: %s",
+			key, resp.Status, strings.TrimSpace(string(detail)))
+	}
+	return objectURL, nil
+}
+
+// signV4 adds the AWS Signature Version 4 headers to req. Every header
+// already set on req is signed, together with the host.
+func signV4(req *http.Request, payloadHash string, target S3Target, t time.Time) {
+	t = t.UTC()
+	timestamp := t.Format(s3TimestampFormat)
+	req.Header.Set("X-Amz-Date", timestamp)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	if target.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", target.SessionToken)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.Query().Encode(),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := strings.Join([]string{t.Format(s3DateFormat), target.Region, s3Service, "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{
+		s3SigningAlgo,
+		timestamp,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+target.SecretAccessKey), t.Format(s3DateFormat))
+	key = hmacSHA256(key, target.Region)
+	key = hmacSHA256(key, s3Service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3SigningAlgo, target.AccessKeyID, scope, signedHeaders, signature))
+}
+
+// sha256Hex returns the hex-encoded SHA-256 digest of data.
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// hmacSHA256 returns the HMAC-SHA256 of data with key.
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
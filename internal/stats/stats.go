@@ -0,0 +1,369 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package stats reads test statistics from a Locust master's web API.
+package stats
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// AggregatedName is the name Locust gives the row summarizing all requests.
+const AggregatedName = "Aggregated"
+
+// Locust web API paths.
+const (
+	// RequestsPath serves the live statistics shown by the web UI as JSON.
+	RequestsPath = "/stats/requests"
+	// RequestsCSVPath serves cumulative per-endpoint statistics as CSV.
+	RequestsCSVPath = "/stats/requests/csv"
+	// StopPath stops the users of the running test, as the web UI's Stop button does.
+	StopPath = "/stop"
+)
+
+// Keys of the response time percentiles Locust charts in the web UI.
+const (
+	medianPercentileKey = "response_time_percentile_0.5"
+	p95PercentileKey    = "response_time_percentile_0.95"
+)
+
+// Report is the live state of a test as shown by the master's web UI.
+type Report struct {
+	// State is the runner state, e.g. "spawning", "running" or "stopped".
+	State string
+	// Users is the current number of simulated users.
+	Users int32
+	// RPS is the current total requests per second.
+	RPS float64
+	// FailureRatio is the ratio of failed requests since the test started.
+	FailureRatio float64
+	// MedianResponseTime is the current median response time.
+	MedianResponseTime time.Duration
+	// P95ResponseTime is the current 95th percentile response time.
+	P95ResponseTime time.Duration
+	// Workers lists the workers registered with the master. It is nil if the
+	// master didn't report a worker list, which only distributed masters do.
+	Workers []WorkerReport
+	// Endpoints lists the statistics of every endpoint, followed by the
+	// aggregated row, as the web UI's statistics table shows them.
+	Endpoints []EndpointReport
+	// Errors lists the distinct request failures and how often they occurred.
+	Errors []ErrorReport
+}
+
+// EndpointReport is the state of one endpoint, or of all requests for the
+// aggregated row, as reported by the master. Response times are in
+// milliseconds, the way Locust reports them.
+type EndpointReport struct {
+	// Method is the request type, e.g. "GET". Empty for the aggregated row.
+	Method string `json:"method"`
+	// Name is the endpoint name, or AggregatedName.
+	Name string `json:"name"`
+	// Requests is the total number of requests.
+	Requests int64 `json:"num_requests"`
+	// Failures is the total number of failed requests.
+	Failures int64 `json:"num_failures"`
+	// CurrentRPS is the current number of requests per second.
+	CurrentRPS float64 `json:"current_rps"`
+	// CurrentFailPerSec is the current number of failures per second.
+	CurrentFailPerSec float64 `json:"current_fail_per_sec"`
+	// AvgResponseTime is the average response time.
+	AvgResponseTime float64 `json:"avg_response_time"`
+	// MinResponseTime is the shortest response time.
+	MinResponseTime float64 `json:"min_response_time"`
+	// MaxResponseTime is the longest response time.
+	MaxResponseTime float64 `json:"max_response_time"`
+	// MedianResponseTime is the median response time.
+	MedianResponseTime float64 `json:"median_response_time"`
+	// AvgContentLength is the average size of the responses in bytes.
+	AvgContentLength float64 `json:"avg_content_length"`
+}
+
+// ErrorReport is one distinct request failure as reported by the master.
+type ErrorReport struct {
+	// Method is the request type of the failed requests.
+	Method string `json:"method"`
+	// Name is the endpoint name of the failed requests.
+	Name string `json:"name"`
+	// Error is the error message.
+	Error string `json:"error"`
+	// Occurrences is how often the error occurred.
+	Occurrences int64 `json:"occurrences"`
+}
+
+// Runner states reported by Locust.
+const (
+	// RunnerStateSpawning is the state of a runner starting its users.
+	RunnerStateSpawning = "spawning"
+	// RunnerStateRunning is the state of a runner whose users all spawned.
+	RunnerStateRunning = "running"
+)
+
+// WorkerStateMissing is the state of a worker whose heartbeats stopped.
+const WorkerStateMissing = "missing"
+
+// WorkerReport is the state of one worker as reported by the master.
+type WorkerReport struct {
+	// ID identifies the worker, usually "<hostname>_<random hex>".
+	ID string `json:"id"`
+	// State is the worker state, e.g. "ready", "running" or "missing".
+	State string `json:"state"`
+	// Users is the number of users the worker runs.
+	Users int32 `json:"user_count"`
+	// CPUUsage is the worker's CPU usage in percent.
+	CPUUsage float64 `json:"cpu_usage"`
+}
+
+// ConnectedWorkers returns the number of workers that are not missing.
+func (r *Report) ConnectedWorkers() int32 {
+	var n int32
+	for _, w := range r.Workers {
+		if w.State != WorkerStateMissing {
+			n++
+		}
+	}
+	return n
+}
+
+// reportJSON is the subset of /stats/requests read into a Report.
+type reportJSON struct {
+	State                          string              `json:"state"`
+	UserCount                      int32               `json:"user_count"`
+	TotalRPS                       float64             `json:"total_rps"`
+	FailRatio                      float64             `json:"fail_ratio"`
+	CurrentResponseTimePercentiles map[string]*float64 `json:"current_response_time_percentiles"`
+	Workers                        []WorkerReport      `json:"workers"`
+	Stats                          []EndpointReport    `json:"stats"`
+	Errors                         []ErrorReport       `json:"errors"`
+}
+
+// RequestStats holds the cumulative statistics of one endpoint, or of all
+// requests for the aggregated row.
+type RequestStats struct {
+	// Method is the request type, e.g. "GET". Empty for the aggregated row.
+	Method string
+	// Name is the endpoint name, or AggregatedName.
+	Name string
+	// Requests is the total number of requests.
+	Requests int64
+	// Failures is the total number of failed requests.
+	Failures int64
+	// RPS is the average number of requests per second since the test started.
+	RPS float64
+	// P95 is the 95th percentile response time.
+	P95 time.Duration
+	// P99 is the 99th percentile response time.
+	P99 time.Duration
+}
+
+// FailureRatio returns Failures/Requests, or 0 when there were no requests.
+func (s RequestStats) FailureRatio() float64 {
+	if s.Requests == 0 {
+		return 0
+	}
+	return float64(s.Failures) / float64(s.Requests)
+}
+
+// Fetcher reads statistics from a Locust master. The controller depends on
+// this interface rather than on HTTP so tests can substitute a fake master.
+type Fetcher interface {
+	// RequestStats returns the cumulative per-endpoint statistics of the
+	// master at baseURL, including the aggregated row.
+	RequestStats(ctx context.Context, baseURL string) ([]RequestStats, error)
+	// Report returns the live state of the master at baseURL.
+	Report(ctx context.Context, baseURL string) (*Report, error)
+}
+
+// Stopper stops the test running on a Locust master.
+type Stopper interface {
+	// Stop stops the users of the master at baseURL. The master keeps
+	// running until autoquit ends it.
+	Stop(ctx context.Context, baseURL string) error
+}
+
+// HTTPFetcher is the Fetcher and Stopper talking to the master's web UI port.
+type HTTPFetcher struct {
+	Client *http.Client
+}
+
+// NewHTTPFetcher returns an HTTPFetcher whose requests time out after timeout.
+func NewHTTPFetcher(timeout time.Duration) *HTTPFetcher {
+	return &HTTPFetcher{Client: &http.Client{Timeout: timeout}}
+}
+
+// RequestStats implements Fetcher.
+func (f *HTTPFetcher) RequestStats(ctx context.Context, baseURL string) ([]RequestStats, error) {
+	body, err := f.get(ctx, baseURL, RequestsCSVPath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = body.Close() }()
+
+	return ParseRequestsCSV(body)
+}
+
+// Report implements Fetcher.
+func (f *HTTPFetcher) Report(ctx context.Context, baseURL string) (*Report, error) {
+	body, err := f.get(ctx, baseURL, RequestsPath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = body.Close() }()
+
+	return ParseReport(body)
+}
+
+// Stop implements Stopper.
+func (f *HTTPFetcher) Stop(ctx context.Context, baseURL string) error {
+	body, err := f.get(ctx, baseURL, StopPath)
+	if err != nil {
+		return err
+	}
+	return body.Close()
+}
+
+// get requests path from the master and returns the body of a 200 response.
+func (f *HTTPFetcher) get(ctx context.Context, baseURL, path string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := f.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", path, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("failed to fetch %s: unexpected status %s", path, resp.Status)
+	}
+
+	return resp.Body, nil
+}
+
+// ParseReport parses the output of /stats/requests. Percentiles Locust
+// reports as null, before the first requests, are read as 0.
+func ParseReport(r io.Reader) (*Report, error) {
+	var raw reportJSON
+	if err := json.NewDecoder(r).Decode(&raw); err != nil {
+		return nil, fmt.Errorf("failed to parse statistics report: %w", err)
+	}
+
+	percentile := func(key string) time.Duration {
+		if v := raw.CurrentResponseTimePercentiles[key]; v != nil {
+			return time.Duration(*v * float64(time.Millisecond))
+		}
+		return 0
+	}
+
+	return &Report{
+		State:              raw.State,
+		Users:              raw.UserCount,
+		RPS:                raw.TotalRPS,
+		FailureRatio:       raw.FailRatio,
+		MedianResponseTime: percentile(medianPercentileKey),
+		P95ResponseTime:    percentile(p95PercentileKey),
+		Workers:            raw.Workers,
+		Endpoints:          raw.Stats,
+		Errors:             raw.Errors,
+	}, nil
+}
+
+// ParseRequestsCSV parses the output of /stats/requests/csv. Columns are
+// looked up by header so additional or reordered columns are tolerated.
+func ParseRequestsCSV(r io.Reader) ([]RequestStats, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse request statistics: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("failed to parse request statistics: empty response")
+	}
+
+	columns := make(map[string]int, len(records[0]))
+	for i, name := range records[0] {
+		columns[name] = i
+	}
+	for _, name := range []string{"Type", "Name", "Request Count", "Failure Count", "Requests/s", "95%", "99%"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("failed to parse request statistics: missing column %q", name)
+		}
+	}
+
+	result := make([]RequestStats, 0, len(records)-1)
+	for _, record := range records[1:] {
+		get := func(name string) string {
+			if i := columns[name]; i < len(record) {
+				return record[i]
+			}
+			return ""
+		}
+
+		result = append(result, RequestStats{
+			Method:   get("Type"),
+			Name:     get("Name"),
+			Requests: parseInt(get("Request Count")),
+			Failures: parseInt(get("Failure Count")),
+			RPS:      parseFloat(get("Requests/s")),
+			P95:      parseMillis(get("95%")),
+			P99:      parseMillis(get("99%")),
+		})
+	}
+
+	return result, nil
+}
+
+// Aggregated returns the aggregated row, or nil if rows has none.
+func Aggregated(rows []RequestStats) *RequestStats {
+	for i := range rows {
+		if rows[i].Name == AggregatedName && rows[i].Method == "" {
+			return &rows[i]
+		}
+	}
+	return nil
+}
+
+// parseInt parses an integer column, treating unparseable values as 0.
+func parseInt(s string) int64 {
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return v
+}
+
+// parseFloat parses a float column, treating unparseable values as 0.
+func parseFloat(s string) float64 {
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0
+	}
+	return v
+}
+
+// parseMillis parses a response time column in milliseconds. Locust writes
+// "N/A" for endpoints without requests, which is treated as 0.
+func parseMillis(s string) time.Duration {
+	return time.Duration(parseFloat(s) * float64(time.Millisecond))
+}
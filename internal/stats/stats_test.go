@@ -0,0 +1,207 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stats
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testRequestsCSV mirrors the output of Locust's /stats/requests/csv.
+const testRequestsCSV = `Type,Name,Request Count,Failure Count,Median Response Time,Average Response Time,Min Response Time,Max Response Time,Average Content Size,Requests/s,Failures/s,50%,66%,75%,80%,90%,95%,98%,99%,99.9%,99.99%,100%
+GET,/,900,9,42,51.3,12,812,1024,90.5,0.9,42,55,61,70,95,120,180,300,700,810,812
+POST,/checkout,100,40,310,350.1,80,2500,256,10,4,310,400,500,600,800,900,1500,2000,2400,2500,2500
+GET,/empty,0,0,0,0,0,0,0,0,0,N/A,N/A,N/A,N/A,N/A,N/A,N/A,N/A,N/A,N/A,N/A
+,Aggregated,1000,49,45,81.2,12,2500,947.2,100.5,4.9,45,60,70,80,110,400,1000,1500,2400,2500,2500
+`
+
+// testReportJSON is a trimmed response of Locust's /stats/requests.
+const testReportJSON = `{
+  "stats": [
+    {"method": "GET", "name": "/", "num_requests": 900, "num_failures": 9, "current_rps": 150.1,
+     "current_fail_per_sec": 1.9, "avg_response_time": 51.3, "min_response_time": 12, "max_response_time": 812,
+     "median_response_time": 42, "avg_content_length": 1024, "ninetieth_response_time": 95},
+    {"method": null, "name": "Aggregated", "num_requests": 900, "num_failures": 9, "current_rps": 150.1,
+     "current_fail_per_sec": 1.9, "avg_response_time": 51.3, "min_response_time": 12, "max_response_time": 812,
+     "median_response_time": null, "avg_content_length": 1024}
+  ],
+  "errors": [{"method": "GET", "name": "/", "error": "HTTPError('503 Server Error')", "occurrences": 9}],
+  "total_rps": 152.3,
+  "total_fail_per_sec": 1.9,
+  "fail_ratio": 0.0125,
+  "current_response_time_percentiles": {
+    "response_time_percentile_0.5": 42,
+    "response_time_percentile_0.95": 310.5
+  },
+  "state": "spawning",
+  "user_count": 150,
+  "workers": [
+    {"id": "worker-a_9b1c", "state": "running", "user_count": 75, "cpu_usage": 37.5, "memory_usage": 52428800},
+    {"id": "worker-b_77d0", "state": "missing", "user_count": 75, "cpu_usage": 0, "memory_usage": 0}
+  ]
+}`
+
+func TestParseReport(t *testing.T) {
+	report, err := ParseReport(strings.NewReader(testReportJSON))
+	require.NoError(t, err)
+
+	assert.Equal(t, &Report{
+		State:              "spawning",
+		Users:              150,
+		RPS:                152.3,
+		FailureRatio:       0.0125,
+		MedianResponseTime: 42 * time.Millisecond,
+		P95ResponseTime:    310500 * time.Microsecond,
+		Workers: []WorkerReport{
+			{ID: "worker-a_9b1c", State: "running", Users: 75, CPUUsage: 37.5},
+			{ID: "worker-b_77d0", State: "missing", Users: 75},
+		},
+		Endpoints: []EndpointReport{
+			{
+				Method: "GET", Name: "/", Requests: 900, Failures: 9, CurrentRPS: 150.1, CurrentFailPerSec: 1.9,
+				AvgResponseTime: 51.3, MinResponseTime: 12, MaxResponseTime: 812, MedianResponseTime: 42,
+				AvgContentLength: 1024,
+			},
+			{
+				Name: AggregatedName, Requests: 900, Failures: 9, CurrentRPS: 150.1, CurrentFailPerSec: 1.9,
+				AvgResponseTime: 51.3, MinResponseTime: 12, MaxResponseTime: 812, AvgContentLength: 1024,
+			},
+		},
+		Errors: []ErrorReport{{Method: "GET", Name: "/", Error: "HTTPError('503 Server Error')", Occurrences: 9}},
+	}, report)
+	assert.Equal(t, int32(1), report.ConnectedWorkers(), "missing workers are not connected")
+}
+
+func TestParseReport_NullPercentiles(t *testing.T) {
+	report, err := ParseReport(strings.NewReader(`{"state": "ready", "user_count": 0,
+		"current_response_time_percentiles": {"response_time_percentile_0.5": null}}`))
+	require.NoError(t, err)
+
+	assert.Equal(t, "ready", report.State)
+	assert.Nil(t, report.Workers, "no worker list outside distributed mode")
+	assert.Zero(t, report.MedianResponseTime)
+	assert.Zero(t, report.P95ResponseTime)
+}
+
+func TestParseReport_Invalid(t *testing.T) {
+	_, err := ParseReport(strings.NewReader("<html>"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to parse statistics report")
+}
+
+func TestParseRequestsCSV(t *testing.T) {
+	rows, err := ParseRequestsCSV(strings.NewReader(testRequestsCSV))
+	require.NoError(t, err)
+	require.Len(t, rows, 4)
+
+	assert.Equal(t, RequestStats{
+		Method:   "POST",
+		Name:     "/checkout",
+		Requests: 100,
+		Failures: 40,
+		RPS:      10,
+		P95:      900 * time.Millisecond,
+		P99:      2 * time.Second,
+	}, rows[1])
+
+	// N/A percentiles are read as 0
+	assert.Equal(t, "/empty", rows[2].Name)
+	assert.Zero(t, rows[2].P95)
+
+	total := Aggregated(rows)
+	require.NotNil(t, total)
+	assert.Equal(t, int64(1000), total.Requests)
+	assert.InDelta(t, 0.049, total.FailureRatio(), 1e-9)
+	assert.InDelta(t, 100.5, total.RPS, 1e-9)
+	assert.Equal(t, 1500*time.Millisecond, total.P99)
+}
+
+func TestParseRequestsCSV_MissingColumn(t *testing.T) {
+	_, err := ParseRequestsCSV(strings.NewReader("Type,Name,Request Count\nGET,/,1\n"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `missing column "Failure Count"`)
+}
+
+func TestParseRequestsCSV_Empty(t *testing.T) {
+	_, err := ParseRequestsCSV(strings.NewReader(""))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "empty response")
+}
+
+func TestAggregated_NotFound(t *testing.T) {
+	assert.Nil(t, Aggregated([]RequestStats{{Method: "GET", Name: AggregatedName}}),
+		"an endpoint named Aggregated is not the aggregated row")
+}
+
+func TestFailureRatio_NoRequests(t *testing.T) {
+	assert.Zero(t, RequestStats{}.FailureRatio())
+}
+
+func TestHTTPFetcher_RequestStats(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, RequestsCSVPath, r.URL.Path)
+		_, _ = w.Write([]byte(testRequestsCSV))
+	}))
+	defer server.Close()
+
+	rows, err := NewHTTPFetcher(time.Second).RequestStats(context.Background(), server.URL)
+	require.NoError(t, err)
+	assert.Len(t, rows, 4)
+}
+
+func TestHTTPFetcher_Report(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, RequestsPath, r.URL.Path)
+		_, _ = w.Write([]byte(testReportJSON))
+	}))
+	defer server.Close()
+
+	report, err := NewHTTPFetcher(time.Second).Report(context.Background(), server.URL)
+	require.NoError(t, err)
+	assert.Equal(t, int32(150), report.Users)
+}
+
+func TestHTTPFetcher_Stop(t *testing.T) {
+	stopped := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, StopPath, r.URL.Path)
+		stopped = true
+		_, _ = w.Write([]byte(`{"success": true, "message": "Test stopped"}`))
+	}))
+	defer server.Close()
+
+	require.NoError(t, NewHTTPFetcher(time.Second).Stop(context.Background(), server.URL))
+	assert.True(t, stopped)
+}
+
+func TestHTTPFetcher_UnexpectedStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	_, err := NewHTTPFetcher(time.Second).RequestStats(context.Background(), server.URL)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unexpected status 503")
+}
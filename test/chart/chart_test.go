@@ -0,0 +1,54 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package chart checks the Helm chart without needing helm installed.
+package chart
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"text/template/parse"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const templatesDir = "../../charts/locust-k8s-operator/templates"
+
+// TestTemplatesParse parses every template of the chart, so an unbalanced
+// if/end breaks `go test` rather than every helm install. Helm and Sprig
+// functions aren't available here, so only the syntax is checked.
+func TestTemplatesParse(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join(templatesDir, "*"))
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+
+	for _, file := range files {
+		if ext := filepath.Ext(file); ext != ".yaml" && ext != ".tpl" {
+			continue
+		}
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			data, err := os.ReadFile(file)
+			require.NoError(t, err)
+
+			tree := parse.New(filepath.Base(file))
+			tree.Mode = parse.SkipFuncCheck | parse.ParseComments
+			_, err = tree.Parse(string(data), "{{", "}}", map[string]*parse.Tree{})
+			assert.NoError(t, err)
+		})
+	}
+}